@@ -290,6 +290,75 @@ class ResumeService(resume_pb2_grpc.AIServiceServicer):
             context.set_code(grpc.StatusCode.INTERNAL)
             return resume_pb2.InterviewPrepResponse()
 
+    def ParseResume(self, request, context):
+        logger.info("Received ParseResume request")
+
+        try:
+            llm = LLMFactory.create_llm(provider="gemini")
+
+            from google.protobuf import json_format
+            draft_dict = json_format.MessageToDict(request.draft, preserving_proto_field_name=True)
+
+            system_instruction = """
+            You are an expert resume parser.
+            You receive the plain text extracted from a resume file and a draft produced by a heuristic parser.
+            Correct the draft so that it faithfully represents the resume text.
+
+            ### RULES
+            - Only use information that appears in the text. Do NOT invent or embellish anything.
+            - Fix fields the heuristic parser put in the wrong place (e.g. a company parsed as a job title).
+            - Fill in fields the draft missed, such as experience entries, education, projects, certificates and languages.
+            - Dates use the format "MM/YYYY" (or "YYYY" when the month is unknown); ongoing roles end with "Present".
+            - Experience and project descriptions are a single string where EACH bullet point starts with "• ".
+
+            ### OUTPUT FORMAT
+            Return a VALID JSON object with the same structure as the draft (snake_case keys).
+            Do NOT encompass the JSON in markdown code blocks. Just valid JSON.
+            """
+
+            human_instruction = f"""
+            ### RESUME TEXT
+            {request.text}
+
+            ### HEURISTIC DRAFT (JSON)
+            {draft_dict}
+            """
+
+            from langchain_core.messages import SystemMessage, HumanMessage
+            messages = [
+                SystemMessage(content=system_instruction),
+                HumanMessage(content=human_instruction)
+            ]
+
+            logger.info("Refining parsed resume...")
+            response = llm.invoke(messages)
+
+            import json
+            import re
+
+            content = response.content
+            if isinstance(content, list):
+                content = "".join(part.get('text', '') if isinstance(part, dict) else str(part) for part in content)
+
+            content = re.sub(r'```json\n|\n```', '', str(content)).strip()
+            content = re.sub(r'```\n|\n```', '', content).strip()
+
+            parsed = json.loads(content)
+
+            # Keep the profile image and anything else the text cannot contain
+            merged_resume = draft_dict.copy()
+            merged_resume.update(parsed)
+
+            resume_proto = resume_pb2.ResumeData()
+            json_format.ParseDict(merged_resume, resume_proto, ignore_unknown_fields=True)
+            return resume_proto
+
+        except Exception as e:
+            logger.error(f"Error parsing resume: {str(e)}")
+            context.set_details(str(e))
+            context.set_code(grpc.StatusCode.INTERNAL)
+            return resume_pb2.ResumeData()
+
 from config import settings
 
 def serve():
@@ -18,6 +18,7 @@ const VALIDATE_RESUME = gql`
       feedback
       missingKeywords
       reasoning
+      source
     }
   }
 `;
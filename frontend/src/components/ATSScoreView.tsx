@@ -24,6 +24,9 @@ export const ATSScoreView: React.FC<Props> = ({ result }) => {
                     ) : (
                         <span className="text-red-600 font-bold">Poor match.</span>
                     )}
+                    {result.source === 'HEURISTIC' && (
+                        <p className="text-xs text-gray-500 mt-1">AI unavailable — scored with the offline keyword matcher.</p>
+                    )}
                 </div>
             </div>
 
@@ -84,7 +87,7 @@ export const ATSScoreView: React.FC<Props> = ({ result }) => {
                             {/* Reasoning */}
                             <section>
                                 <h3 className="text-lg font-bold text-gray-800 mb-3 flex items-center gap-2">
-                                    <span className="text-xl">🤖</span> AI Reasoning
+                                    <span className="text-xl">🤖</span> {result.source === 'HEURISTIC' ? 'Keyword Reasoning' : 'AI Reasoning'}
                                 </h3>
                                 <div className="bg-blue-50 p-5 rounded-xl border border-blue-100 text-gray-700 leading-relaxed">
                                     {result.reasoning || "The AI did not provide specific reasoning for this score."}
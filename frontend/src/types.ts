@@ -82,6 +82,7 @@ export interface ATSScore {
     missingKeywords: string[];
     feedback: string[];
     reasoning?: string;
+    source?: 'LLM' | 'HEURISTIC';
 }
 
 export interface TailorResponse {
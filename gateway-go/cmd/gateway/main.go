@@ -11,13 +11,18 @@ import (
 	"github.com/99designs/gqlgen/graphql/handler/transport"
 	"github.com/99designs/gqlgen/graphql/playground"
 	"github.com/iprotoresume/gateway-go/graph"
+	"github.com/iprotoresume/gateway-go/internal/auth"
 	"github.com/iprotoresume/gateway-go/internal/clients"
+	"github.com/iprotoresume/gateway-go/internal/export"
 	"github.com/rs/cors"
 	"github.com/vektah/gqlparser/v2/ast"
 )
 
 const defaultPort = "8080"
 
+// maxUploadSize keeps uploaded resumes under gRPC's default 4 MB message limit.
+const maxUploadSize = 3 << 20
+
 func main() {
 	port := os.Getenv("PORT")
 	if port == "" {
@@ -36,14 +41,37 @@ func main() {
 	}
 	defer persistenceClient.Connection.Close()
 
-	srv := handler.New(graph.NewExecutableSchema(graph.Config{Resolvers: &graph.Resolver{
+	atsClient, err := clients.NewATSClient()
+	if err != nil {
+		log.Fatalf("failed to create ATS client: %v", err)
+	}
+	defer atsClient.Connection.Close()
+
+	authenticator, err := auth.NewAuthenticatorFromEnv()
+	if err != nil {
+		log.Fatalf("failed to configure JWT authentication: %v", err)
+	}
+	if authenticator == nil {
+		log.Printf("No JWT keys configured, authentication is disabled")
+	} else if os.Getenv("SERVICE_TOKEN") == "" {
+		// The resume service would reject every identity the gateway forwards
+		log.Fatalf("SERVICE_TOKEN must be set when authentication is enabled")
+	}
+
+	cfg := graph.Config{Resolvers: &graph.Resolver{
 		AIClient:          aiClient,
 		PersistenceClient: persistenceClient,
-	}}))
+		ATSClient:         atsClient,
+		AuthEnabled:       authenticator != nil,
+	}}
+	cfg.Directives.Auth = graph.Auth(authenticator != nil)
+
+	srv := handler.New(graph.NewExecutableSchema(cfg))
 
 	srv.AddTransport(transport.Options{})
 	srv.AddTransport(transport.GET{})
 	srv.AddTransport(transport.POST{})
+	srv.AddTransport(transport.MultipartForm{MaxUploadSize: maxUploadSize, MaxMemory: maxUploadSize})
 
 	srv.SetQueryCache(lru.New[*ast.QueryDocument](1000))
 
@@ -53,7 +81,8 @@ func main() {
 	})
 
 	http.Handle("/", playground.Handler("GraphQL playground", "/query"))
-	http.Handle("/query", srv)
+	http.Handle("/query", auth.Middleware(authenticator)(srv))
+	http.Handle("GET /export/{file}", auth.Middleware(authenticator)(auth.RequireUser(authenticator)(export.PDFHandler(persistenceClient.Client))))
 
 	// CORS setup
 	c := cors.New(cors.Options{
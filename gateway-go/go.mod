@@ -4,6 +4,7 @@ go 1.24.0
 
 require (
 	github.com/99designs/gqlgen v0.17.86
+	github.com/golang-jwt/jwt/v5 v5.3.0
 	github.com/iprotoresume v0.0.0-00010101000000-000000000000
 	github.com/rs/cors v1.11.1
 	github.com/vektah/gqlparser/v2 v2.5.31
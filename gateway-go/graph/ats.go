@@ -0,0 +1,108 @@
+package graph
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/iprotoresume/gateway-go/graph/model"
+	pb "github.com/iprotoresume/shared/proto"
+)
+
+// analyzeTimeout bounds LLM calls that have a heuristic fallback, such as
+// validateResume's analysis and importResumeFile's refinement.
+const analyzeTimeout = 45 * time.Second
+
+// validateWithScorer scores the resume with the deterministic ATS service.
+func (r *Resolver) validateWithScorer(ctx context.Context, resume *pb.ResumeData, jobDescription string) (*model.ATSScore, error) {
+	resp, err := r.ATSClient.Client.ValidateResume(ctx, &pb.ValidationRequest{
+		Resume:         resume,
+		JobDescription: jobDescription,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to validate resume: %w", err)
+	}
+
+	score := &model.ATSScore{
+		Score:           resp.Score,
+		Feedback:        resp.Feedback,
+		MissingKeywords: resp.MissingKeywords,
+		Reasoning:       &resp.Reasoning,
+		Source:          model.ATSScoreSourceHeuristic,
+		Sections:        []*model.SectionScore{},
+		Language:        optionalString(resp.Language),
+		ResumeLanguage:  optionalString(resp.ResumeLanguage),
+	}
+	for _, section := range resp.Sections {
+		matched := section.MatchedKeywords
+		if matched == nil {
+			matched = []string{}
+		}
+		score.Sections = append(score.Sections, &model.SectionScore{
+			Section:         section.Section,
+			Score:           section.Score,
+			MatchedKeywords: matched,
+		})
+	}
+	return score, nil
+}
+
+// resumeToValidate returns the resume validateResume should score: the
+// inline resume, or the requested revision of a saved one along with its
+// revision number.
+func (r *Resolver) resumeToValidate(ctx context.Context, input *model.ValidateResumeInput) (*pb.ResumeData, int32, error) {
+	switch {
+	case input.Resume != nil && input.ResumeID != nil:
+		return nil, 0, fmt.Errorf("resume and resumeId are mutually exclusive")
+	case input.Resume != nil:
+		return mapResumeInput(input.Resume), 0, nil
+	case input.ResumeID == nil:
+		return nil, 0, fmt.Errorf("resume or resumeId is required")
+	}
+	if err := r.requireUser(ctx); err != nil {
+		return nil, 0, err
+	}
+
+	if input.Revision != nil {
+		resp, err := r.PersistenceClient.Client.GetResumeRevision(ctx, &pb.GetResumeRevisionRequest{
+			ResumeId: *input.ResumeID,
+			Revision: *input.Revision,
+		})
+		if err != nil {
+			return nil, 0, fmt.Errorf("failed to get resume revision: %w", err)
+		}
+		return resp.ResumeData, resp.Revision, nil
+	}
+
+	resp, err := r.PersistenceClient.Client.GetResume(ctx, &pb.GetResumeRequest{
+		Id: *input.ResumeID,
+	})
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to get resume: %w", err)
+	}
+	return resp.ResumeData, resp.Revision, nil
+}
+
+// recordAnalysis adds score to the score history of the resume revision and
+// job description, setting its AnalysisID. Failures are logged rather than
+// returned so the caller still gets the score.
+func (r *Resolver) recordAnalysis(ctx context.Context, resumeID string, revision int32, jobID string, score *model.ATSScore) {
+	resp, err := r.PersistenceClient.Client.SaveAnalysis(ctx, &pb.SaveAnalysisRequest{
+		Analysis: &pb.Analysis{
+			ResumeId:         resumeID,
+			ResumeRevision:   revision,
+			JobDescriptionId: jobID,
+			Score:            score.Score,
+			Feedback:         score.Feedback,
+			MissingKeywords:  score.MissingKeywords,
+			Reasoning:        getStringValue(score.Reasoning),
+			Source:           analysisSources[score.Source],
+		},
+	})
+	if err != nil {
+		log.Printf("Saving analysis failed, score not recorded: %v", err)
+		return
+	}
+	score.AnalysisID = stringPtr(resp.Id)
+}
@@ -0,0 +1,37 @@
+package graph
+
+import (
+	"context"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/iprotoresume/gateway-go/internal/auth"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+// Auth implements the @auth directive. When authentication is disabled
+// (no JWT keys configured) every caller is let through.
+func Auth(enabled bool) func(ctx context.Context, obj any, next graphql.Resolver) (any, error) {
+	return func(ctx context.Context, obj any, next graphql.Resolver) (any, error) {
+		if enabled && auth.UserFromContext(ctx) == nil {
+			return nil, unauthenticatedError()
+		}
+		return next(ctx)
+	}
+}
+
+// requireUser applies the @auth check inside a resolver, for operations
+// that only need a caller when they read saved data, such as scoring
+// against a saved job description.
+func (r *Resolver) requireUser(ctx context.Context) error {
+	if r.AuthEnabled && auth.UserFromContext(ctx) == nil {
+		return unauthenticatedError()
+	}
+	return nil
+}
+
+func unauthenticatedError() *gqlerror.Error {
+	return &gqlerror.Error{
+		Message:    "authentication required",
+		Extensions: map[string]any{"code": "UNAUTHENTICATED"},
+	}
+}
@@ -0,0 +1,39 @@
+package graph
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/iprotoresume/gateway-go/graph/model"
+	pb "github.com/iprotoresume/shared/proto"
+)
+
+func (r *Resolver) listCoverLetters(ctx context.Context, links *pb.DocumentLinks) ([]*model.CoverLetter, error) {
+	resp, err := r.PersistenceClient.Client.ListCoverLetters(ctx, &pb.ListCoverLettersRequest{
+		Links: links,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list cover letters: %w", err)
+	}
+
+	results := []*model.CoverLetter{}
+	for _, l := range resp.CoverLetters {
+		results = append(results, mapProtoCoverLetterToModel(l))
+	}
+	return results, nil
+}
+
+func (r *Resolver) listQuestionSets(ctx context.Context, links *pb.DocumentLinks) ([]*model.QuestionSet, error) {
+	resp, err := r.PersistenceClient.Client.ListQuestionSets(ctx, &pb.ListQuestionSetsRequest{
+		Links: links,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list question sets: %w", err)
+	}
+
+	results := []*model.QuestionSet{}
+	for _, qs := range resp.QuestionSets {
+		results = append(results, mapProtoQuestionSetToModel(qs))
+	}
+	return results, nil
+}
@@ -0,0 +1,32 @@
+package graph
+
+import (
+	"fmt"
+
+	pb "github.com/iprotoresume/shared/proto"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// revisionConflictError turns the ABORTED status of a stale UpdateResume into
+// a REVISION_CONFLICT error carrying the server's copy of the resume. Other
+// errors are wrapped like any failed update.
+func revisionConflictError(err error) error {
+	st, ok := status.FromError(err)
+	if !ok || st.Code() != codes.Aborted {
+		return fmt.Errorf("failed to update resume: %w", err)
+	}
+
+	extensions := map[string]any{"code": "REVISION_CONFLICT"}
+	for _, detail := range st.Details() {
+		if current, ok := detail.(*pb.SavedResume); ok {
+			extensions["currentRevision"] = current.Revision
+			extensions["current"] = mapProtoSavedResumeToModel(current)
+		}
+	}
+	return &gqlerror.Error{
+		Message:    st.Message(),
+		Extensions: extensions,
+	}
+}
@@ -91,6 +91,44 @@ func mapAchievementInput(inputs []*model.AchievementInput) []*pb.Achievement {
 	return results
 }
 
+func mapResumeInput(in *model.ResumeInput) *pb.ResumeData {
+	if in == nil {
+		return nil
+	}
+
+	return &pb.ResumeData{
+		FullName:     in.FullName,
+		Email:        in.Email,
+		Phone:        getStringValue(in.Phone),
+		Summary:      getStringValue(in.Summary),
+		Skills:       in.Skills,
+		Experience:   mapExperienceInput(in.Experience),
+		Education:    mapEducationInput(in.Education),
+		Projects:     mapProjectInput(in.Projects),
+		Certificates: mapCertificateInput(in.Certificates),
+		JobTitle:     getStringValue(in.JobTitle),
+		Location:     getStringValue(in.Location),
+		Linkedin:     getStringValue(in.Linkedin),
+		Github:       getStringValue(in.Github),
+		Website:      getStringValue(in.Website),
+		ProfileImage: getStringValue(in.ProfileImage),
+		SkillGroups:  mapSkillGroupInput(in.SkillGroups),
+		Languages:    mapLanguageInput(in.Languages),
+		Achievements: mapAchievementInput(in.Achievements),
+	}
+}
+
+func mapResumeRefInput(in *model.ResumeRefInput) *pb.ResumeRef {
+	ref := &pb.ResumeRef{
+		ResumeId: getStringValue(in.ResumeID),
+		Resume:   mapResumeInput(in.Resume),
+	}
+	if in.Revision != nil {
+		ref.Revision = *in.Revision
+	}
+	return ref
+}
+
 func mapProtoResumeToModel(p *pb.ResumeData) *model.ResumeData {
 	if p == nil {
 		return &model.ResumeData{}
@@ -183,10 +221,313 @@ func mapProtoResumeToModel(p *pb.ResumeData) *model.ResumeData {
 	}
 }
 
+func mapProtoSavedResumeToModel(p *pb.SavedResume) *model.SavedResume {
+	saved := &model.SavedResume{
+		ID:              p.Id,
+		Resume:          mapProtoResumeToModel(p.ResumeData),
+		Tags:            p.Tags,
+		Version:         p.Version,
+		CreatedAt:       p.CreatedAt,
+		UpdatedAt:       p.UpdatedAt,
+		OwnerID:         p.OwnerId,
+		CurrentRevision: p.Revision,
+	}
+	if p.DeletedAt != "" {
+		saved.DeletedAt = stringPtr(p.DeletedAt)
+	}
+	return saved
+}
+
+func mapProtoRevisionToModel(p *pb.ResumeRevision) *model.ResumeRevision {
+	return &model.ResumeRevision{
+		ID:        p.Id,
+		ResumeID:  p.ResumeId,
+		Revision:  p.Revision,
+		Resume:    mapProtoResumeToModel(p.ResumeData),
+		CreatedAt: p.CreatedAt,
+	}
+}
+
+// defaultPageSize is the page size of resumes when first is null or not
+// positive, matching the default in the schema.
+const defaultPageSize = 20
+
+var resumeSortFields = map[model.ResumeSortField]pb.ResumeSortField{
+	model.ResumeSortFieldCreatedAt: pb.ResumeSortField_RESUME_SORT_FIELD_CREATED_AT,
+	model.ResumeSortFieldUpdatedAt: pb.ResumeSortField_RESUME_SORT_FIELD_UPDATED_AT,
+	model.ResumeSortFieldVersion:   pb.ResumeSortField_RESUME_SORT_FIELD_VERSION,
+}
+
+func mapProtoListToConnection(p *pb.ListResumesResponse) *model.SavedResumeConnection {
+	conn := &model.SavedResumeConnection{
+		PageInfo: &model.PageInfo{
+			HasNextPage: p.NextPageToken != "",
+		},
+		TotalCount: p.TotalCount,
+	}
+
+	for i, r := range p.Resumes {
+		conn.Edges = append(conn.Edges, &model.SavedResumeEdge{
+			Cursor: p.Cursors[i],
+			Node:   mapProtoSavedResumeToModel(r),
+		})
+	}
+	if p.NextPageToken != "" {
+		conn.PageInfo.EndCursor = stringPtr(p.NextPageToken)
+	} else if n := len(conn.Edges); n > 0 {
+		conn.PageInfo.EndCursor = stringPtr(conn.Edges[n-1].Cursor)
+	}
+	return conn
+}
+
+var resumeFormats = map[model.ResumeFormat]pb.ResumeFormat{
+	model.ResumeFormatJSONResume: pb.ResumeFormat_RESUME_FORMAT_JSON_RESUME,
+	model.ResumeFormatMarkdown:   pb.ResumeFormat_RESUME_FORMAT_MARKDOWN,
+	model.ResumeFormatHTML:       pb.ResumeFormat_RESUME_FORMAT_HTML,
+	model.ResumeFormatLatex:      pb.ResumeFormat_RESUME_FORMAT_LATEX,
+}
+
+var changeTypes = map[pb.ChangeType]model.DiffChangeType{
+	pb.ChangeType_CHANGE_TYPE_ADDED:    model.DiffChangeTypeAdded,
+	pb.ChangeType_CHANGE_TYPE_REMOVED:  model.DiffChangeTypeRemoved,
+	pb.ChangeType_CHANGE_TYPE_MODIFIED: model.DiffChangeTypeModified,
+}
+
+var textEditOps = map[pb.TextEditOp]model.TextEditOp{
+	pb.TextEditOp_TEXT_EDIT_OP_EQUAL:  model.TextEditOpEqual,
+	pb.TextEditOp_TEXT_EDIT_OP_INSERT: model.TextEditOpInsert,
+	pb.TextEditOp_TEXT_EDIT_OP_DELETE: model.TextEditOpDelete,
+}
+
+func mapProtoDiffToModel(p *pb.ResumeDiff) *model.ResumeDiff {
+	return &model.ResumeDiff{
+		Fields:      mapProtoFieldChanges(p.Fields),
+		Experience:  mapProtoItemChanges(p.Experience),
+		Skills:      mapProtoItemChanges(p.Skills),
+		SkillGroups: mapProtoItemChanges(p.SkillGroups),
+		Projects:    mapProtoItemChanges(p.Projects),
+	}
+}
+
+func mapProtoItemChanges(changes []*pb.ItemChange) []*model.ItemChange {
+	var results []*model.ItemChange
+	for _, c := range changes {
+		results = append(results, &model.ItemChange{
+			ChangeType: changeTypes[c.ChangeType],
+			Key:        c.Key,
+			Fields:     mapProtoFieldChanges(c.Fields),
+		})
+	}
+	return results
+}
+
+func mapProtoFieldChanges(changes []*pb.FieldChange) []*model.FieldChange {
+	var results []*model.FieldChange
+	for _, c := range changes {
+		var edits []*model.TextEdit
+		for _, e := range c.Edits {
+			edits = append(edits, &model.TextEdit{
+				Op:   textEditOps[e.Op],
+				Text: e.Text,
+			})
+		}
+
+		results = append(results, &model.FieldChange{
+			Field:         c.Field,
+			OldValue:      stringPtr(c.OldValue),
+			NewValue:      stringPtr(c.NewValue),
+			Edits:         edits,
+			AddedValues:   c.AddedValues,
+			RemovedValues: c.RemovedValues,
+		})
+	}
+	return results
+}
+
+var applicationStatuses = map[model.ApplicationStatus]pb.ApplicationStatus{
+	model.ApplicationStatusApplied:   pb.ApplicationStatus_APPLICATION_STATUS_APPLIED,
+	model.ApplicationStatusScreening: pb.ApplicationStatus_APPLICATION_STATUS_SCREENING,
+	model.ApplicationStatusInterview: pb.ApplicationStatus_APPLICATION_STATUS_INTERVIEW,
+	model.ApplicationStatusOffer:     pb.ApplicationStatus_APPLICATION_STATUS_OFFER,
+	model.ApplicationStatusAccepted:  pb.ApplicationStatus_APPLICATION_STATUS_ACCEPTED,
+	model.ApplicationStatusRejected:  pb.ApplicationStatus_APPLICATION_STATUS_REJECTED,
+	model.ApplicationStatusWithdrawn: pb.ApplicationStatus_APPLICATION_STATUS_WITHDRAWN,
+}
+
+func mapProtoApplicationStatus(st pb.ApplicationStatus) model.ApplicationStatus {
+	for m, p := range applicationStatuses {
+		if p == st {
+			return m
+		}
+	}
+	return model.ApplicationStatusApplied
+}
+
+func mapApplicationInput(id string, in *model.ApplicationInput) *pb.Application {
+	app := &pb.Application{
+		Id:             id,
+		ResumeId:       getStringValue(in.ResumeID),
+		Company:        in.Company,
+		Role:           in.Role,
+		JobDescription: getStringValue(in.JobDescription),
+		Url:            getStringValue(in.URL),
+		AppliedAt:      getStringValue(in.AppliedAt),
+		Notes:          getStringValue(in.Notes),
+	}
+	if in.ResumeRevision != nil {
+		app.ResumeRevision = *in.ResumeRevision
+	}
+	if in.Status != nil {
+		app.Status = applicationStatuses[*in.Status]
+	}
+	return app
+}
+
+func mapProtoApplicationToModel(p *pb.Application) *model.Application {
+	app := &model.Application{
+		ID:             p.Id,
+		Company:        p.Company,
+		Role:           p.Role,
+		JobDescription: &p.JobDescription,
+		URL:            &p.Url,
+		Status:         mapProtoApplicationStatus(p.Status),
+		AppliedAt:      p.AppliedAt,
+		Notes:          &p.Notes,
+		History:        []*model.ApplicationStatusChange{},
+		CreatedAt:      p.CreatedAt,
+		UpdatedAt:      p.UpdatedAt,
+	}
+	if p.ResumeId != "" {
+		app.ResumeID = stringPtr(p.ResumeId)
+		app.ResumeRevision = &p.ResumeRevision
+	}
+	for _, h := range p.History {
+		app.History = append(app.History, &model.ApplicationStatusChange{
+			Status:    mapProtoApplicationStatus(h.Status),
+			Note:      &h.Note,
+			ChangedAt: h.ChangedAt,
+		})
+	}
+	return app
+}
+
+func mapProtoJobDescriptionToModel(p *pb.JobDescription) *model.JobDescription {
+	keywords := p.Keywords
+	if keywords == nil {
+		keywords = []string{}
+	}
+	return &model.JobDescription{
+		ID:        p.Id,
+		Title:     &p.Title,
+		Company:   &p.Company,
+		SourceURL: &p.SourceUrl,
+		Text:      p.Text,
+		Keywords:  keywords,
+		CreatedAt: p.CreatedAt,
+	}
+}
+
+var analysisSources = map[model.ATSScoreSource]pb.AnalysisSource{
+	model.ATSScoreSourceLlm:       pb.AnalysisSource_ANALYSIS_SOURCE_LLM,
+	model.ATSScoreSourceHeuristic: pb.AnalysisSource_ANALYSIS_SOURCE_HEURISTIC,
+}
+
+func mapProtoAnalysisToModel(p *pb.Analysis) *model.ATSAnalysis {
+	source := model.ATSScoreSourceLlm
+	if p.Source == pb.AnalysisSource_ANALYSIS_SOURCE_HEURISTIC {
+		source = model.ATSScoreSourceHeuristic
+	}
+	return &model.ATSAnalysis{
+		ID:               p.Id,
+		ResumeID:         p.ResumeId,
+		ResumeRevision:   p.ResumeRevision,
+		JobDescriptionID: p.JobDescriptionId,
+		Score:            p.Score,
+		Feedback:         p.Feedback,
+		MissingKeywords:  p.MissingKeywords,
+		Reasoning:        &p.Reasoning,
+		Source:           source,
+		CreatedAt:        p.CreatedAt,
+	}
+}
+
+func mapDocumentLinksInput(in *model.DocumentLinksInput) *pb.DocumentLinks {
+	if in == nil {
+		return nil
+	}
+	return &pb.DocumentLinks{
+		ApplicationId:    getStringValue(in.ApplicationID),
+		ResumeId:         getStringValue(in.ResumeID),
+		JobDescriptionId: getStringValue(in.JobDescriptionID),
+	}
+}
+
+func mapPracticeQuestionInput(in *model.PracticeQuestionInput) *pb.PracticeQuestion {
+	q := &pb.PracticeQuestion{
+		Question:    in.Question,
+		Type:        getStringValue(in.Type),
+		AnswerGuide: getStringValue(in.AnswerGuide),
+		UserAnswer:  getStringValue(in.UserAnswer),
+	}
+	if in.Practiced != nil {
+		q.Practiced = *in.Practiced
+	}
+	return q
+}
+
+func mapProtoCoverLetterToModel(p *pb.CoverLetter) *model.CoverLetter {
+	links := p.GetLinks()
+	return &model.CoverLetter{
+		ID:               p.Id,
+		ApplicationID:    optionalString(links.GetApplicationId()),
+		ResumeID:         optionalString(links.GetResumeId()),
+		JobDescriptionID: optionalString(links.GetJobDescriptionId()),
+		Content:          p.Content,
+		CreatedAt:        p.CreatedAt,
+		UpdatedAt:        p.UpdatedAt,
+	}
+}
+
+func mapProtoQuestionSetToModel(p *pb.QuestionSet) *model.QuestionSet {
+	links := p.GetLinks()
+	set := &model.QuestionSet{
+		ID:               p.Id,
+		ApplicationID:    optionalString(links.GetApplicationId()),
+		ResumeID:         optionalString(links.GetResumeId()),
+		JobDescriptionID: optionalString(links.GetJobDescriptionId()),
+		Questions:        []*model.PracticeQuestion{},
+		CreatedAt:        p.CreatedAt,
+		UpdatedAt:        p.UpdatedAt,
+	}
+	for _, q := range p.Questions {
+		set.Questions = append(set.Questions, mapProtoPracticeQuestionToModel(q))
+	}
+	return set
+}
+
+func mapProtoPracticeQuestionToModel(p *pb.PracticeQuestion) *model.PracticeQuestion {
+	return &model.PracticeQuestion{
+		ID:          p.Id,
+		Question:    p.Question,
+		Type:        p.Type,
+		AnswerGuide: &p.AnswerGuide,
+		Practiced:   p.Practiced,
+		UserAnswer:  &p.UserAnswer,
+	}
+}
+
 func stringPtr(s string) *string {
 	return &s
 }
 
+// optionalString returns nil for an empty s.
+func optionalString(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return &s
+}
+
 func getStringValue(s *string) string {
 	if s == nil {
 		return ""
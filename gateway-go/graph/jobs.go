@@ -0,0 +1,34 @@
+package graph
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	pb "github.com/iprotoresume/shared/proto"
+)
+
+// jobDescriptionText returns the text of the saved job description id, or
+// text itself when no ID is given.
+func (r *Resolver) jobDescriptionText(ctx context.Context, id, text *string) (string, error) {
+	if id != nil {
+		if strings.TrimSpace(getStringValue(text)) != "" {
+			return "", fmt.Errorf("jobDescription and jobDescriptionId are mutually exclusive")
+		}
+		if err := r.requireUser(ctx); err != nil {
+			return "", err
+		}
+		resp, err := r.PersistenceClient.Client.GetJobDescription(ctx, &pb.GetJobDescriptionRequest{
+			Id: *id,
+		})
+		if err != nil {
+			return "", fmt.Errorf("failed to get job description: %w", err)
+		}
+		return resp.Text, nil
+	}
+
+	if strings.TrimSpace(getStringValue(text)) == "" {
+		return "", fmt.Errorf("jobDescription or jobDescriptionId is required")
+	}
+	return *text, nil
+}
@@ -2,11 +2,36 @@
 
 package model
 
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"strconv"
+)
+
+type ATSAnalysis struct {
+	ID               string         `json:"id"`
+	ResumeID         string         `json:"resumeId"`
+	ResumeRevision   int32          `json:"resumeRevision"`
+	JobDescriptionID string         `json:"jobDescriptionId"`
+	Score            int32          `json:"score"`
+	Feedback         []string       `json:"feedback"`
+	MissingKeywords  []string       `json:"missingKeywords"`
+	Reasoning        *string        `json:"reasoning,omitempty"`
+	Source           ATSScoreSource `json:"source"`
+	CreatedAt        string         `json:"createdAt"`
+}
+
 type ATSScore struct {
-	Score           int32    `json:"score"`
-	Feedback        []string `json:"feedback"`
-	MissingKeywords []string `json:"missingKeywords"`
-	Reasoning       *string  `json:"reasoning,omitempty"`
+	Score           int32           `json:"score"`
+	Feedback        []string        `json:"feedback"`
+	MissingKeywords []string        `json:"missingKeywords"`
+	Reasoning       *string         `json:"reasoning,omitempty"`
+	Source          ATSScoreSource  `json:"source"`
+	AnalysisID      *string         `json:"analysisId,omitempty"`
+	Sections        []*SectionScore `json:"sections,omitempty"`
+	Language        *string         `json:"language,omitempty"`
+	ResumeLanguage  *string         `json:"resumeLanguage,omitempty"`
 }
 
 type Achievement struct {
@@ -19,6 +44,43 @@ type AchievementInput struct {
 	Description string `json:"description"`
 }
 
+type Application struct {
+	ID             string                     `json:"id"`
+	ResumeID       *string                    `json:"resumeId,omitempty"`
+	ResumeRevision *int32                     `json:"resumeRevision,omitempty"`
+	Resume         *SavedResume               `json:"resume,omitempty"`
+	Company        string                     `json:"company"`
+	Role           string                     `json:"role"`
+	JobDescription *string                    `json:"jobDescription,omitempty"`
+	URL            *string                    `json:"url,omitempty"`
+	Status         ApplicationStatus          `json:"status"`
+	AppliedAt      string                     `json:"appliedAt"`
+	Notes          *string                    `json:"notes,omitempty"`
+	History        []*ApplicationStatusChange `json:"history"`
+	CoverLetters   []*CoverLetter             `json:"coverLetters"`
+	QuestionSets   []*QuestionSet             `json:"questionSets"`
+	CreatedAt      string                     `json:"createdAt"`
+	UpdatedAt      string                     `json:"updatedAt"`
+}
+
+type ApplicationInput struct {
+	ResumeID       *string            `json:"resumeId,omitempty"`
+	ResumeRevision *int32             `json:"resumeRevision,omitempty"`
+	Company        string             `json:"company"`
+	Role           string             `json:"role"`
+	JobDescription *string            `json:"jobDescription,omitempty"`
+	URL            *string            `json:"url,omitempty"`
+	Status         *ApplicationStatus `json:"status,omitempty"`
+	AppliedAt      *string            `json:"appliedAt,omitempty"`
+	Notes          *string            `json:"notes,omitempty"`
+}
+
+type ApplicationStatusChange struct {
+	Status    ApplicationStatus `json:"status"`
+	Note      *string           `json:"note,omitempty"`
+	ChangedAt string            `json:"changedAt"`
+}
+
 type Certificate struct {
 	Name   string  `json:"name"`
 	Issuer string  `json:"issuer"`
@@ -33,6 +95,27 @@ type CertificateInput struct {
 	Link   *string `json:"link,omitempty"`
 }
 
+type CoverLetter struct {
+	ID               string  `json:"id"`
+	ApplicationID    *string `json:"applicationId,omitempty"`
+	ResumeID         *string `json:"resumeId,omitempty"`
+	JobDescriptionID *string `json:"jobDescriptionId,omitempty"`
+	Content          string  `json:"content"`
+	CreatedAt        string  `json:"createdAt"`
+	UpdatedAt        string  `json:"updatedAt"`
+}
+
+type CoverLetterInput struct {
+	Links   *DocumentLinksInput `json:"links,omitempty"`
+	Content string              `json:"content"`
+}
+
+type DocumentLinksInput struct {
+	ApplicationID    *string `json:"applicationId,omitempty"`
+	ResumeID         *string `json:"resumeId,omitempty"`
+	JobDescriptionID *string `json:"jobDescriptionId,omitempty"`
+}
+
 type Education struct {
 	Degree         string  `json:"degree"`
 	Institution    string  `json:"institution"`
@@ -61,9 +144,25 @@ type ExperienceInput struct {
 	Description *string `json:"description,omitempty"`
 }
 
+type ExportedResume struct {
+	Content     string `json:"content"`
+	ContentType string `json:"contentType"`
+	Filename    string `json:"filename"`
+}
+
+type FieldChange struct {
+	Field         string      `json:"field"`
+	OldValue      *string     `json:"oldValue,omitempty"`
+	NewValue      *string     `json:"newValue,omitempty"`
+	Edits         []*TextEdit `json:"edits"`
+	AddedValues   []string    `json:"addedValues"`
+	RemovedValues []string    `json:"removedValues"`
+}
+
 type InterviewPrepInput struct {
-	Resume         *ResumeInput `json:"resume"`
-	JobDescription string       `json:"jobDescription"`
+	Resume           *ResumeInput `json:"resume"`
+	JobDescription   *string      `json:"jobDescription,omitempty"`
+	JobDescriptionID *string      `json:"jobDescriptionId,omitempty"`
 }
 
 type InterviewQuestion struct {
@@ -72,6 +171,29 @@ type InterviewQuestion struct {
 	AnswerGuide *string `json:"answerGuide,omitempty"`
 }
 
+type ItemChange struct {
+	ChangeType DiffChangeType `json:"changeType"`
+	Key        string         `json:"key"`
+	Fields     []*FieldChange `json:"fields"`
+}
+
+type JobDescription struct {
+	ID        string   `json:"id"`
+	Title     *string  `json:"title,omitempty"`
+	Company   *string  `json:"company,omitempty"`
+	SourceURL *string  `json:"sourceUrl,omitempty"`
+	Text      string   `json:"text"`
+	Keywords  []string `json:"keywords"`
+	CreatedAt string   `json:"createdAt"`
+}
+
+type JobDescriptionInput struct {
+	Title     *string `json:"title,omitempty"`
+	Company   *string `json:"company,omitempty"`
+	SourceURL *string `json:"sourceUrl,omitempty"`
+	Text      string  `json:"text"`
+}
+
 type Language struct {
 	Language    string `json:"language"`
 	Proficiency string `json:"proficiency"`
@@ -83,12 +205,35 @@ type LanguageInput struct {
 }
 
 type ListResumesFilter struct {
-	Tags []string `json:"tags,omitempty"`
+	Tags  []string `json:"tags,omitempty"`
+	Query *string  `json:"query,omitempty"`
 }
 
 type Mutation struct {
 }
 
+type PageInfo struct {
+	HasNextPage bool    `json:"hasNextPage"`
+	EndCursor   *string `json:"endCursor,omitempty"`
+}
+
+type PracticeQuestion struct {
+	ID          string  `json:"id"`
+	Question    string  `json:"question"`
+	Type        string  `json:"type"`
+	AnswerGuide *string `json:"answerGuide,omitempty"`
+	Practiced   bool    `json:"practiced"`
+	UserAnswer  *string `json:"userAnswer,omitempty"`
+}
+
+type PracticeQuestionInput struct {
+	Question    string  `json:"question"`
+	Type        *string `json:"type,omitempty"`
+	AnswerGuide *string `json:"answerGuide,omitempty"`
+	Practiced   *bool   `json:"practiced,omitempty"`
+	UserAnswer  *string `json:"userAnswer,omitempty"`
+}
+
 type Project struct {
 	Title       string   `json:"title"`
 	Description string   `json:"description"`
@@ -108,6 +253,21 @@ type ProjectInput struct {
 type Query struct {
 }
 
+type QuestionSet struct {
+	ID               string              `json:"id"`
+	ApplicationID    *string             `json:"applicationId,omitempty"`
+	ResumeID         *string             `json:"resumeId,omitempty"`
+	JobDescriptionID *string             `json:"jobDescriptionId,omitempty"`
+	Questions        []*PracticeQuestion `json:"questions"`
+	CreatedAt        string              `json:"createdAt"`
+	UpdatedAt        string              `json:"updatedAt"`
+}
+
+type QuestionSetInput struct {
+	Links     *DocumentLinksInput      `json:"links,omitempty"`
+	Questions []*PracticeQuestionInput `json:"questions"`
+}
+
 type QuestionsResponse struct {
 	Questions []*InterviewQuestion `json:"questions"`
 }
@@ -133,6 +293,14 @@ type ResumeData struct {
 	Achievements []*Achievement `json:"achievements,omitempty"`
 }
 
+type ResumeDiff struct {
+	Fields      []*FieldChange `json:"fields"`
+	Experience  []*ItemChange  `json:"experience"`
+	Skills      []*ItemChange  `json:"skills"`
+	SkillGroups []*ItemChange  `json:"skillGroups"`
+	Projects    []*ItemChange  `json:"projects"`
+}
+
 type ResumeInput struct {
 	FullName     string              `json:"fullName"`
 	Email        string              `json:"email"`
@@ -154,18 +322,61 @@ type ResumeInput struct {
 	Achievements []*AchievementInput `json:"achievements,omitempty"`
 }
 
+type ResumeRefInput struct {
+	ResumeID *string      `json:"resumeId,omitempty"`
+	Revision *int32       `json:"revision,omitempty"`
+	Resume   *ResumeInput `json:"resume,omitempty"`
+}
+
+type ResumeRevision struct {
+	ID        string      `json:"id"`
+	ResumeID  string      `json:"resumeId"`
+	Revision  int32       `json:"revision"`
+	Resume    *ResumeData `json:"resume"`
+	CreatedAt string      `json:"createdAt"`
+}
+
+type ResumeSort struct {
+	Field     ResumeSortField `json:"field"`
+	Direction SortDirection   `json:"direction"`
+}
+
 type SaveResumeInput struct {
+	ID      *string      `json:"id,omitempty"`
 	Resume  *ResumeInput `json:"resume"`
 	Tags    []string     `json:"tags"`
 	Version string       `json:"version"`
 }
 
 type SavedResume struct {
-	ID        string      `json:"id"`
-	Resume    *ResumeData `json:"resume"`
-	Tags      []string    `json:"tags"`
-	Version   string      `json:"version"`
-	CreatedAt string      `json:"createdAt"`
+	ID              string            `json:"id"`
+	Resume          *ResumeData       `json:"resume"`
+	Tags            []string          `json:"tags"`
+	Version         string            `json:"version"`
+	CreatedAt       string            `json:"createdAt"`
+	UpdatedAt       string            `json:"updatedAt"`
+	DeletedAt       *string           `json:"deletedAt,omitempty"`
+	OwnerID         string            `json:"ownerId"`
+	CurrentRevision int32             `json:"currentRevision"`
+	Revisions       []*ResumeRevision `json:"revisions"`
+	Revision        *ResumeRevision   `json:"revision,omitempty"`
+}
+
+type SavedResumeConnection struct {
+	Edges      []*SavedResumeEdge `json:"edges"`
+	PageInfo   *PageInfo          `json:"pageInfo"`
+	TotalCount int32              `json:"totalCount"`
+}
+
+type SavedResumeEdge struct {
+	Cursor string       `json:"cursor"`
+	Node   *SavedResume `json:"node"`
+}
+
+type SectionScore struct {
+	Section         string   `json:"section"`
+	Score           int32    `json:"score"`
+	MatchedKeywords []string `json:"matchedKeywords"`
 }
 
 type SkillGroup struct {
@@ -184,11 +395,433 @@ type TailorResponse struct {
 }
 
 type TailorResumeInput struct {
-	OriginalResume *ResumeInput `json:"originalResume"`
-	JobDescription string       `json:"jobDescription"`
+	OriginalResume   *ResumeInput `json:"originalResume"`
+	JobDescription   *string      `json:"jobDescription,omitempty"`
+	JobDescriptionID *string      `json:"jobDescriptionId,omitempty"`
+}
+
+type TextEdit struct {
+	Op   TextEditOp `json:"op"`
+	Text string     `json:"text"`
+}
+
+type User struct {
+	ID        string  `json:"id"`
+	Subject   string  `json:"subject"`
+	Email     *string `json:"email,omitempty"`
+	Name      *string `json:"name,omitempty"`
+	CreatedAt string  `json:"createdAt"`
 }
 
 type ValidateResumeInput struct {
-	Resume         *ResumeInput `json:"resume"`
-	JobDescription string       `json:"jobDescription"`
+	Resume           *ResumeInput `json:"resume,omitempty"`
+	ResumeID         *string      `json:"resumeId,omitempty"`
+	Revision         *int32       `json:"revision,omitempty"`
+	JobDescription   *string      `json:"jobDescription,omitempty"`
+	JobDescriptionID *string      `json:"jobDescriptionId,omitempty"`
+}
+
+type ATSScoreSource string
+
+const (
+	ATSScoreSourceLlm       ATSScoreSource = "LLM"
+	ATSScoreSourceHeuristic ATSScoreSource = "HEURISTIC"
+)
+
+var AllATSScoreSource = []ATSScoreSource{
+	ATSScoreSourceLlm,
+	ATSScoreSourceHeuristic,
+}
+
+func (e ATSScoreSource) IsValid() bool {
+	switch e {
+	case ATSScoreSourceLlm, ATSScoreSourceHeuristic:
+		return true
+	}
+	return false
+}
+
+func (e ATSScoreSource) String() string {
+	return string(e)
+}
+
+func (e *ATSScoreSource) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = ATSScoreSource(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid ATSScoreSource", str)
+	}
+	return nil
+}
+
+func (e ATSScoreSource) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *ATSScoreSource) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e ATSScoreSource) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+type ApplicationStatus string
+
+const (
+	ApplicationStatusApplied   ApplicationStatus = "APPLIED"
+	ApplicationStatusScreening ApplicationStatus = "SCREENING"
+	ApplicationStatusInterview ApplicationStatus = "INTERVIEW"
+	ApplicationStatusOffer     ApplicationStatus = "OFFER"
+	ApplicationStatusAccepted  ApplicationStatus = "ACCEPTED"
+	ApplicationStatusRejected  ApplicationStatus = "REJECTED"
+	ApplicationStatusWithdrawn ApplicationStatus = "WITHDRAWN"
+)
+
+var AllApplicationStatus = []ApplicationStatus{
+	ApplicationStatusApplied,
+	ApplicationStatusScreening,
+	ApplicationStatusInterview,
+	ApplicationStatusOffer,
+	ApplicationStatusAccepted,
+	ApplicationStatusRejected,
+	ApplicationStatusWithdrawn,
+}
+
+func (e ApplicationStatus) IsValid() bool {
+	switch e {
+	case ApplicationStatusApplied, ApplicationStatusScreening, ApplicationStatusInterview, ApplicationStatusOffer, ApplicationStatusAccepted, ApplicationStatusRejected, ApplicationStatusWithdrawn:
+		return true
+	}
+	return false
+}
+
+func (e ApplicationStatus) String() string {
+	return string(e)
+}
+
+func (e *ApplicationStatus) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = ApplicationStatus(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid ApplicationStatus", str)
+	}
+	return nil
+}
+
+func (e ApplicationStatus) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *ApplicationStatus) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e ApplicationStatus) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+type DiffChangeType string
+
+const (
+	DiffChangeTypeAdded    DiffChangeType = "ADDED"
+	DiffChangeTypeRemoved  DiffChangeType = "REMOVED"
+	DiffChangeTypeModified DiffChangeType = "MODIFIED"
+)
+
+var AllDiffChangeType = []DiffChangeType{
+	DiffChangeTypeAdded,
+	DiffChangeTypeRemoved,
+	DiffChangeTypeModified,
+}
+
+func (e DiffChangeType) IsValid() bool {
+	switch e {
+	case DiffChangeTypeAdded, DiffChangeTypeRemoved, DiffChangeTypeModified:
+		return true
+	}
+	return false
+}
+
+func (e DiffChangeType) String() string {
+	return string(e)
+}
+
+func (e *DiffChangeType) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = DiffChangeType(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid DiffChangeType", str)
+	}
+	return nil
+}
+
+func (e DiffChangeType) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *DiffChangeType) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e DiffChangeType) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+type ResumeFormat string
+
+const (
+	ResumeFormatJSONResume ResumeFormat = "JSON_RESUME"
+	ResumeFormatMarkdown   ResumeFormat = "MARKDOWN"
+	ResumeFormatHTML       ResumeFormat = "HTML"
+	ResumeFormatLatex      ResumeFormat = "LATEX"
+)
+
+var AllResumeFormat = []ResumeFormat{
+	ResumeFormatJSONResume,
+	ResumeFormatMarkdown,
+	ResumeFormatHTML,
+	ResumeFormatLatex,
+}
+
+func (e ResumeFormat) IsValid() bool {
+	switch e {
+	case ResumeFormatJSONResume, ResumeFormatMarkdown, ResumeFormatHTML, ResumeFormatLatex:
+		return true
+	}
+	return false
+}
+
+func (e ResumeFormat) String() string {
+	return string(e)
+}
+
+func (e *ResumeFormat) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = ResumeFormat(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid ResumeFormat", str)
+	}
+	return nil
+}
+
+func (e ResumeFormat) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *ResumeFormat) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e ResumeFormat) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+type ResumeSortField string
+
+const (
+	ResumeSortFieldCreatedAt ResumeSortField = "CREATED_AT"
+	ResumeSortFieldUpdatedAt ResumeSortField = "UPDATED_AT"
+	ResumeSortFieldVersion   ResumeSortField = "VERSION"
+)
+
+var AllResumeSortField = []ResumeSortField{
+	ResumeSortFieldCreatedAt,
+	ResumeSortFieldUpdatedAt,
+	ResumeSortFieldVersion,
+}
+
+func (e ResumeSortField) IsValid() bool {
+	switch e {
+	case ResumeSortFieldCreatedAt, ResumeSortFieldUpdatedAt, ResumeSortFieldVersion:
+		return true
+	}
+	return false
+}
+
+func (e ResumeSortField) String() string {
+	return string(e)
+}
+
+func (e *ResumeSortField) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = ResumeSortField(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid ResumeSortField", str)
+	}
+	return nil
+}
+
+func (e ResumeSortField) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *ResumeSortField) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e ResumeSortField) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+type SortDirection string
+
+const (
+	SortDirectionAsc  SortDirection = "ASC"
+	SortDirectionDesc SortDirection = "DESC"
+)
+
+var AllSortDirection = []SortDirection{
+	SortDirectionAsc,
+	SortDirectionDesc,
+}
+
+func (e SortDirection) IsValid() bool {
+	switch e {
+	case SortDirectionAsc, SortDirectionDesc:
+		return true
+	}
+	return false
+}
+
+func (e SortDirection) String() string {
+	return string(e)
+}
+
+func (e *SortDirection) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = SortDirection(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid SortDirection", str)
+	}
+	return nil
+}
+
+func (e SortDirection) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *SortDirection) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e SortDirection) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+type TextEditOp string
+
+const (
+	TextEditOpEqual  TextEditOp = "EQUAL"
+	TextEditOpInsert TextEditOp = "INSERT"
+	TextEditOpDelete TextEditOp = "DELETE"
+)
+
+var AllTextEditOp = []TextEditOp{
+	TextEditOpEqual,
+	TextEditOpInsert,
+	TextEditOpDelete,
+}
+
+func (e TextEditOp) IsValid() bool {
+	switch e {
+	case TextEditOpEqual, TextEditOpInsert, TextEditOpDelete:
+		return true
+	}
+	return false
+}
+
+func (e TextEditOp) String() string {
+	return string(e)
+}
+
+func (e *TextEditOp) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = TextEditOp(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid TextEditOp", str)
+	}
+	return nil
+}
+
+func (e TextEditOp) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *TextEditOp) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e TextEditOp) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
 }
@@ -12,4 +12,8 @@ import (
 type Resolver struct {
 	AIClient          *clients.AIClient
 	PersistenceClient *clients.PersistenceClient
+	ATSClient         *clients.ATSClient
+	// AuthEnabled mirrors whether the @auth directive is enforced, for
+	// resolvers that are open to anonymous callers only in part.
+	AuthEnabled bool
 }
@@ -8,14 +8,53 @@ package graph
 import (
 	"context"
 	"fmt"
+	"io"
+	"log"
 
+	"github.com/99designs/gqlgen/graphql"
 	"github.com/iprotoresume/gateway-go/graph/model"
 	pb "github.com/iprotoresume/shared/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
+// Resume is the resolver for the resume field.
+func (r *applicationResolver) Resume(ctx context.Context, obj *model.Application) (*model.SavedResume, error) {
+	if obj.ResumeID == nil {
+		return nil, nil
+	}
+
+	resp, err := r.PersistenceClient.Client.GetResume(ctx, &pb.GetResumeRequest{
+		Id: *obj.ResumeID,
+	})
+	if status.Code(err) == codes.NotFound {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get resume: %w", err)
+	}
+
+	return mapProtoSavedResumeToModel(resp), nil
+}
+
+// CoverLetters is the resolver for the coverLetters field.
+func (r *applicationResolver) CoverLetters(ctx context.Context, obj *model.Application) ([]*model.CoverLetter, error) {
+	return r.listCoverLetters(ctx, &pb.DocumentLinks{ApplicationId: obj.ID})
+}
+
+// QuestionSets is the resolver for the questionSets field.
+func (r *applicationResolver) QuestionSets(ctx context.Context, obj *model.Application) ([]*model.QuestionSet, error) {
+	return r.listQuestionSets(ctx, &pb.DocumentLinks{ApplicationId: obj.ID})
+}
+
 // TailorResume is the resolver for the tailorResume field.
 // TailorResume is the resolver for the tailorResume field.
 func (r *mutationResolver) TailorResume(ctx context.Context, input model.TailorResumeInput) (*model.TailorResponse, error) {
+	jobDescription, err := r.jobDescriptionText(ctx, input.JobDescriptionID, input.JobDescription)
+	if err != nil {
+		return nil, err
+	}
+
 	req := &pb.TailorRequest{
 		OriginalResume: &pb.ResumeData{
 			FullName:     input.OriginalResume.FullName,
@@ -36,7 +75,7 @@ func (r *mutationResolver) TailorResume(ctx context.Context, input model.TailorR
 			Languages:    mapLanguageInput(input.OriginalResume.Languages),
 			Achievements: mapAchievementInput(input.OriginalResume.Achievements),
 		},
-		JobDescription: input.JobDescription,
+		JobDescription: jobDescription,
 	}
 
 	resp, err := r.AIClient.Client.TailorResume(ctx, req)
@@ -52,41 +91,46 @@ func (r *mutationResolver) TailorResume(ctx context.Context, input model.TailorR
 
 // ValidateResume is the resolver for the validateResume field.
 func (r *mutationResolver) ValidateResume(ctx context.Context, input model.ValidateResumeInput) (*model.ATSScore, error) {
+	jobDescription, err := r.jobDescriptionText(ctx, input.JobDescriptionID, input.JobDescription)
+	if err != nil {
+		return nil, err
+	}
+
+	resume, revision, err := r.resumeToValidate(ctx, &input)
+	if err != nil {
+		return nil, err
+	}
+
 	req := &pb.AnalyzeResumeRequest{
-		Resume: &pb.ResumeData{
-			FullName:     input.Resume.FullName,
-			Email:        input.Resume.Email,
-			Phone:        getStringValue(input.Resume.Phone),
-			Summary:      getStringValue(input.Resume.Summary),
-			Skills:       input.Resume.Skills,
-			Experience:   mapExperienceInput(input.Resume.Experience),
-			Education:    mapEducationInput(input.Resume.Education),
-			Projects:     mapProjectInput(input.Resume.Projects),
-			Certificates: mapCertificateInput(input.Resume.Certificates),
-			JobTitle:     getStringValue(input.Resume.JobTitle),
-			Location:     getStringValue(input.Resume.Location),
-			Linkedin:     getStringValue(input.Resume.Linkedin),
-			Github:       getStringValue(input.Resume.Github),
-			Website:      getStringValue(input.Resume.Website),
-			ProfileImage: getStringValue(input.Resume.ProfileImage),
-			SkillGroups:  mapSkillGroupInput(input.Resume.SkillGroups),
-			Languages:    mapLanguageInput(input.Resume.Languages),
-			Achievements: mapAchievementInput(input.Resume.Achievements),
-		},
-		JobDescription: input.JobDescription,
+		Resume:         resume,
+		JobDescription: jobDescription,
 	}
 
-	resp, err := r.AIClient.Client.AnalyzeResume(ctx, req)
+	aiCtx, cancel := context.WithTimeout(ctx, analyzeTimeout)
+	defer cancel()
+
+	var score *model.ATSScore
+	resp, err := r.AIClient.Client.AnalyzeResume(aiCtx, req)
 	if err != nil {
-		return nil, fmt.Errorf("failed to validate resume: %w", err)
+		log.Printf("AI analysis failed, falling back to heuristic scorer: %v", err)
+		score, err = r.validateWithScorer(ctx, req.Resume, req.JobDescription)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		score = &model.ATSScore{
+			Score:           resp.Score,
+			Feedback:        resp.Feedback,
+			MissingKeywords: resp.MissingKeywords,
+			Reasoning:       &resp.Reasoning,
+			Source:          model.ATSScoreSourceLlm,
+		}
 	}
 
-	return &model.ATSScore{
-		Score:           resp.Score,
-		Feedback:        resp.Feedback,
-		MissingKeywords: resp.MissingKeywords,
-		Reasoning:       &resp.Reasoning,
-	}, nil
+	if input.ResumeID != nil && input.JobDescriptionID != nil {
+		r.recordAnalysis(ctx, *input.ResumeID, revision, *input.JobDescriptionID, score)
+	}
+	return score, nil
 }
 
 // SaveResume is the resolver for the saveResume field.
@@ -114,6 +158,7 @@ func (r *mutationResolver) SaveResume(ctx context.Context, input model.SaveResum
 		},
 		Tags:    input.Tags,
 		Version: input.Version,
+		Id:      getStringValue(input.ID),
 	}
 
 	resp, err := r.PersistenceClient.Client.SaveResume(ctx, req)
@@ -121,13 +166,21 @@ func (r *mutationResolver) SaveResume(ctx context.Context, input model.SaveResum
 		return nil, fmt.Errorf("failed to save resume: %w", err)
 	}
 
-	return &model.SavedResume{
-		ID:        resp.Id,
-		Resume:    mapProtoResumeToModel(resp.ResumeData),
-		Tags:      resp.Tags,
-		Version:   resp.Version,
-		CreatedAt: resp.CreatedAt,
-	}, nil
+	return mapProtoSavedResumeToModel(resp), nil
+}
+
+// UpdateResume is the resolver for the updateResume field.
+func (r *mutationResolver) UpdateResume(ctx context.Context, id string, expectedRevision int32, resume model.ResumeInput) (*model.SavedResume, error) {
+	resp, err := r.PersistenceClient.Client.UpdateResume(ctx, &pb.UpdateResumeRequest{
+		Id:               id,
+		ExpectedRevision: expectedRevision,
+		Resume:           mapResumeInput(&resume),
+	})
+	if err != nil {
+		return nil, revisionConflictError(err)
+	}
+
+	return mapProtoSavedResumeToModel(resp), nil
 }
 
 // DeleteResume is the resolver for the deleteResume field.
@@ -144,8 +197,50 @@ func (r *mutationResolver) DeleteResume(ctx context.Context, id string) (bool, e
 	return resp.Success, nil
 }
 
+// RestoreResumeRevision is the resolver for the restoreResumeRevision field.
+func (r *mutationResolver) RestoreResumeRevision(ctx context.Context, resumeID string, revision int32) (*model.SavedResume, error) {
+	resp, err := r.PersistenceClient.Client.RestoreResumeRevision(ctx, &pb.RestoreResumeRevisionRequest{
+		ResumeId: resumeID,
+		Revision: revision,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to restore resume revision: %w", err)
+	}
+
+	return mapProtoSavedResumeToModel(resp), nil
+}
+
+// RestoreResume is the resolver for the restoreResume field.
+func (r *mutationResolver) RestoreResume(ctx context.Context, id string) (*model.SavedResume, error) {
+	resp, err := r.PersistenceClient.Client.RestoreResume(ctx, &pb.RestoreResumeRequest{
+		Id: id,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to restore resume: %w", err)
+	}
+
+	return mapProtoSavedResumeToModel(resp), nil
+}
+
+// PurgeResume is the resolver for the purgeResume field.
+func (r *mutationResolver) PurgeResume(ctx context.Context, id string) (bool, error) {
+	resp, err := r.PersistenceClient.Client.PurgeResume(ctx, &pb.PurgeResumeRequest{
+		Id: id,
+	})
+	if err != nil {
+		return false, fmt.Errorf("failed to purge resume: %w", err)
+	}
+
+	return resp.Success, nil
+}
+
 // GenerateInterviewQuestions is the resolver for the generateInterviewQuestions field.
 func (r *mutationResolver) GenerateInterviewQuestions(ctx context.Context, input model.InterviewPrepInput) (*model.QuestionsResponse, error) {
+	jobDescription, err := r.jobDescriptionText(ctx, input.JobDescriptionID, input.JobDescription)
+	if err != nil {
+		return nil, err
+	}
+
 	req := &pb.InterviewPrepRequest{
 		Resume: &pb.ResumeData{
 			FullName:     input.Resume.FullName,
@@ -167,7 +262,7 @@ func (r *mutationResolver) GenerateInterviewQuestions(ctx context.Context, input
 			Languages:    mapLanguageInput(input.Resume.Languages),
 			Achievements: mapAchievementInput(input.Resume.Achievements),
 		},
-		JobDescription: input.JobDescription,
+		JobDescription: jobDescription,
 	}
 
 	resp, err := r.AIClient.Client.GenerateInterviewQuestions(ctx, req)
@@ -189,16 +284,252 @@ func (r *mutationResolver) GenerateInterviewQuestions(ctx context.Context, input
 	}, nil
 }
 
+// ImportResume is the resolver for the importResume field.
+func (r *mutationResolver) ImportResume(ctx context.Context, format model.ResumeFormat, content string) (*model.ResumeData, error) {
+	resp, err := r.PersistenceClient.Client.ImportResume(ctx, &pb.ImportResumeRequest{
+		Format:  resumeFormats[format],
+		Content: content,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to import resume: %w", err)
+	}
+
+	return mapProtoResumeToModel(resp), nil
+}
+
+// ImportResumeFile is the resolver for the importResumeFile field.
+func (r *mutationResolver) ImportResumeFile(ctx context.Context, file graphql.Upload, refine *bool) (*model.ResumeData, error) {
+	content, err := io.ReadAll(file.File)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read upload: %w", err)
+	}
+
+	resp, err := r.PersistenceClient.Client.ParseResumeFile(ctx, &pb.ParseResumeFileRequest{
+		Filename: file.Filename,
+		Content:  content,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse resume file: %w", err)
+	}
+
+	draft := resp.Draft
+	if refine != nil && *refine {
+		aiCtx, cancel := context.WithTimeout(ctx, analyzeTimeout)
+		defer cancel()
+
+		refined, err := r.AIClient.Client.ParseResume(aiCtx, &pb.ParseResumeRequest{Text: resp.Text, Draft: draft})
+		if err != nil {
+			log.Printf("AI parse refinement failed, returning heuristic draft: %v", err)
+		} else {
+			draft = refined
+		}
+	}
+
+	return mapProtoResumeToModel(draft), nil
+}
+
+// ImportLinkedInArchive is the resolver for the importLinkedInArchive field.
+func (r *mutationResolver) ImportLinkedInArchive(ctx context.Context, file graphql.Upload) (*model.ResumeData, error) {
+	content, err := io.ReadAll(file.File)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read upload: %w", err)
+	}
+
+	resp, err := r.PersistenceClient.Client.ImportResume(ctx, &pb.ImportResumeRequest{
+		Format: pb.ResumeFormat_RESUME_FORMAT_LINKEDIN_EXPORT,
+		File:   content,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to import LinkedIn archive: %w", err)
+	}
+
+	return mapProtoResumeToModel(resp), nil
+}
+
+// CreateApplication is the resolver for the createApplication field.
+func (r *mutationResolver) CreateApplication(ctx context.Context, input model.ApplicationInput) (*model.Application, error) {
+	resp, err := r.PersistenceClient.Client.CreateApplication(ctx, &pb.CreateApplicationRequest{
+		Application: mapApplicationInput("", &input),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create application: %w", err)
+	}
+
+	return mapProtoApplicationToModel(resp), nil
+}
+
+// UpdateApplication is the resolver for the updateApplication field.
+func (r *mutationResolver) UpdateApplication(ctx context.Context, id string, input model.ApplicationInput, statusNote *string) (*model.Application, error) {
+	resp, err := r.PersistenceClient.Client.UpdateApplication(ctx, &pb.UpdateApplicationRequest{
+		Application: mapApplicationInput(id, &input),
+		StatusNote:  getStringValue(statusNote),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to update application: %w", err)
+	}
+
+	return mapProtoApplicationToModel(resp), nil
+}
+
+// DeleteApplication is the resolver for the deleteApplication field.
+func (r *mutationResolver) DeleteApplication(ctx context.Context, id string) (bool, error) {
+	resp, err := r.PersistenceClient.Client.DeleteApplication(ctx, &pb.DeleteApplicationRequest{
+		Id: id,
+	})
+	if err != nil {
+		return false, fmt.Errorf("failed to delete application: %w", err)
+	}
+
+	return resp.Success, nil
+}
+
+// SaveJobDescription is the resolver for the saveJobDescription field.
+func (r *mutationResolver) SaveJobDescription(ctx context.Context, input model.JobDescriptionInput) (*model.JobDescription, error) {
+	resp, err := r.PersistenceClient.Client.SaveJobDescription(ctx, &pb.SaveJobDescriptionRequest{
+		JobDescription: &pb.JobDescription{
+			Title:     getStringValue(input.Title),
+			Company:   getStringValue(input.Company),
+			SourceUrl: getStringValue(input.SourceURL),
+			Text:      input.Text,
+		},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to save job description: %w", err)
+	}
+
+	return mapProtoJobDescriptionToModel(resp), nil
+}
+
+// SaveCoverLetter is the resolver for the saveCoverLetter field.
+func (r *mutationResolver) SaveCoverLetter(ctx context.Context, input model.CoverLetterInput) (*model.CoverLetter, error) {
+	resp, err := r.PersistenceClient.Client.SaveCoverLetter(ctx, &pb.SaveCoverLetterRequest{
+		CoverLetter: &pb.CoverLetter{
+			Links:   mapDocumentLinksInput(input.Links),
+			Content: input.Content,
+		},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to save cover letter: %w", err)
+	}
+
+	return mapProtoCoverLetterToModel(resp), nil
+}
+
+// UpdateCoverLetter is the resolver for the updateCoverLetter field.
+func (r *mutationResolver) UpdateCoverLetter(ctx context.Context, id string, content string) (*model.CoverLetter, error) {
+	resp, err := r.PersistenceClient.Client.UpdateCoverLetter(ctx, &pb.UpdateCoverLetterRequest{
+		Id:      id,
+		Content: content,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to update cover letter: %w", err)
+	}
+
+	return mapProtoCoverLetterToModel(resp), nil
+}
+
+// DeleteCoverLetter is the resolver for the deleteCoverLetter field.
+func (r *mutationResolver) DeleteCoverLetter(ctx context.Context, id string) (bool, error) {
+	resp, err := r.PersistenceClient.Client.DeleteCoverLetter(ctx, &pb.DeleteCoverLetterRequest{
+		Id: id,
+	})
+	if err != nil {
+		return false, fmt.Errorf("failed to delete cover letter: %w", err)
+	}
+
+	return resp.Success, nil
+}
+
+// SaveQuestionSet is the resolver for the saveQuestionSet field.
+func (r *mutationResolver) SaveQuestionSet(ctx context.Context, input model.QuestionSetInput) (*model.QuestionSet, error) {
+	set := &pb.QuestionSet{
+		Links: mapDocumentLinksInput(input.Links),
+	}
+	for _, q := range input.Questions {
+		set.Questions = append(set.Questions, mapPracticeQuestionInput(q))
+	}
+
+	resp, err := r.PersistenceClient.Client.SaveQuestionSet(ctx, &pb.SaveQuestionSetRequest{
+		QuestionSet: set,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to save question set: %w", err)
+	}
+
+	return mapProtoQuestionSetToModel(resp), nil
+}
+
+// UpdatePracticeQuestion is the resolver for the updatePracticeQuestion field.
+func (r *mutationResolver) UpdatePracticeQuestion(ctx context.Context, id string, input model.PracticeQuestionInput) (*model.PracticeQuestion, error) {
+	q := mapPracticeQuestionInput(&input)
+	resp, err := r.PersistenceClient.Client.UpdatePracticeQuestion(ctx, &pb.UpdatePracticeQuestionRequest{
+		Id:          id,
+		Question:    q.Question,
+		AnswerGuide: q.AnswerGuide,
+		Practiced:   q.Practiced,
+		UserAnswer:  q.UserAnswer,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to update question: %w", err)
+	}
+
+	return mapProtoPracticeQuestionToModel(resp), nil
+}
+
+// DeleteQuestionSet is the resolver for the deleteQuestionSet field.
+func (r *mutationResolver) DeleteQuestionSet(ctx context.Context, id string) (bool, error) {
+	resp, err := r.PersistenceClient.Client.DeleteQuestionSet(ctx, &pb.DeleteQuestionSetRequest{
+		Id: id,
+	})
+	if err != nil {
+		return false, fmt.Errorf("failed to delete question set: %w", err)
+	}
+
+	return resp.Success, nil
+}
+
 // Health is the resolver for the health field.
 func (r *queryResolver) Health(ctx context.Context) (string, error) {
 	return "OK", nil
 }
 
+// Me is the resolver for the me field.
+func (r *queryResolver) Me(ctx context.Context) (*model.User, error) {
+	resp, err := r.PersistenceClient.Client.GetCurrentUser(ctx, &pb.GetCurrentUserRequest{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get current user: %w", err)
+	}
+
+	return &model.User{
+		ID:        resp.Id,
+		Subject:   resp.Subject,
+		Email:     stringPtr(resp.Email),
+		Name:      stringPtr(resp.Name),
+		CreatedAt: resp.CreatedAt,
+	}, nil
+}
+
+// Resume is the resolver for the resume field.
+func (r *queryResolver) Resume(ctx context.Context, id string) (*model.SavedResume, error) {
+	resp, err := r.PersistenceClient.Client.GetResume(ctx, &pb.GetResumeRequest{
+		Id: id,
+	})
+	if status.Code(err) == codes.NotFound {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get resume: %w", err)
+	}
+
+	return mapProtoSavedResumeToModel(resp), nil
+}
+
 // ListResumes is the resolver for the listResumes field.
 func (r *queryResolver) ListResumes(ctx context.Context, filter *model.ListResumesFilter) ([]*model.SavedResume, error) {
 	req := &pb.ListResumesRequest{}
 	if filter != nil {
 		req.Tags = filter.Tags
+		req.Query = getStringValue(filter.Query)
 	}
 
 	resp, err := r.PersistenceClient.Client.ListResumes(ctx, req)
@@ -208,22 +539,224 @@ func (r *queryResolver) ListResumes(ctx context.Context, filter *model.ListResum
 
 	var results []*model.SavedResume
 	for _, r := range resp.Resumes {
-		results = append(results, &model.SavedResume{
-			ID:        r.Id,
-			Resume:    mapProtoResumeToModel(r.ResumeData),
-			Tags:      r.Tags,
-			Version:   r.Version,
-			CreatedAt: r.CreatedAt,
-		})
+		results = append(results, mapProtoSavedResumeToModel(r))
+	}
+	return results, nil
+}
+
+// DeletedResumes is the resolver for the deletedResumes field.
+func (r *queryResolver) DeletedResumes(ctx context.Context) ([]*model.SavedResume, error) {
+	resp, err := r.PersistenceClient.Client.ListDeletedResumes(ctx, &pb.ListDeletedResumesRequest{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list deleted resumes: %w", err)
+	}
+
+	var results []*model.SavedResume
+	for _, r := range resp.Resumes {
+		results = append(results, mapProtoSavedResumeToModel(r))
+	}
+	return results, nil
+}
+
+// Resumes is the resolver for the resumes field.
+func (r *queryResolver) Resumes(ctx context.Context, first *int32, after *string, filter *model.ListResumesFilter, sort *model.ResumeSort) (*model.SavedResumeConnection, error) {
+	pageSize := int32(defaultPageSize)
+	if first != nil && *first > 0 {
+		pageSize = *first
+	}
+
+	req := &pb.ListResumesRequest{
+		PageSize:  pageSize,
+		PageToken: getStringValue(after),
+	}
+	if filter != nil {
+		req.Tags = filter.Tags
+		req.Query = getStringValue(filter.Query)
+	}
+	if sort != nil {
+		req.SortBy = resumeSortFields[sort.Field]
+		req.Ascending = sort.Direction == model.SortDirectionAsc
+	}
+
+	resp, err := r.PersistenceClient.Client.ListResumes(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list resumes: %w", err)
+	}
+
+	return mapProtoListToConnection(resp), nil
+}
+
+// ResumeDiff is the resolver for the resumeDiff field.
+func (r *queryResolver) ResumeDiff(ctx context.Context, a model.ResumeRefInput, b model.ResumeRefInput) (*model.ResumeDiff, error) {
+	req := &pb.DiffResumesRequest{
+		Base:   mapResumeRefInput(&a),
+		Target: mapResumeRefInput(&b),
+	}
+
+	resp, err := r.PersistenceClient.Client.DiffResumes(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to diff resumes: %w", err)
+	}
+
+	return mapProtoDiffToModel(resp), nil
+}
+
+// ExportResume is the resolver for the exportResume field.
+func (r *queryResolver) ExportResume(ctx context.Context, id string, format model.ResumeFormat) (*model.ExportedResume, error) {
+	resp, err := r.PersistenceClient.Client.ExportResume(ctx, &pb.ExportResumeRequest{
+		Id:     id,
+		Format: resumeFormats[format],
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to export resume: %w", err)
+	}
+
+	return &model.ExportedResume{
+		Content:     resp.Content,
+		ContentType: resp.ContentType,
+		Filename:    resp.Filename,
+	}, nil
+}
+
+// Application is the resolver for the application field.
+func (r *queryResolver) Application(ctx context.Context, id string) (*model.Application, error) {
+	resp, err := r.PersistenceClient.Client.GetApplication(ctx, &pb.GetApplicationRequest{
+		Id: id,
+	})
+	if status.Code(err) == codes.NotFound {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get application: %w", err)
+	}
+
+	return mapProtoApplicationToModel(resp), nil
+}
+
+// Applications is the resolver for the applications field.
+func (r *queryResolver) Applications(ctx context.Context, status []model.ApplicationStatus, resumeID *string) ([]*model.Application, error) {
+	req := &pb.ListApplicationsRequest{
+		ResumeId: getStringValue(resumeID),
+	}
+	for _, st := range status {
+		req.Statuses = append(req.Statuses, applicationStatuses[st])
+	}
+
+	resp, err := r.PersistenceClient.Client.ListApplications(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list applications: %w", err)
+	}
+
+	var results []*model.Application
+	for _, app := range resp.Applications {
+		results = append(results, mapProtoApplicationToModel(app))
 	}
 	return results, nil
 }
 
+// JobDescription is the resolver for the jobDescription field.
+func (r *queryResolver) JobDescription(ctx context.Context, id string) (*model.JobDescription, error) {
+	resp, err := r.PersistenceClient.Client.GetJobDescription(ctx, &pb.GetJobDescriptionRequest{
+		Id: id,
+	})
+	if status.Code(err) == codes.NotFound {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get job description: %w", err)
+	}
+
+	return mapProtoJobDescriptionToModel(resp), nil
+}
+
+// JobDescriptions is the resolver for the jobDescriptions field.
+func (r *queryResolver) JobDescriptions(ctx context.Context, company *string) ([]*model.JobDescription, error) {
+	resp, err := r.PersistenceClient.Client.ListJobDescriptions(ctx, &pb.ListJobDescriptionsRequest{
+		Company: getStringValue(company),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list job descriptions: %w", err)
+	}
+
+	var results []*model.JobDescription
+	for _, job := range resp.JobDescriptions {
+		results = append(results, mapProtoJobDescriptionToModel(job))
+	}
+	return results, nil
+}
+
+// ScoreHistory is the resolver for the scoreHistory field.
+func (r *queryResolver) ScoreHistory(ctx context.Context, resumeID string, jobID string) ([]*model.ATSAnalysis, error) {
+	resp, err := r.PersistenceClient.Client.ListAnalyses(ctx, &pb.ListAnalysesRequest{
+		ResumeId:         resumeID,
+		JobDescriptionId: jobID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get score history: %w", err)
+	}
+
+	var results []*model.ATSAnalysis
+	for _, a := range resp.Analyses {
+		results = append(results, mapProtoAnalysisToModel(a))
+	}
+	return results, nil
+}
+
+// CoverLetters is the resolver for the coverLetters field.
+func (r *queryResolver) CoverLetters(ctx context.Context, links *model.DocumentLinksInput) ([]*model.CoverLetter, error) {
+	return r.listCoverLetters(ctx, mapDocumentLinksInput(links))
+}
+
+// QuestionSets is the resolver for the questionSets field.
+func (r *queryResolver) QuestionSets(ctx context.Context, links *model.DocumentLinksInput) ([]*model.QuestionSet, error) {
+	return r.listQuestionSets(ctx, mapDocumentLinksInput(links))
+}
+
+// Revisions is the resolver for the revisions field.
+func (r *savedResumeResolver) Revisions(ctx context.Context, obj *model.SavedResume) ([]*model.ResumeRevision, error) {
+	resp, err := r.PersistenceClient.Client.ListResumeRevisions(ctx, &pb.ListResumeRevisionsRequest{
+		ResumeId: obj.ID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list resume revisions: %w", err)
+	}
+
+	var results []*model.ResumeRevision
+	for _, rev := range resp.Revisions {
+		results = append(results, mapProtoRevisionToModel(rev))
+	}
+	return results, nil
+}
+
+// Revision is the resolver for the revision field.
+func (r *savedResumeResolver) Revision(ctx context.Context, obj *model.SavedResume, number int32) (*model.ResumeRevision, error) {
+	resp, err := r.PersistenceClient.Client.GetResumeRevision(ctx, &pb.GetResumeRevisionRequest{
+		ResumeId: obj.ID,
+		Revision: number,
+	})
+	if status.Code(err) == codes.NotFound {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get resume revision: %w", err)
+	}
+
+	return mapProtoRevisionToModel(resp), nil
+}
+
+// Application returns ApplicationResolver implementation.
+func (r *Resolver) Application() ApplicationResolver { return &applicationResolver{r} }
+
 // Mutation returns MutationResolver implementation.
 func (r *Resolver) Mutation() MutationResolver { return &mutationResolver{r} }
 
 // Query returns QueryResolver implementation.
 func (r *Resolver) Query() QueryResolver { return &queryResolver{r} }
 
+// SavedResume returns SavedResumeResolver implementation.
+func (r *Resolver) SavedResume() SavedResumeResolver { return &savedResumeResolver{r} }
+
+type applicationResolver struct{ *Resolver }
 type mutationResolver struct{ *Resolver }
 type queryResolver struct{ *Resolver }
+type savedResumeResolver struct{ *Resolver }
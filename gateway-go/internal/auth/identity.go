@@ -0,0 +1,62 @@
+package auth
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// Metadata keys used to propagate the caller's identity to the gRPC backends.
+const (
+	SubjectKey = "x-user-id"
+	EmailKey   = "x-user-email"
+	NameKey    = "x-user-name"
+)
+
+// ServiceTokenKey is the metadata key of the secret shared with the resume
+// service, which only trusts identity metadata from callers that know it.
+const ServiceTokenKey = "x-service-token"
+
+// AnonymousSubject is the subject the resume service gives callers without
+// one. It owns everything saved while authentication was disabled, so no
+// token may claim it.
+const AnonymousSubject = "anonymous"
+
+// User is the authenticated caller of a GraphQL request.
+type User struct {
+	Subject string
+	Email   string
+	Name    string
+}
+
+type contextKey struct{}
+
+// WithUser returns a context carrying the authenticated user.
+func WithUser(ctx context.Context, user *User) context.Context {
+	return context.WithValue(ctx, contextKey{}, user)
+}
+
+// UserFromContext returns the authenticated user, or nil for anonymous requests.
+func UserFromContext(ctx context.Context) *User {
+	user, _ := ctx.Value(contextKey{}).(*User)
+	return user
+}
+
+// UnaryClientInterceptor forwards the user in the call's context as outgoing
+// metadata, along with serviceToken when it is set.
+func UnaryClientInterceptor(serviceToken string) grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		if serviceToken != "" {
+			ctx = metadata.AppendToOutgoingContext(ctx, ServiceTokenKey, serviceToken)
+		}
+		if user := UserFromContext(ctx); user != nil {
+			ctx = metadata.AppendToOutgoingContext(ctx,
+				SubjectKey, user.Subject,
+				EmailKey, user.Email,
+				NameKey, user.Name,
+			)
+		}
+		return invoker(ctx, method, req, reply, cc, opts...)
+	}
+}
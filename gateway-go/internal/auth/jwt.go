@@ -0,0 +1,165 @@
+package auth
+
+import (
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"os"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// Claims are the JWT claims the gateway understands.
+type Claims struct {
+	Email string `json:"email,omitempty"`
+	Name  string `json:"name,omitempty"`
+	jwt.RegisteredClaims
+}
+
+// Authenticator validates HS256 and RS256 bearer tokens.
+type Authenticator struct {
+	hmacSecret []byte
+	rsaKeys    map[string]*rsa.PublicKey // keyed by "kid"; "" matches tokens without one
+	parser     *jwt.Parser
+}
+
+// NewAuthenticatorFromEnv builds an Authenticator from the environment:
+//
+//	JWT_HS256_SECRET          shared secret for HS256 tokens
+//	JWT_RS256_PUBLIC_KEY_FILE PEM encoded RSA public key for RS256 tokens
+//	JWT_JWKS_FILE             local JWKS file with RSA keys for RS256 tokens
+//	JWT_ISSUER, JWT_AUDIENCE  expected "iss" and "aud" claims (optional)
+//
+// It returns nil when no key is configured, which disables authentication.
+func NewAuthenticatorFromEnv() (*Authenticator, error) {
+	a := &Authenticator{rsaKeys: make(map[string]*rsa.PublicKey)}
+
+	if secret := os.Getenv("JWT_HS256_SECRET"); secret != "" {
+		a.hmacSecret = []byte(secret)
+	}
+
+	if path := os.Getenv("JWT_RS256_PUBLIC_KEY_FILE"); path != "" {
+		pemBytes, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read RS256 public key: %w", err)
+		}
+		key, err := jwt.ParseRSAPublicKeyFromPEM(pemBytes)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse RS256 public key: %w", err)
+		}
+		a.rsaKeys[""] = key
+	}
+
+	if path := os.Getenv("JWT_JWKS_FILE"); path != "" {
+		if err := a.loadJWKS(path); err != nil {
+			return nil, err
+		}
+	}
+
+	if a.hmacSecret == nil && len(a.rsaKeys) == 0 {
+		return nil, nil
+	}
+
+	var algs []string
+	if a.hmacSecret != nil {
+		algs = append(algs, jwt.SigningMethodHS256.Alg())
+	}
+	if len(a.rsaKeys) > 0 {
+		algs = append(algs, jwt.SigningMethodRS256.Alg())
+	}
+
+	opts := []jwt.ParserOption{jwt.WithValidMethods(algs), jwt.WithExpirationRequired()}
+	if iss := os.Getenv("JWT_ISSUER"); iss != "" {
+		opts = append(opts, jwt.WithIssuer(iss))
+	}
+	if aud := os.Getenv("JWT_AUDIENCE"); aud != "" {
+		opts = append(opts, jwt.WithAudience(aud))
+	}
+	a.parser = jwt.NewParser(opts...)
+
+	return a, nil
+}
+
+// Authenticate validates a raw token and returns the user it identifies.
+func (a *Authenticator) Authenticate(token string) (*User, error) {
+	var claims Claims
+	if _, err := a.parser.ParseWithClaims(token, &claims, a.key); err != nil {
+		return nil, err
+	}
+	if claims.Subject == "" {
+		return nil, errors.New("token has no subject")
+	}
+	if claims.Subject == AnonymousSubject {
+		return nil, fmt.Errorf("token subject %q is reserved", AnonymousSubject)
+	}
+
+	return &User{
+		Subject: claims.Subject,
+		Email:   claims.Email,
+		Name:    claims.Name,
+	}, nil
+}
+
+// key selects the verification key for a token based on its algorithm and "kid".
+func (a *Authenticator) key(token *jwt.Token) (any, error) {
+	switch token.Method.Alg() {
+	case jwt.SigningMethodHS256.Alg():
+		return a.hmacSecret, nil
+	case jwt.SigningMethodRS256.Alg():
+		kid, _ := token.Header["kid"].(string)
+		if key, ok := a.rsaKeys[kid]; ok {
+			return key, nil
+		}
+		if key, ok := a.rsaKeys[""]; ok {
+			return key, nil
+		}
+		return nil, fmt.Errorf("unknown signing key %q", kid)
+	default:
+		return nil, fmt.Errorf("unexpected signing method %s", token.Method.Alg())
+	}
+}
+
+type jwks struct {
+	Keys []struct {
+		Kty string `json:"kty"`
+		Kid string `json:"kid"`
+		Use string `json:"use"`
+		N   string `json:"n"`
+		E   string `json:"e"`
+	} `json:"keys"`
+}
+
+// loadJWKS adds the RSA signing keys of a JWKS file. Other key types are skipped.
+func (a *Authenticator) loadJWKS(path string) error {
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("failed to read JWKS file: %w", err)
+	}
+
+	var set jwks
+	if err := json.Unmarshal(raw, &set); err != nil {
+		return fmt.Errorf("failed to parse JWKS file: %w", err)
+	}
+
+	for _, k := range set.Keys {
+		if k.Kty != "RSA" || (k.Use != "" && k.Use != "sig") {
+			continue
+		}
+		n, err := base64.RawURLEncoding.DecodeString(k.N)
+		if err != nil {
+			return fmt.Errorf("invalid modulus for JWKS key %q: %w", k.Kid, err)
+		}
+		e, err := base64.RawURLEncoding.DecodeString(k.E)
+		if err != nil {
+			return fmt.Errorf("invalid exponent for JWKS key %q: %w", k.Kid, err)
+		}
+		a.rsaKeys[k.Kid] = &rsa.PublicKey{
+			N: new(big.Int).SetBytes(n),
+			E: int(new(big.Int).SetBytes(e).Int64()),
+		}
+	}
+	return nil
+}
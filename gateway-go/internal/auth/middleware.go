@@ -0,0 +1,60 @@
+package auth
+
+import (
+	"log"
+	"net/http"
+	"strings"
+)
+
+// Middleware authenticates "Authorization: Bearer <token>" headers and stores
+// the user in the request context. Requests without a token continue
+// anonymously; requests with an invalid token are rejected. A nil
+// Authenticator lets every request through unauthenticated.
+func Middleware(a *Authenticator) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		if a == nil {
+			return next
+		}
+
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			header := r.Header.Get("Authorization")
+			if header == "" {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			token, ok := strings.CutPrefix(header, "Bearer ")
+			if !ok {
+				http.Error(w, "unsupported authorization scheme", http.StatusUnauthorized)
+				return
+			}
+
+			user, err := a.Authenticate(strings.TrimSpace(token))
+			if err != nil {
+				log.Printf("Rejected token: %v", err)
+				http.Error(w, "invalid token", http.StatusUnauthorized)
+				return
+			}
+
+			next.ServeHTTP(w, r.WithContext(WithUser(r.Context(), user)))
+		})
+	}
+}
+
+// RequireUser rejects requests that Middleware let through anonymously. A
+// nil Authenticator lets every request through, as Middleware does.
+func RequireUser(a *Authenticator) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		if a == nil {
+			return next
+		}
+
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if UserFromContext(r.Context()) == nil {
+				http.Error(w, "authentication required", http.StatusUnauthorized)
+				return
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
 
+	"github.com/iprotoresume/gateway-go/internal/auth"
 	pb "github.com/iprotoresume/shared/proto"
 )
 
@@ -20,13 +21,21 @@ type PersistenceClient struct {
 	Connection *grpc.ClientConn
 }
 
+type ATSClient struct {
+	Client     pb.ATSServiceClient
+	Connection *grpc.ClientConn
+}
+
 func NewAIClient() (*AIClient, error) {
 	addr := os.Getenv("AI_SERVICE_URL")
 	if addr == "" {
 		addr = "127.0.0.1:50051"
 	}
 
-	conn, err := grpc.Dial(addr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	conn, err := grpc.Dial(addr,
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithUnaryInterceptor(auth.UnaryClientInterceptor(os.Getenv("SERVICE_TOKEN"))),
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -46,7 +55,10 @@ func NewPersistenceClient() (*PersistenceClient, error) {
 		addr = "127.0.0.1:50053"
 	}
 
-	conn, err := grpc.Dial(addr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	conn, err := grpc.Dial(addr,
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithUnaryInterceptor(auth.UnaryClientInterceptor(os.Getenv("SERVICE_TOKEN"))),
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -59,3 +71,26 @@ func NewPersistenceClient() (*PersistenceClient, error) {
 		Connection: conn,
 	}, nil
 }
+
+func NewATSClient() (*ATSClient, error) {
+	addr := os.Getenv("ATS_SERVICE_URL")
+	if addr == "" {
+		addr = "127.0.0.1:50053"
+	}
+
+	conn, err := grpc.Dial(addr,
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithUnaryInterceptor(auth.UnaryClientInterceptor(os.Getenv("SERVICE_TOKEN"))),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	client := pb.NewATSServiceClient(conn)
+	log.Printf("Connected to ATS Service at %s", addr)
+
+	return &ATSClient{
+		Client:     client,
+		Connection: conn,
+	}, nil
+}
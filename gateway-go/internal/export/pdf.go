@@ -0,0 +1,79 @@
+package export
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	pb "github.com/iprotoresume/shared/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const renderTimeout = 30 * time.Second
+
+// PDFHandler serves GET /export/{file} where file is "<resume id>.pdf". The
+// optional "template" and "revision" query parameters select the layout and
+// a past revision of the resume.
+func PDFHandler(client pb.ResumePersistenceServiceClient) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		id, ok := strings.CutSuffix(r.PathValue("file"), ".pdf")
+		if !ok || id == "" {
+			http.NotFound(w, r)
+			return
+		}
+
+		var revision int64
+		if v := r.URL.Query().Get("revision"); v != "" {
+			var err error
+			if revision, err = strconv.ParseInt(v, 10, 32); err != nil || revision < 0 {
+				http.Error(w, "invalid revision", http.StatusBadRequest)
+				return
+			}
+		}
+
+		ctx, cancel := context.WithTimeout(r.Context(), renderTimeout)
+		defer cancel()
+
+		res, err := client.RenderResume(ctx, &pb.RenderResumeRequest{
+			Resume:   &pb.ResumeRef{ResumeId: id, Revision: int32(revision)},
+			Template: r.URL.Query().Get("template"),
+		})
+		if err != nil {
+			writeError(w, err)
+			return
+		}
+
+		w.Header().Set("Content-Type", res.ContentType)
+		w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", res.Filename))
+		w.Header().Set("Content-Length", strconv.Itoa(len(res.Content)))
+		if _, err := w.Write(res.Content); err != nil {
+			log.Printf("failed to write PDF for resume %s: %v", id, err)
+		}
+	})
+}
+
+// writeError maps a gRPC error onto the closest HTTP status.
+func writeError(w http.ResponseWriter, err error) {
+	st := status.Convert(err)
+	code := http.StatusInternalServerError
+	switch st.Code() {
+	case codes.NotFound:
+		code = http.StatusNotFound
+	case codes.InvalidArgument:
+		code = http.StatusBadRequest
+	case codes.Unauthenticated:
+		code = http.StatusUnauthorized
+	case codes.PermissionDenied:
+		code = http.StatusForbidden
+	case codes.DeadlineExceeded:
+		code = http.StatusGatewayTimeout
+	default:
+		log.Printf("failed to render resume: %v", err)
+	}
+	http.Error(w, st.Message(), code)
+}
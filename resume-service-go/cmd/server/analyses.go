@@ -0,0 +1,92 @@
+package main
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/iprotoresume/resume-service-go/internal/models"
+	pb "github.com/iprotoresume/shared/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *server) SaveAnalysis(ctx context.Context, req *pb.SaveAnalysisRequest) (*pb.Analysis, error) {
+	in := req.Analysis
+	if in == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "analysis is required")
+	}
+	if in.Score < 0 || in.Score > 100 {
+		return nil, status.Errorf(codes.InvalidArgument, "score must be between 0 and 100, got %d", in.Score)
+	}
+	if _, ok := pb.AnalysisSource_name[int32(in.Source)]; !ok || in.Source == pb.AnalysisSource_ANALYSIS_SOURCE_UNSPECIFIED {
+		return nil, status.Errorf(codes.InvalidArgument, "unknown analysis source: %v", in.Source)
+	}
+
+	// Both lookups also check that the caller owns the resume and the job description.
+	if _, err := s.GetResumeRevision(ctx, &pb.GetResumeRevisionRequest{ResumeId: in.ResumeId, Revision: in.ResumeRevision}); err != nil {
+		return nil, err
+	}
+	if _, err := s.GetJobDescription(ctx, &pb.GetJobDescriptionRequest{Id: in.JobDescriptionId}); err != nil {
+		return nil, err
+	}
+
+	analysis := models.Analysis{
+		OwnerID:          ownerID(ctx),
+		ResumeID:         uuid.MustParse(in.ResumeId),
+		ResumeRevision:   in.ResumeRevision,
+		JobDescriptionID: uuid.MustParse(in.JobDescriptionId),
+		Score:            in.Score,
+		Feedback:         in.Feedback,
+		MissingKeywords:  in.MissingKeywords,
+		Reasoning:        in.Reasoning,
+		Source:           strings.ToLower(strings.TrimPrefix(in.Source.String(), "ANALYSIS_SOURCE_")),
+	}
+	if err := s.DB.WithContext(ctx).Create(&analysis).Error; err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to save analysis: %v", err)
+	}
+
+	return toProtoAnalysis(&analysis), nil
+}
+
+func (s *server) ListAnalyses(ctx context.Context, req *pb.ListAnalysesRequest) (*pb.ListAnalysesResponse, error) {
+	resumeID, err := parseResumeID(req.ResumeId)
+	if err != nil {
+		return nil, err
+	}
+	jobID, err := parseJobDescriptionID(req.JobDescriptionId)
+	if err != nil {
+		return nil, err
+	}
+
+	var analyses []models.Analysis
+	err = s.DB.WithContext(ctx).Scopes(ownedIn(ctx, "analyses")).
+		Where("resume_id = ? AND job_description_id = ?", resumeID, jobID).
+		Order("created_at asc").
+		Find(&analyses).Error
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list analyses: %v", err)
+	}
+
+	var response []*pb.Analysis
+	for i := range analyses {
+		response = append(response, toProtoAnalysis(&analyses[i]))
+	}
+	return &pb.ListAnalysesResponse{Analyses: response}, nil
+}
+
+func toProtoAnalysis(a *models.Analysis) *pb.Analysis {
+	return &pb.Analysis{
+		Id:               a.ID.String(),
+		ResumeId:         a.ResumeID.String(),
+		ResumeRevision:   a.ResumeRevision,
+		JobDescriptionId: a.JobDescriptionID.String(),
+		Score:            a.Score,
+		Feedback:         a.Feedback,
+		MissingKeywords:  a.MissingKeywords,
+		Reasoning:        a.Reasoning,
+		Source:           pb.AnalysisSource(pb.AnalysisSource_value["ANALYSIS_SOURCE_"+strings.ToUpper(a.Source)]),
+		CreatedAt:        a.CreatedAt.Format(time.RFC3339),
+	}
+}
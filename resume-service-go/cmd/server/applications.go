@@ -0,0 +1,262 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/iprotoresume/resume-service-go/internal/models"
+	pb "github.com/iprotoresume/shared/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+func (s *server) CreateApplication(ctx context.Context, req *pb.CreateApplicationRequest) (*pb.Application, error) {
+	in := req.Application
+	if in == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "application is required")
+	}
+
+	app := models.Application{
+		OwnerID: ownerID(ctx),
+		Status:  applicationStatusName(pb.ApplicationStatus_APPLICATION_STATUS_APPLIED),
+	}
+	if err := s.applyApplication(ctx, &app, in); err != nil {
+		return nil, err
+	}
+	if in.AppliedAt == "" {
+		app.AppliedAt = time.Now()
+	}
+	app.History = []models.ApplicationStatusChange{{Status: app.Status, ChangedAt: time.Now()}}
+
+	if err := s.DB.WithContext(ctx).Create(&app).Error; err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to create application: %v", err)
+	}
+	return toProtoApplication(&app), nil
+}
+
+func (s *server) GetApplication(ctx context.Context, req *pb.GetApplicationRequest) (*pb.Application, error) {
+	app, err := s.loadApplication(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+	return toProtoApplication(app), nil
+}
+
+func (s *server) ListApplications(ctx context.Context, req *pb.ListApplicationsRequest) (*pb.ListApplicationsResponse, error) {
+	query := s.DB.WithContext(ctx).Scopes(ownedIn(ctx, "applications"), withHistory)
+
+	if len(req.Statuses) > 0 {
+		var names []string
+		for _, st := range req.Statuses {
+			names = append(names, applicationStatusName(st))
+		}
+		query = query.Where("status IN ?", names)
+	}
+	if req.ResumeId != "" {
+		resumeID, err := parseResumeID(req.ResumeId)
+		if err != nil {
+			return nil, err
+		}
+		query = query.Where("resume_id = ?", resumeID)
+	}
+
+	var apps []models.Application
+	if err := query.Order("updated_at desc").Find(&apps).Error; err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list applications: %v", err)
+	}
+
+	var response []*pb.Application
+	for i := range apps {
+		response = append(response, toProtoApplication(&apps[i]))
+	}
+	return &pb.ListApplicationsResponse{Applications: response}, nil
+}
+
+func (s *server) UpdateApplication(ctx context.Context, req *pb.UpdateApplicationRequest) (*pb.Application, error) {
+	if req.Application == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "application is required")
+	}
+
+	app, err := s.loadApplication(ctx, req.Application.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	previousStatus := app.Status
+	if err := s.applyApplication(ctx, app, req.Application); err != nil {
+		return nil, err
+	}
+
+	err = s.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Omit(clause.Associations).Save(app).Error; err != nil {
+			return err
+		}
+		if app.Status == previousStatus {
+			return nil
+		}
+
+		change := models.ApplicationStatusChange{
+			ApplicationID: app.ID,
+			Status:        app.Status,
+			Note:          req.StatusNote,
+			ChangedAt:     time.Now(),
+		}
+		if err := tx.Create(&change).Error; err != nil {
+			return err
+		}
+		app.History = append(app.History, change)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to update application: %v", err)
+	}
+
+	return toProtoApplication(app), nil
+}
+
+func (s *server) DeleteApplication(ctx context.Context, req *pb.DeleteApplicationRequest) (*pb.DeleteApplicationResponse, error) {
+	id, err := parseApplicationID(req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	result := s.DB.WithContext(ctx).Scopes(ownedIn(ctx, "applications")).Delete(&models.Application{}, "id = ?", id)
+	if result.Error != nil {
+		return nil, status.Errorf(codes.Internal, "failed to delete application: %v", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return nil, status.Errorf(codes.NotFound, "application not found with ID: %s", req.Id)
+	}
+
+	return &pb.DeleteApplicationResponse{Success: true}, nil
+}
+
+// applyApplication copies the editable fields of in onto app. A linked
+// resume must belong to the caller. When it is first linked its revision
+// defaults to the latest; an unchanged link keeps the revision it records,
+// even once the resume has been edited or moved to the trash.
+func (s *server) applyApplication(ctx context.Context, app *models.Application, in *pb.Application) error {
+	if strings.TrimSpace(in.Company) == "" || strings.TrimSpace(in.Role) == "" {
+		return status.Errorf(codes.InvalidArgument, "company and role are required")
+	}
+
+	app.Company = in.Company
+	app.Role = in.Role
+	app.JobDescription = in.JobDescription
+	app.URL = in.Url
+	app.Notes = in.Notes
+
+	if in.Status != pb.ApplicationStatus_APPLICATION_STATUS_UNSPECIFIED {
+		if _, ok := pb.ApplicationStatus_name[int32(in.Status)]; !ok {
+			return status.Errorf(codes.InvalidArgument, "unknown application status: %v", in.Status)
+		}
+		app.Status = applicationStatusName(in.Status)
+	}
+
+	if in.AppliedAt != "" {
+		appliedAt, err := time.Parse(time.RFC3339, in.AppliedAt)
+		if err != nil {
+			return status.Errorf(codes.InvalidArgument, "invalid applied_at: %v", err)
+		}
+		app.AppliedAt = appliedAt
+	}
+
+	if in.ResumeId == "" {
+		app.ResumeID, app.ResumeRevision = nil, 0
+		return nil
+	}
+	if id, err := uuid.Parse(in.ResumeId); err == nil && app.ResumeID != nil && id == *app.ResumeID &&
+		(in.ResumeRevision == 0 || in.ResumeRevision == app.ResumeRevision) {
+		return nil
+	}
+	savedResume, err := s.GetResume(ctx, &pb.GetResumeRequest{Id: in.ResumeId})
+	if err != nil {
+		return err
+	}
+	if in.ResumeRevision > savedResume.Revision || in.ResumeRevision < 0 {
+		return status.Errorf(codes.InvalidArgument, "resume %s has no revision %d", in.ResumeId, in.ResumeRevision)
+	}
+
+	resumeID := uuid.MustParse(savedResume.Id)
+	app.ResumeID = &resumeID
+	app.ResumeRevision = in.ResumeRevision
+	if app.ResumeRevision == 0 {
+		app.ResumeRevision = savedResume.Revision
+	}
+	return nil
+}
+
+func (s *server) loadApplication(ctx context.Context, id string) (*models.Application, error) {
+	appID, err := parseApplicationID(id)
+	if err != nil {
+		return nil, err
+	}
+
+	var app models.Application
+	err = s.DB.WithContext(ctx).Scopes(ownedIn(ctx, "applications"), withHistory).First(&app, "id = ?", appID).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, status.Errorf(codes.NotFound, "application not found with ID: %s", id)
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get application: %v", err)
+	}
+	return &app, nil
+}
+
+// withHistory preloads the status history of applications, oldest first.
+func withHistory(db *gorm.DB) *gorm.DB {
+	return db.Preload("History", func(db *gorm.DB) *gorm.DB {
+		return db.Order("changed_at asc")
+	})
+}
+
+func toProtoApplication(a *models.Application) *pb.Application {
+	app := &pb.Application{
+		Id:             a.ID.String(),
+		ResumeRevision: a.ResumeRevision,
+		Company:        a.Company,
+		Role:           a.Role,
+		JobDescription: a.JobDescription,
+		Url:            a.URL,
+		Status:         parseApplicationStatus(a.Status),
+		AppliedAt:      a.AppliedAt.Format(time.RFC3339),
+		Notes:          a.Notes,
+		CreatedAt:      a.CreatedAt.Format(time.RFC3339),
+		UpdatedAt:      a.UpdatedAt.Format(time.RFC3339),
+	}
+	if a.ResumeID != nil {
+		app.ResumeId = a.ResumeID.String()
+	}
+	for _, h := range a.History {
+		app.History = append(app.History, &pb.ApplicationStatusChange{
+			Status:    parseApplicationStatus(h.Status),
+			Note:      h.Note,
+			ChangedAt: h.ChangedAt.Format(time.RFC3339),
+		})
+	}
+	return app
+}
+
+// applicationStatusName converts APPLICATION_STATUS_INTERVIEW to "interview",
+// the form stored in the database.
+func applicationStatusName(st pb.ApplicationStatus) string {
+	return strings.ToLower(strings.TrimPrefix(st.String(), "APPLICATION_STATUS_"))
+}
+
+func parseApplicationStatus(name string) pb.ApplicationStatus {
+	return pb.ApplicationStatus(pb.ApplicationStatus_value["APPLICATION_STATUS_"+strings.ToUpper(name)])
+}
+
+// parseApplicationID parses an application UUID, returning an InvalidArgument status on failure.
+func parseApplicationID(id string) (uuid.UUID, error) {
+	parsed, err := uuid.Parse(id)
+	if err != nil {
+		return uuid.Nil, status.Errorf(codes.InvalidArgument, "invalid application ID: %v", err)
+	}
+	return parsed, nil
+}
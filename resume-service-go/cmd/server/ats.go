@@ -0,0 +1,77 @@
+package main
+
+import (
+	"context"
+	"log"
+	"os"
+	"strings"
+
+	"github.com/iprotoresume/resume-service-go/internal/scorer"
+	pb "github.com/iprotoresume/shared/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// atsServer implements ATSService with the deterministic keyword scorer,
+// so ATS checks work without the Python AI service.
+type atsServer struct {
+	pb.UnimplementedATSServiceServer
+}
+
+func (s *atsServer) ValidateResume(ctx context.Context, req *pb.ValidationRequest) (*pb.ATSScore, error) {
+	if req.Resume == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "resume is required")
+	}
+	if strings.TrimSpace(req.JobDescription) == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "job description is required")
+	}
+
+	result := scorer.Calculate(req.Resume, req.JobDescription)
+	log.Printf("Scored resume for %s: %d", req.Resume.FullName, result.Score)
+
+	score := &pb.ATSScore{
+		Score:           result.Score,
+		Feedback:        result.Feedback,
+		MissingKeywords: result.MissingKeywords,
+		Reasoning:       result.Reasoning,
+		Language:        result.Language,
+		ResumeLanguage:  result.ResumeLanguage,
+	}
+	for _, section := range result.Sections {
+		score.Sections = append(score.Sections, &pb.SectionScore{
+			Section:         section.Section,
+			Score:           section.Score,
+			MatchedKeywords: section.MatchedKeywords,
+		})
+	}
+	return score, nil
+}
+
+// normalizeSkillGroups rewrites the skill group items of a resume being saved
+// to their canonical names in the skill taxonomy.
+func normalizeSkillGroups(r *pb.ResumeData) {
+	for _, sg := range r.GetSkillGroups() {
+		sg.Items = scorer.NormalizeSkills(sg.Items)
+	}
+}
+
+// loadTaxonomy replaces the scorer's embedded skill taxonomy with the file
+// named by SKILL_TAXONOMY_FILE, if set.
+func loadTaxonomy() error {
+	path := os.Getenv("SKILL_TAXONOMY_FILE")
+	if path == "" {
+		return nil
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	taxonomy, err := scorer.ParseTaxonomy(data)
+	if err != nil {
+		return err
+	}
+	scorer.SetTaxonomy(taxonomy)
+	log.Printf("Loaded skill taxonomy from %s", path)
+	return nil
+}
@@ -0,0 +1,51 @@
+package main
+
+import (
+	"context"
+
+	"github.com/iprotoresume/resume-service-go/internal/diff"
+	pb "github.com/iprotoresume/shared/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *server) DiffResumes(ctx context.Context, req *pb.DiffResumesRequest) (*pb.ResumeDiff, error) {
+	base, err := s.resolveResumeRef(ctx, req.Base)
+	if err != nil {
+		return nil, err
+	}
+	target, err := s.resolveResumeRef(ctx, req.Target)
+	if err != nil {
+		return nil, err
+	}
+
+	return diff.Resumes(base, target), nil
+}
+
+// resolveResumeRef returns the inline resume of ref, or loads the referenced
+// saved resume at the requested revision (the latest when revision is 0).
+func (s *server) resolveResumeRef(ctx context.Context, ref *pb.ResumeRef) (*pb.ResumeData, error) {
+	if ref == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "resume reference is required")
+	}
+	if ref.Resume != nil {
+		return ref.Resume, nil
+	}
+
+	if ref.Revision > 0 {
+		revision, err := s.GetResumeRevision(ctx, &pb.GetResumeRevisionRequest{
+			ResumeId: ref.ResumeId,
+			Revision: ref.Revision,
+		})
+		if err != nil {
+			return nil, err
+		}
+		return revision.ResumeData, nil
+	}
+
+	savedResume, err := s.GetResume(ctx, &pb.GetResumeRequest{Id: ref.ResumeId})
+	if err != nil {
+		return nil, err
+	}
+	return savedResume.ResumeData, nil
+}
@@ -0,0 +1,358 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/iprotoresume/resume-service-go/internal/models"
+	pb "github.com/iprotoresume/shared/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+func (s *server) SaveCoverLetter(ctx context.Context, req *pb.SaveCoverLetterRequest) (*pb.CoverLetter, error) {
+	in := req.CoverLetter
+	if in == nil || strings.TrimSpace(in.Content) == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "cover letter content is required")
+	}
+
+	links, err := s.resolveLinks(ctx, in.Links)
+	if err != nil {
+		return nil, err
+	}
+
+	letter := models.CoverLetter{
+		OwnerID:          ownerID(ctx),
+		ApplicationID:    links.applicationID,
+		ResumeID:         links.resumeID,
+		JobDescriptionID: links.jobID,
+		Content:          in.Content,
+	}
+	if err := s.DB.WithContext(ctx).Create(&letter).Error; err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to save cover letter: %v", err)
+	}
+
+	return toProtoCoverLetter(&letter), nil
+}
+
+func (s *server) UpdateCoverLetter(ctx context.Context, req *pb.UpdateCoverLetterRequest) (*pb.CoverLetter, error) {
+	id, err := parseDocumentID("cover letter", req.Id)
+	if err != nil {
+		return nil, err
+	}
+	if strings.TrimSpace(req.Content) == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "cover letter content is required")
+	}
+
+	var letter models.CoverLetter
+	err = s.DB.WithContext(ctx).Scopes(ownedIn(ctx, "cover_letters")).First(&letter, "id = ?", id).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, status.Errorf(codes.NotFound, "cover letter not found with ID: %s", req.Id)
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get cover letter: %v", err)
+	}
+
+	letter.Content = req.Content
+	if err := s.DB.WithContext(ctx).Save(&letter).Error; err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to update cover letter: %v", err)
+	}
+
+	return toProtoCoverLetter(&letter), nil
+}
+
+func (s *server) ListCoverLetters(ctx context.Context, req *pb.ListCoverLettersRequest) (*pb.ListCoverLettersResponse, error) {
+	filter, err := linkFilter(req.Links)
+	if err != nil {
+		return nil, err
+	}
+
+	var letters []models.CoverLetter
+	err = s.DB.WithContext(ctx).Scopes(ownedIn(ctx, "cover_letters"), filter).
+		Order("created_at desc").
+		Find(&letters).Error
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list cover letters: %v", err)
+	}
+
+	var response []*pb.CoverLetter
+	for i := range letters {
+		response = append(response, toProtoCoverLetter(&letters[i]))
+	}
+	return &pb.ListCoverLettersResponse{CoverLetters: response}, nil
+}
+
+func (s *server) DeleteCoverLetter(ctx context.Context, req *pb.DeleteCoverLetterRequest) (*pb.DeleteCoverLetterResponse, error) {
+	id, err := parseDocumentID("cover letter", req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	result := s.DB.WithContext(ctx).Scopes(ownedIn(ctx, "cover_letters")).Delete(&models.CoverLetter{}, "id = ?", id)
+	if result.Error != nil {
+		return nil, status.Errorf(codes.Internal, "failed to delete cover letter: %v", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return nil, status.Errorf(codes.NotFound, "cover letter not found with ID: %s", req.Id)
+	}
+
+	return &pb.DeleteCoverLetterResponse{Success: true}, nil
+}
+
+func (s *server) SaveQuestionSet(ctx context.Context, req *pb.SaveQuestionSetRequest) (*pb.QuestionSet, error) {
+	in := req.QuestionSet
+	if in == nil || len(in.Questions) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "at least one question is required")
+	}
+
+	links, err := s.resolveLinks(ctx, in.Links)
+	if err != nil {
+		return nil, err
+	}
+
+	set := models.QuestionSet{
+		OwnerID:          ownerID(ctx),
+		ApplicationID:    links.applicationID,
+		ResumeID:         links.resumeID,
+		JobDescriptionID: links.jobID,
+	}
+	for i, q := range in.Questions {
+		if strings.TrimSpace(q.Question) == "" {
+			return nil, status.Errorf(codes.InvalidArgument, "question %d is empty", i+1)
+		}
+		set.Questions = append(set.Questions, models.PracticeQuestion{
+			Position:    i,
+			Question:    q.Question,
+			Type:        q.Type,
+			AnswerGuide: q.AnswerGuide,
+			Practiced:   q.Practiced,
+			UserAnswer:  q.UserAnswer,
+		})
+	}
+
+	if err := s.DB.WithContext(ctx).Create(&set).Error; err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to save question set: %v", err)
+	}
+
+	return toProtoQuestionSet(&set), nil
+}
+
+func (s *server) ListQuestionSets(ctx context.Context, req *pb.ListQuestionSetsRequest) (*pb.ListQuestionSetsResponse, error) {
+	filter, err := linkFilter(req.Links)
+	if err != nil {
+		return nil, err
+	}
+
+	var sets []models.QuestionSet
+	err = s.DB.WithContext(ctx).Scopes(ownedIn(ctx, "question_sets"), filter).
+		Preload("Questions", func(db *gorm.DB) *gorm.DB {
+			return db.Order("position asc")
+		}).
+		Order("created_at desc").
+		Find(&sets).Error
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list question sets: %v", err)
+	}
+
+	var response []*pb.QuestionSet
+	for i := range sets {
+		response = append(response, toProtoQuestionSet(&sets[i]))
+	}
+	return &pb.ListQuestionSetsResponse{QuestionSets: response}, nil
+}
+
+func (s *server) UpdatePracticeQuestion(ctx context.Context, req *pb.UpdatePracticeQuestionRequest) (*pb.PracticeQuestion, error) {
+	id, err := parseDocumentID("question", req.Id)
+	if err != nil {
+		return nil, err
+	}
+	if strings.TrimSpace(req.Question) == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "question is required")
+	}
+
+	var question models.PracticeQuestion
+	err = s.DB.WithContext(ctx).
+		Joins("JOIN question_sets ON question_sets.id = practice_questions.question_set_id").
+		Scopes(ownedIn(ctx, "question_sets")).
+		First(&question, "practice_questions.id = ?", id).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, status.Errorf(codes.NotFound, "question not found with ID: %s", req.Id)
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get question: %v", err)
+	}
+
+	question.Question = req.Question
+	question.AnswerGuide = req.AnswerGuide
+	question.Practiced = req.Practiced
+	question.UserAnswer = req.UserAnswer
+
+	err = s.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Save(&question).Error; err != nil {
+			return err
+		}
+		return tx.Model(&models.QuestionSet{}).Where("id = ?", question.QuestionSetID).Update("updated_at", time.Now()).Error
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to update question: %v", err)
+	}
+
+	return toProtoPracticeQuestion(&question), nil
+}
+
+func (s *server) DeleteQuestionSet(ctx context.Context, req *pb.DeleteQuestionSetRequest) (*pb.DeleteQuestionSetResponse, error) {
+	id, err := parseDocumentID("question set", req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	result := s.DB.WithContext(ctx).Scopes(ownedIn(ctx, "question_sets")).Delete(&models.QuestionSet{}, "id = ?", id)
+	if result.Error != nil {
+		return nil, status.Errorf(codes.Internal, "failed to delete question set: %v", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return nil, status.Errorf(codes.NotFound, "question set not found with ID: %s", req.Id)
+	}
+
+	return &pb.DeleteQuestionSetResponse{Success: true}, nil
+}
+
+// documentLinks are the parsed links of a document to be saved.
+type documentLinks struct {
+	applicationID *uuid.UUID
+	resumeID      *uuid.UUID
+	jobID         *uuid.UUID
+}
+
+// resolveLinks parses the links of a document to be saved, checking that the
+// caller owns everything linked. A linked application's resume is used when
+// no resume is given.
+func (s *server) resolveLinks(ctx context.Context, in *pb.DocumentLinks) (documentLinks, error) {
+	var links documentLinks
+	if in == nil {
+		return links, nil
+	}
+
+	if in.ApplicationId != "" {
+		app, err := s.loadApplication(ctx, in.ApplicationId)
+		if err != nil {
+			return links, err
+		}
+		links.applicationID = &app.ID
+		links.resumeID = app.ResumeID
+	}
+	if in.ResumeId != "" {
+		resume, err := s.GetResume(ctx, &pb.GetResumeRequest{Id: in.ResumeId})
+		if err != nil {
+			return links, err
+		}
+		resumeID := uuid.MustParse(resume.Id)
+		links.resumeID = &resumeID
+	}
+	if in.JobDescriptionId != "" {
+		job, err := s.GetJobDescription(ctx, &pb.GetJobDescriptionRequest{Id: in.JobDescriptionId})
+		if err != nil {
+			return links, err
+		}
+		jobID := uuid.MustParse(job.Id)
+		links.jobID = &jobID
+	}
+	return links, nil
+}
+
+// linkFilter narrows a document query to the links that are set.
+func linkFilter(in *pb.DocumentLinks) (func(*gorm.DB) *gorm.DB, error) {
+	if in == nil {
+		in = &pb.DocumentLinks{}
+	}
+
+	var ids [3]*uuid.UUID
+	for i, link := range []struct{ kind, id string }{
+		{"application", in.ApplicationId},
+		{"resume", in.ResumeId},
+		{"job description", in.JobDescriptionId},
+	} {
+		if link.id == "" {
+			continue
+		}
+		id, err := parseDocumentID(link.kind, link.id)
+		if err != nil {
+			return nil, err
+		}
+		ids[i] = &id
+	}
+
+	return func(db *gorm.DB) *gorm.DB {
+		if ids[0] != nil {
+			db = db.Where("application_id = ?", *ids[0])
+		}
+		if ids[1] != nil {
+			db = db.Where("resume_id = ?", *ids[1])
+		}
+		if ids[2] != nil {
+			db = db.Where("job_description_id = ?", *ids[2])
+		}
+		return db
+	}, nil
+}
+
+func toProtoLinks(applicationID, resumeID, jobID *uuid.UUID) *pb.DocumentLinks {
+	links := &pb.DocumentLinks{}
+	if applicationID != nil {
+		links.ApplicationId = applicationID.String()
+	}
+	if resumeID != nil {
+		links.ResumeId = resumeID.String()
+	}
+	if jobID != nil {
+		links.JobDescriptionId = jobID.String()
+	}
+	return links
+}
+
+func toProtoCoverLetter(l *models.CoverLetter) *pb.CoverLetter {
+	return &pb.CoverLetter{
+		Id:        l.ID.String(),
+		Links:     toProtoLinks(l.ApplicationID, l.ResumeID, l.JobDescriptionID),
+		Content:   l.Content,
+		CreatedAt: l.CreatedAt.Format(time.RFC3339),
+		UpdatedAt: l.UpdatedAt.Format(time.RFC3339),
+	}
+}
+
+func toProtoQuestionSet(qs *models.QuestionSet) *pb.QuestionSet {
+	set := &pb.QuestionSet{
+		Id:        qs.ID.String(),
+		Links:     toProtoLinks(qs.ApplicationID, qs.ResumeID, qs.JobDescriptionID),
+		CreatedAt: qs.CreatedAt.Format(time.RFC3339),
+		UpdatedAt: qs.UpdatedAt.Format(time.RFC3339),
+	}
+	for i := range qs.Questions {
+		set.Questions = append(set.Questions, toProtoPracticeQuestion(&qs.Questions[i]))
+	}
+	return set
+}
+
+func toProtoPracticeQuestion(q *models.PracticeQuestion) *pb.PracticeQuestion {
+	return &pb.PracticeQuestion{
+		Id:          q.ID.String(),
+		Question:    q.Question,
+		Type:        q.Type,
+		AnswerGuide: q.AnswerGuide,
+		Practiced:   q.Practiced,
+		UserAnswer:  q.UserAnswer,
+	}
+}
+
+// parseDocumentID parses the UUID of a kind of record, returning an InvalidArgument status on failure.
+func parseDocumentID(kind, id string) (uuid.UUID, error) {
+	parsed, err := uuid.Parse(id)
+	if err != nil {
+		return uuid.Nil, status.Errorf(codes.InvalidArgument, "invalid %s ID: %v", kind, err)
+	}
+	return parsed, nil
+}
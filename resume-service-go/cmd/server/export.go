@@ -0,0 +1,79 @@
+package main
+
+import (
+	"context"
+	"regexp"
+	"strings"
+
+	"github.com/iprotoresume/resume-service-go/internal/importer"
+	"github.com/iprotoresume/resume-service-go/internal/jsonresume"
+	"github.com/iprotoresume/resume-service-go/internal/render"
+	pb "github.com/iprotoresume/shared/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var filenameUnsafe = regexp.MustCompile(`[^a-z0-9]+`)
+
+type exporter struct {
+	export      func(*pb.ResumeData) ([]byte, error)
+	contentType string
+	ext         string
+}
+
+var exporters = map[pb.ResumeFormat]exporter{
+	pb.ResumeFormat_RESUME_FORMAT_JSON_RESUME: {jsonresume.Export, "application/json", "json"},
+	pb.ResumeFormat_RESUME_FORMAT_MARKDOWN:    {render.Markdown, "text/markdown; charset=utf-8", "md"},
+	pb.ResumeFormat_RESUME_FORMAT_HTML:        {render.HTML, "text/html; charset=utf-8", "html"},
+	pb.ResumeFormat_RESUME_FORMAT_LATEX:       {render.LaTeX, "application/x-latex", "tex"},
+}
+
+func (s *server) ExportResume(ctx context.Context, req *pb.ExportResumeRequest) (*pb.ExportResumeResponse, error) {
+	savedResume, err := s.GetResume(ctx, &pb.GetResumeRequest{Id: req.Id})
+	if err != nil {
+		return nil, err
+	}
+
+	exporter, ok := exporters[req.Format]
+	if !ok {
+		return nil, status.Errorf(codes.InvalidArgument, "unsupported export format: %v", req.Format)
+	}
+
+	content, err := exporter.export(savedResume.ResumeData)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to export resume: %v", err)
+	}
+	return &pb.ExportResumeResponse{
+		Content:     string(content),
+		ContentType: exporter.contentType,
+		Filename:    exportFilename(savedResume.ResumeData, exporter.ext),
+	}, nil
+}
+
+func (s *server) ImportResume(ctx context.Context, req *pb.ImportResumeRequest) (*pb.ResumeData, error) {
+	switch req.Format {
+	case pb.ResumeFormat_RESUME_FORMAT_JSON_RESUME:
+		resume, err := jsonresume.Import([]byte(req.Content))
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+		}
+		return resume, nil
+	case pb.ResumeFormat_RESUME_FORMAT_LINKEDIN_EXPORT:
+		resume, err := importer.LinkedIn(req.File)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+		}
+		return resume, nil
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "unsupported import format: %v", req.Format)
+	}
+}
+
+// exportFilename derives a file name like "jane-doe-resume.json" from the resume owner's name.
+func exportFilename(resume *pb.ResumeData, ext string) string {
+	name := strings.Trim(filenameUnsafe.ReplaceAllString(strings.ToLower(resume.FullName), "-"), "-")
+	if name == "" {
+		return "resume." + ext
+	}
+	return name + "-resume." + ext
+}
@@ -0,0 +1,95 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/iprotoresume/resume-service-go/internal/models"
+	"github.com/iprotoresume/resume-service-go/internal/scorer"
+	pb "github.com/iprotoresume/shared/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+func (s *server) SaveJobDescription(ctx context.Context, req *pb.SaveJobDescriptionRequest) (*pb.JobDescription, error) {
+	in := req.JobDescription
+	if in == nil || strings.TrimSpace(in.Text) == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "job description text is required")
+	}
+
+	job := models.JobDescription{
+		OwnerID:   ownerID(ctx),
+		Title:     in.Title,
+		Company:   in.Company,
+		SourceURL: in.SourceUrl,
+		Text:      in.Text,
+	}
+	if err := s.DB.WithContext(ctx).Create(&job).Error; err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to save job description: %v", err)
+	}
+
+	return toProtoJobDescription(&job), nil
+}
+
+func (s *server) GetJobDescription(ctx context.Context, req *pb.GetJobDescriptionRequest) (*pb.JobDescription, error) {
+	id, err := parseJobDescriptionID(req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	var job models.JobDescription
+	err = s.DB.WithContext(ctx).Scopes(ownedIn(ctx, "job_descriptions")).First(&job, "id = ?", id).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, status.Errorf(codes.NotFound, "job description not found with ID: %s", req.Id)
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get job description: %v", err)
+	}
+
+	return toProtoJobDescription(&job), nil
+}
+
+func (s *server) ListJobDescriptions(ctx context.Context, req *pb.ListJobDescriptionsRequest) (*pb.ListJobDescriptionsResponse, error) {
+	query := s.DB.WithContext(ctx).Scopes(ownedIn(ctx, "job_descriptions"))
+	if company := strings.TrimSpace(req.Company); company != "" {
+		query = query.Where("lower(company) = lower(?)", company)
+	}
+
+	var jobs []models.JobDescription
+	if err := query.Order("created_at desc").Find(&jobs).Error; err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list job descriptions: %v", err)
+	}
+
+	var response []*pb.JobDescription
+	for i := range jobs {
+		response = append(response, toProtoJobDescription(&jobs[i]))
+	}
+	return &pb.ListJobDescriptionsResponse{JobDescriptions: response}, nil
+}
+
+// toProtoJobDescription converts a saved job description. Keywords are
+// extracted on every read so they always agree with what the scorer matches.
+func toProtoJobDescription(j *models.JobDescription) *pb.JobDescription {
+	return &pb.JobDescription{
+		Id:        j.ID.String(),
+		Title:     j.Title,
+		Company:   j.Company,
+		SourceUrl: j.SourceURL,
+		Text:      j.Text,
+		Keywords:  scorer.Keywords(j.Text),
+		CreatedAt: j.CreatedAt.Format(time.RFC3339),
+	}
+}
+
+// parseJobDescriptionID parses a job description UUID, returning an InvalidArgument status on failure.
+func parseJobDescriptionID(id string) (uuid.UUID, error) {
+	parsed, err := uuid.Parse(id)
+	if err != nil {
+		return uuid.Nil, status.Errorf(codes.InvalidArgument, "invalid job description ID: %v", err)
+	}
+	return parsed, nil
+}
@@ -2,9 +2,12 @@ package main
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"log"
 	"net"
 	"os"
+	"runtime/debug"
 	"time"
 
 	"github.com/google/uuid"
@@ -15,8 +18,10 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/protoadapt"
 	"gorm.io/driver/postgres"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 const (
@@ -32,69 +37,239 @@ type server struct {
 func (s *server) SaveResume(ctx context.Context, req *pb.SaveResumeRequest) (*pb.SavedResume, error) {
 	log.Printf("Saving resume version %s with tags %v", req.Version, req.Tags)
 
+	key := versionKey(req.Version)
+	if key == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "version is required")
+	}
+
+	normalizeSkillGroups(req.Resume)
 	resumeJson, err := protojson.Marshal(req.Resume)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to marshal resume data: %v", err)
 	}
 
-	// Check if a resume with the same version AND tags exists
-	var existingResume models.SavedResume
-	result := s.DB.Where("version = ? AND tags = ?", req.Version, pq.Array(req.Tags)).First(&existingResume)
+	var savedResume models.SavedResume
+	err = s.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// An explicit ID updates that resume; otherwise the version names it
+		query := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Scopes(ownedBy(ctx))
+		if req.Id != "" {
+			id, err := parseResumeID(req.Id)
+			if err != nil {
+				return err
+			}
+			query = query.Where("id = ?", id)
+		} else {
+			query = query.Where("version_key = ?", key)
+		}
+		result := query.First(&savedResume)
 
-	if result.Error == nil {
-		// Resume exists - update it
-		log.Printf("Updating existing resume with ID %s", existingResume.ID)
-		existingResume.ResumeData = resumeJson
-		existingResume.UpdatedAt = time.Now()
+		if result.Error == nil {
+			// Resume exists - update it
+			log.Printf("Updating existing resume with ID %s", savedResume.ID)
+			savedResume.UpdatedAt = time.Now()
+		} else if errors.Is(result.Error, gorm.ErrRecordNotFound) && req.Id == "" {
+			// Resume doesn't exist - create new
+			log.Printf("Creating new resume")
+			savedResume = models.SavedResume{OwnerID: ownerID(ctx)}
+		} else if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return status.Errorf(codes.NotFound, "resume not found with ID: %s", req.Id)
+		} else {
+			return result.Error
+		}
 
-		if err := s.DB.Save(&existingResume).Error; err != nil {
-			return nil, status.Errorf(codes.Internal, "failed to update resume: %v", err)
+		savedResume.ResumeData = resumeJson
+		savedResume.Tags = normalizeTags(req.Tags)
+		savedResume.Version = req.Version
+		savedResume.VersionKey = key
+		return appendRevision(tx, &savedResume)
+	})
+	if errors.Is(err, gorm.ErrDuplicatedKey) {
+		return nil, status.Errorf(codes.AlreadyExists, "another resume already has version %q", req.Version)
+	}
+	if err != nil {
+		if _, ok := status.FromError(err); ok {
+			return nil, err
 		}
+		return nil, status.Errorf(codes.Internal, "failed to save resume: %v", err)
+	}
 
-		return &pb.SavedResume{
-			Id:         existingResume.ID.String(),
-			ResumeData: req.Resume,
-			Tags:       existingResume.Tags,
-			Version:    existingResume.Version,
-			CreatedAt:  existingResume.CreatedAt.Format(time.RFC3339),
-		}, nil
+	return toProtoSavedResume(&savedResume, req.Resume), nil
+}
+
+// appendRevision bumps the resume's revision counter, saves it and records
+// its current data as a new immutable revision. It must run inside a transaction.
+func appendRevision(tx *gorm.DB, savedResume *models.SavedResume) error {
+	savedResume.Revision++
+	if err := tx.Save(savedResume).Error; err != nil {
+		return err
 	}
 
-	// Resume doesn't exist - create new
-	log.Printf("Creating new resume")
-	savedResume := models.SavedResume{
-		ResumeData: resumeJson,
-		Tags:       req.Tags,
-		Version:    req.Version,
+	return tx.Create(&models.ResumeRevision{
+		ResumeID:   savedResume.ID,
+		Revision:   savedResume.Revision,
+		ResumeData: savedResume.ResumeData,
+	}).Error
+}
+
+// toProtoSavedResume converts a stored resume to its protobuf form using already decoded resume data.
+func toProtoSavedResume(r *models.SavedResume, resumeData *pb.ResumeData) *pb.SavedResume {
+	saved := &pb.SavedResume{
+		Id:         r.ID.String(),
+		ResumeData: resumeData,
+		Tags:       r.Tags,
+		Version:    r.Version,
+		CreatedAt:  r.CreatedAt.Format(time.RFC3339),
+		Revision:   r.Revision,
+		UpdatedAt:  r.UpdatedAt.Format(time.RFC3339),
+		OwnerId:    r.OwnerID.String(),
+	}
+	if r.DeletedAt.Valid {
+		saved.DeletedAt = r.DeletedAt.Time.Format(time.RFC3339)
 	}
+	return saved
+}
 
-	if err := s.DB.Create(&savedResume).Error; err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to save to database: %v", err)
+func (s *server) UpdateResume(ctx context.Context, req *pb.UpdateResumeRequest) (*pb.SavedResume, error) {
+	id, err := parseResumeID(req.Id)
+	if err != nil {
+		return nil, err
+	}
+	if req.Resume == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "resume is required")
 	}
 
-	return &pb.SavedResume{
-		Id:         savedResume.ID.String(),
-		ResumeData: req.Resume,
-		Tags:       savedResume.Tags,
-		Version:    savedResume.Version,
-		CreatedAt:  savedResume.CreatedAt.Format(time.RFC3339),
-	}, nil
+	normalizeSkillGroups(req.Resume)
+	resumeJson, err := protojson.Marshal(req.Resume)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to marshal resume data: %v", err)
+	}
+
+	var savedResume models.SavedResume
+	err = s.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Scopes(ownedBy(ctx)).First(&savedResume, "id = ?", id).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return status.Errorf(codes.NotFound, "resume not found with ID: %s", req.Id)
+			}
+			return err
+		}
+
+		if savedResume.Revision != req.ExpectedRevision {
+			return revisionConflict(&savedResume, req.ExpectedRevision)
+		}
+
+		log.Printf("Updating resume %s from revision %d", savedResume.ID, savedResume.Revision)
+		savedResume.ResumeData = resumeJson
+		savedResume.UpdatedAt = time.Now()
+		return appendRevision(tx, &savedResume)
+	})
+	if err != nil {
+		if _, ok := status.FromError(err); ok {
+			return nil, err
+		}
+		return nil, status.Errorf(codes.Internal, "failed to update resume: %v", err)
+	}
+
+	return toProtoSavedResume(&savedResume, req.Resume), nil
+}
+
+// revisionConflict builds the ABORTED status returned when an update expected
+// a revision other than the latest, attaching the current resume so the
+// client can merge.
+func revisionConflict(current *models.SavedResume, expected int32) error {
+	st := status.Newf(codes.Aborted, "resume %s is at revision %d, not %d", current.ID, current.Revision, expected)
+
+	var resumeData pb.ResumeData
+	if err := protojson.Unmarshal(current.ResumeData, &resumeData); err != nil {
+		return status.Errorf(codes.Internal, "failed to unmarshal resume data: %v", err)
+	}
+	detailed, err := st.WithDetails(protoadapt.MessageV1Of(toProtoSavedResume(current, &resumeData)))
+	if err != nil {
+		return st.Err()
+	}
+	return detailed.Err()
+}
+
+func (s *server) GetResume(ctx context.Context, req *pb.GetResumeRequest) (*pb.SavedResume, error) {
+	id, err := parseResumeID(req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	var savedResume models.SavedResume
+	if err := s.DB.WithContext(ctx).Scopes(ownedBy(ctx)).First(&savedResume, "id = ?", id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, status.Errorf(codes.NotFound, "resume not found with ID: %s", req.Id)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to load resume: %v", err)
+	}
+
+	var resumeData pb.ResumeData
+	if err := protojson.Unmarshal(savedResume.ResumeData, &resumeData); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to unmarshal resume data: %v", err)
+	}
+
+	return toProtoSavedResume(&savedResume, &resumeData), nil
 }
 
 func (s *server) ListResumes(ctx context.Context, req *pb.ListResumesRequest) (*pb.ListResumesResponse, error) {
 	var savedResumes []models.SavedResume
-	query := s.DB.Model(&models.SavedResume{})
+	query := s.DB.WithContext(ctx).Model(&models.SavedResume{}).Scopes(ownedBy(ctx))
 
 	if len(req.Tags) > 0 {
 		// Simple overlap check using Postgres array operator &&
 		query = query.Where("tags && ?", pq.Array(req.Tags))
 	}
+	if req.Query != "" {
+		query = query.Where(searchVector+" @@ websearch_to_tsquery('english', ?)", req.Query)
+	}
+
+	var totalCount int64
+	if err := query.Session(&gorm.Session{}).Count(&totalCount).Error; err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to count resumes: %v", err)
+	}
+
+	column, ok := sortColumns[req.SortBy]
+	if !ok {
+		return nil, status.Errorf(codes.InvalidArgument, "unsupported sort field: %v", req.SortBy)
+	}
+	direction := "desc"
+	if req.Ascending {
+		direction = "asc"
+	}
+
+	if req.PageToken != "" {
+		var err error
+		query, err = applyCursor(query, column, req.Ascending, req.PageToken)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+		}
+	}
+
+	pageSize := int(req.PageSize)
+	if pageSize < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "page size must not be negative")
+	}
+	if pageSize > maxPageSize {
+		pageSize = maxPageSize
+	}
+	if pageSize > 0 {
+		// Fetch one extra row to know whether another page follows
+		query = query.Limit(pageSize + 1)
+	}
 
-	if err := query.Order("created_at desc").Find(&savedResumes).Error; err != nil {
+	order := fmt.Sprintf("%s %s, id %s", column, direction, direction)
+	if err := query.Order(order).Find(&savedResumes).Error; err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to list resumes: %v", err)
 	}
 
+	var nextPageToken string
+	if pageSize > 0 && len(savedResumes) > pageSize {
+		savedResumes = savedResumes[:pageSize]
+		nextPageToken = encodeCursor(column, &savedResumes[pageSize-1])
+	}
+
 	var response []*pb.SavedResume
+	var cursors []string
 	for _, r := range savedResumes {
 		var resumeData pb.ResumeData
 		if err := protojson.Unmarshal(r.ResumeData, &resumeData); err != nil {
@@ -102,29 +277,27 @@ func (s *server) ListResumes(ctx context.Context, req *pb.ListResumesRequest) (*
 			continue
 		}
 
-		response = append(response, &pb.SavedResume{
-			Id:         r.ID.String(),
-			ResumeData: &resumeData,
-			Tags:       r.Tags,
-			Version:    r.Version,
-			CreatedAt:  r.CreatedAt.Format(time.RFC3339),
-		})
+		response = append(response, toProtoSavedResume(&r, &resumeData))
+		cursors = append(cursors, encodeCursor(column, &r))
 	}
 
 	return &pb.ListResumesResponse{
-		Resumes: response,
+		Resumes:       response,
+		NextPageToken: nextPageToken,
+		TotalCount:    int32(totalCount),
+		Cursors:       cursors,
 	}, nil
 }
 
 func (s *server) DeleteResume(ctx context.Context, req *pb.DeleteResumeRequest) (*pb.DeleteResumeResponse, error) {
 	// Parse the UUID
-	id, err := uuid.Parse(req.Id)
+	id, err := parseResumeID(req.Id)
 	if err != nil {
-		return nil, status.Errorf(codes.InvalidArgument, "invalid resume ID: %v", err)
+		return nil, err
 	}
 
 	// Delete the resume from database
-	result := s.DB.Delete(&models.SavedResume{}, "id = ?", id)
+	result := s.DB.WithContext(ctx).Scopes(ownedBy(ctx)).Delete(&models.SavedResume{}, "id = ?", id)
 	if result.Error != nil {
 		return nil, status.Errorf(codes.Internal, "failed to delete resume: %v", result.Error)
 	}
@@ -139,6 +312,15 @@ func (s *server) DeleteResume(ctx context.Context, req *pb.DeleteResumeRequest)
 	}, nil
 }
 
+// parseResumeID parses a resume UUID, returning an InvalidArgument status on failure.
+func parseResumeID(id string) (uuid.UUID, error) {
+	parsed, err := uuid.Parse(id)
+	if err != nil {
+		return uuid.Nil, status.Errorf(codes.InvalidArgument, "invalid resume ID: %v", err)
+	}
+	return parsed, nil
+}
+
 func main() {
 	port := os.Getenv("RESUME_SERVICE_PORT")
 	if port == "" {
@@ -150,31 +332,73 @@ func main() {
 	if dsn == "" {
 		dsn = dbDSN
 	}
-	db, err := gorm.Open(postgres.Open(dsn), &gorm.Config{})
+	db, err := gorm.Open(postgres.Open(dsn), &gorm.Config{TranslateError: true})
 	if err != nil {
 		log.Fatalf("failed to connect to database: %v", err)
 	}
 
 	// Auto Migrate
-	if err := db.AutoMigrate(&models.SavedResume{}); err != nil {
+	if err := db.AutoMigrate(&models.User{}, &models.SavedResume{}, &models.ResumeRevision{}, &models.Application{}, &models.ApplicationStatusChange{}, &models.JobDescription{}, &models.Analysis{}, &models.CoverLetter{}, &models.QuestionSet{}, &models.PracticeQuestion{}); err != nil {
 		log.Fatalf("failed to migrate database: %v", err)
 	}
+	if err := backfillOwners(db); err != nil {
+		log.Fatalf("failed to backfill resume owners: %v", err)
+	}
+	if err := backfillRevisions(db); err != nil {
+		log.Fatalf("failed to backfill resume revisions: %v", err)
+	}
+	if err := dedupeResumes(db); err != nil {
+		log.Fatalf("failed to deduplicate resumes: %v", err)
+	}
+	if err := ensureSearchIndex(db); err != nil {
+		log.Fatalf("failed to create search index: %v", err)
+	}
+
+	if err := loadTaxonomy(); err != nil {
+		log.Fatalf("failed to load skill taxonomy: %v", err)
+	}
+
+	retention, err := trashRetention()
+	if err != nil {
+		log.Fatalf("invalid trash retention: %v", err)
+	}
+	if retention > 0 {
+		go purgeExpiredTrash(context.Background(), db, retention)
+	}
 
 	lis, err := net.Listen("tcp", ":"+port)
 	if err != nil {
 		log.Fatalf("failed to listen: %v", err)
 	}
 
-	s := grpc.NewServer()
+	serviceToken := os.Getenv("SERVICE_TOKEN")
+	if serviceToken == "" {
+		log.Printf("No SERVICE_TOKEN configured, all callers act as the anonymous user")
+	}
+
+	s := grpc.NewServer(grpc.ChainUnaryInterceptor(recoveryInterceptor, serviceTokenInterceptor(serviceToken), identityInterceptor(db)))
 	srv := &server{
 		DB: db,
 	}
 
 	pb.RegisterResumePersistenceServiceServer(s, srv)
+	pb.RegisterATSServiceServer(s, &atsServer{})
 
-	log.Printf("Resume Persistence Service listening on :%s", port)
+	log.Printf("Resume Persistence and ATS Services listening on :%s", port)
 
 	if err := s.Serve(lis); err != nil {
 		log.Fatalf("failed to serve: %v", err)
 	}
 }
+
+// recoveryInterceptor turns a panic in a handler into an Internal error, so
+// that one malformed request cannot take the whole service down.
+func recoveryInterceptor(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp any, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			log.Printf("panic in %s: %v\n%s", info.FullMethod, r, debug.Stack())
+			err = status.Errorf(codes.Internal, "internal error")
+		}
+	}()
+	return handler(ctx, req)
+}
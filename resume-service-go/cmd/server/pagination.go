@@ -0,0 +1,95 @@
+package main
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/iprotoresume/resume-service-go/internal/models"
+	pb "github.com/iprotoresume/shared/proto"
+	"gorm.io/gorm"
+)
+
+const maxPageSize = 100
+
+// searchVector is the full-text document of a saved resume. It must match the
+// expression of the search index so Postgres can use it.
+const searchVector = `to_tsvector('english', coalesce(resume_data->>'fullName', '') || ' ' || coalesce(resume_data->>'jobTitle', '') || ' ' || coalesce(resume_data->>'summary', ''))`
+
+var sortColumns = map[pb.ResumeSortField]string{
+	pb.ResumeSortField_RESUME_SORT_FIELD_UNSPECIFIED: "created_at",
+	pb.ResumeSortField_RESUME_SORT_FIELD_CREATED_AT:  "created_at",
+	pb.ResumeSortField_RESUME_SORT_FIELD_UPDATED_AT:  "updated_at",
+	pb.ResumeSortField_RESUME_SORT_FIELD_VERSION:     "version",
+}
+
+// pageCursor is the keyset position after which the next page starts.
+type pageCursor struct {
+	Sort  string `json:"s"`
+	Value string `json:"v"`
+	ID    string `json:"id"`
+}
+
+func encodeCursor(column string, r *models.SavedResume) string {
+	c := pageCursor{Sort: column, ID: r.ID.String()}
+	switch column {
+	case "created_at":
+		c.Value = r.CreatedAt.Format(time.RFC3339Nano)
+	case "updated_at":
+		c.Value = r.UpdatedAt.Format(time.RFC3339Nano)
+	default:
+		c.Value = r.Version
+	}
+
+	raw, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(raw)
+}
+
+func decodeCursor(token string) (*pageCursor, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, err
+	}
+
+	var c pageCursor
+	if err := json.Unmarshal(raw, &c); err != nil {
+		return nil, err
+	}
+	if _, err := uuid.Parse(c.ID); err != nil {
+		return nil, err
+	}
+	return &c, nil
+}
+
+// applyCursor restricts query to rows strictly after the cursor in the given ordering.
+func applyCursor(query *gorm.DB, column string, ascending bool, token string) (*gorm.DB, error) {
+	c, err := decodeCursor(token)
+	if err != nil {
+		return nil, fmt.Errorf("malformed page token: %w", err)
+	}
+	if c.Sort != column {
+		return nil, fmt.Errorf("page token was issued for sorting by %s, not %s", c.Sort, column)
+	}
+
+	var value any = c.Value
+	if column != "version" {
+		t, err := time.Parse(time.RFC3339Nano, c.Value)
+		if err != nil {
+			return nil, fmt.Errorf("malformed page token: %w", err)
+		}
+		value = t
+	}
+
+	op := "<"
+	if ascending {
+		op = ">"
+	}
+	return query.Where(fmt.Sprintf("(%s, id) %s (?, ?)", column, op), value, c.ID), nil
+}
+
+// ensureSearchIndex creates the GIN index backing ListResumes full-text search.
+func ensureSearchIndex(db *gorm.DB) error {
+	return db.Exec(`CREATE INDEX IF NOT EXISTS idx_saved_resumes_search ON saved_resumes USING GIN (` + searchVector + `)`).Error
+}
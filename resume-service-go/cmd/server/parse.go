@@ -0,0 +1,39 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"strings"
+
+	"github.com/iprotoresume/resume-service-go/internal/importer"
+	pb "github.com/iprotoresume/shared/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *server) ParseResumeFile(ctx context.Context, req *pb.ParseResumeFileRequest) (*pb.ParseResumeFileResponse, error) {
+	var (
+		text string
+		err  error
+	)
+	// Detect the type from the content; uploaded file names and types are not reliable
+	switch {
+	case bytes.HasPrefix(req.Content, []byte("%PDF")):
+		text, err = importer.PDFText(req.Content)
+	case bytes.HasPrefix(req.Content, []byte("PK\x03\x04")):
+		text, err = importer.DOCXText(req.Content)
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "unsupported file %q: upload a PDF or DOCX document", req.Filename)
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "failed to read %q: %v", req.Filename, err)
+	}
+	if strings.TrimSpace(text) == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "no text found in %q; scanned documents are not supported", req.Filename)
+	}
+
+	return &pb.ParseResumeFileResponse{
+		Draft: importer.Parse(text),
+		Text:  text,
+	}, nil
+}
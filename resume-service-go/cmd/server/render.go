@@ -0,0 +1,32 @@
+package main
+
+import (
+	"context"
+	"errors"
+
+	"github.com/iprotoresume/resume-service-go/internal/render"
+	pb "github.com/iprotoresume/shared/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *server) RenderResume(ctx context.Context, req *pb.RenderResumeRequest) (*pb.RenderResumeResponse, error) {
+	resume, err := s.resolveResumeRef(ctx, req.Resume)
+	if err != nil {
+		return nil, err
+	}
+
+	content, err := render.PDF(resume, req.Template)
+	if errors.Is(err, render.ErrUnknownTemplate) {
+		return nil, status.Errorf(codes.InvalidArgument, "%v (available: %v)", err, render.Templates())
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to render resume: %v", err)
+	}
+
+	return &pb.RenderResumeResponse{
+		Content:     content,
+		ContentType: "application/pdf",
+		Filename:    exportFilename(resume, "pdf"),
+	}, nil
+}
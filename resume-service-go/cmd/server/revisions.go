@@ -0,0 +1,148 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	"github.com/iprotoresume/resume-service-go/internal/models"
+	pb "github.com/iprotoresume/shared/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+func (s *server) ListResumeRevisions(ctx context.Context, req *pb.ListResumeRevisionsRequest) (*pb.ListResumeRevisionsResponse, error) {
+	resumeID, err := parseResumeID(req.ResumeId)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.DB.WithContext(ctx).Scopes(ownedBy(ctx)).Select("id").First(&models.SavedResume{}, "id = ?", resumeID).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, status.Errorf(codes.NotFound, "resume not found with ID: %s", req.ResumeId)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to load resume: %v", err)
+	}
+
+	var revisions []models.ResumeRevision
+	if err := s.DB.WithContext(ctx).Where("resume_id = ?", resumeID).Order("revision desc").Find(&revisions).Error; err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list revisions: %v", err)
+	}
+
+	var response []*pb.ResumeRevision
+	for _, rev := range revisions {
+		pbRev, err := toProtoRevision(&rev)
+		if err != nil {
+			log.Printf("Failed to unmarshal revision %d of resume %s: %v", rev.Revision, rev.ResumeID, err)
+			continue
+		}
+		response = append(response, pbRev)
+	}
+
+	return &pb.ListResumeRevisionsResponse{
+		Revisions: response,
+	}, nil
+}
+
+func (s *server) GetResumeRevision(ctx context.Context, req *pb.GetResumeRevisionRequest) (*pb.ResumeRevision, error) {
+	resumeID, err := parseResumeID(req.ResumeId)
+	if err != nil {
+		return nil, err
+	}
+
+	var revision models.ResumeRevision
+	err = s.DB.WithContext(ctx).
+		Joins("JOIN saved_resumes ON saved_resumes.id = resume_revisions.resume_id AND saved_resumes.deleted_at IS NULL").
+		Scopes(ownedBy(ctx)).
+		Where("resume_revisions.resume_id = ? AND resume_revisions.revision = ?", resumeID, req.Revision).
+		First(&revision).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, status.Errorf(codes.NotFound, "revision %d not found for resume %s", req.Revision, req.ResumeId)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to load revision: %v", err)
+	}
+
+	pbRev, err := toProtoRevision(&revision)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to unmarshal resume data: %v", err)
+	}
+	return pbRev, nil
+}
+
+func (s *server) RestoreResumeRevision(ctx context.Context, req *pb.RestoreResumeRevisionRequest) (*pb.SavedResume, error) {
+	resumeID, err := parseResumeID(req.ResumeId)
+	if err != nil {
+		return nil, err
+	}
+
+	var savedResume models.SavedResume
+	err = s.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Scopes(ownedBy(ctx)).First(&savedResume, "id = ?", resumeID).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return status.Errorf(codes.NotFound, "resume not found with ID: %s", req.ResumeId)
+			}
+			return err
+		}
+
+		var revision models.ResumeRevision
+		if err := tx.Where("resume_id = ? AND revision = ?", resumeID, req.Revision).First(&revision).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return status.Errorf(codes.NotFound, "revision %d not found for resume %s", req.Revision, req.ResumeId)
+			}
+			return err
+		}
+
+		// Restoring appends a copy so the history stays append-only
+		log.Printf("Restoring resume %s to revision %d", savedResume.ID, revision.Revision)
+		savedResume.ResumeData = revision.ResumeData
+		savedResume.UpdatedAt = time.Now()
+		return appendRevision(tx, &savedResume)
+	})
+	if err != nil {
+		if _, ok := status.FromError(err); ok {
+			return nil, err
+		}
+		return nil, status.Errorf(codes.Internal, "failed to restore revision: %v", err)
+	}
+
+	var resumeData pb.ResumeData
+	if err := protojson.Unmarshal(savedResume.ResumeData, &resumeData); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to unmarshal resume data: %v", err)
+	}
+	return toProtoSavedResume(&savedResume, &resumeData), nil
+}
+
+func toProtoRevision(rev *models.ResumeRevision) (*pb.ResumeRevision, error) {
+	var resumeData pb.ResumeData
+	if err := protojson.Unmarshal(rev.ResumeData, &resumeData); err != nil {
+		return nil, err
+	}
+
+	return &pb.ResumeRevision{
+		Id:         rev.ID.String(),
+		ResumeId:   rev.ResumeID.String(),
+		Revision:   rev.Revision,
+		ResumeData: &resumeData,
+		CreatedAt:  rev.CreatedAt.Format(time.RFC3339),
+	}, nil
+}
+
+// backfillRevisions gives resumes saved before revisions existed their first revision.
+func backfillRevisions(db *gorm.DB) error {
+	return db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Exec(`UPDATE saved_resumes SET revision = 1 WHERE revision = 0`).Error; err != nil {
+			return err
+		}
+
+		return tx.Exec(`
+			INSERT INTO resume_revisions (resume_id, revision, resume_data, created_at)
+			SELECT s.id, s.revision, s.resume_data, s.updated_at
+			FROM saved_resumes s
+			WHERE NOT EXISTS (SELECT 1 FROM resume_revisions r WHERE r.resume_id = s.id)`).Error
+	})
+}
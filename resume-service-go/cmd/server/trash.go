@@ -0,0 +1,125 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"log"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/iprotoresume/resume-service-go/internal/models"
+	pb "github.com/iprotoresume/shared/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"gorm.io/gorm"
+)
+
+const (
+	defaultTrashRetentionDays = 30
+	trashPurgeInterval        = time.Hour
+)
+
+func (s *server) ListDeletedResumes(ctx context.Context, req *pb.ListDeletedResumesRequest) (*pb.ListResumesResponse, error) {
+	var savedResumes []models.SavedResume
+	if err := s.DB.WithContext(ctx).Unscoped().Scopes(ownedBy(ctx)).Where("deleted_at IS NOT NULL").Order("deleted_at desc").Find(&savedResumes).Error; err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list deleted resumes: %v", err)
+	}
+
+	var response []*pb.SavedResume
+	for _, r := range savedResumes {
+		var resumeData pb.ResumeData
+		if err := protojson.Unmarshal(r.ResumeData, &resumeData); err != nil {
+			log.Printf("Failed to unmarshal resume data for ID %s: %v", r.ID, err)
+			continue
+		}
+
+		response = append(response, toProtoSavedResume(&r, &resumeData))
+	}
+
+	return &pb.ListResumesResponse{
+		Resumes:    response,
+		TotalCount: int32(len(response)),
+	}, nil
+}
+
+func (s *server) RestoreResume(ctx context.Context, req *pb.RestoreResumeRequest) (*pb.SavedResume, error) {
+	id, err := parseResumeID(req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	result := s.DB.WithContext(ctx).Unscoped().Model(&models.SavedResume{}).Scopes(ownedBy(ctx)).
+		Where("id = ? AND deleted_at IS NOT NULL", id).
+		Update("deleted_at", nil)
+	if errors.Is(result.Error, gorm.ErrDuplicatedKey) {
+		return nil, status.Errorf(codes.AlreadyExists, "another resume already has this resume's version; rename or delete it first")
+	}
+	if result.Error != nil {
+		return nil, status.Errorf(codes.Internal, "failed to restore resume: %v", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return nil, status.Errorf(codes.NotFound, "no deleted resume found with ID: %s", req.Id)
+	}
+
+	log.Printf("Restored resume %s from trash", id)
+	return s.GetResume(ctx, &pb.GetResumeRequest{Id: req.Id})
+}
+
+func (s *server) PurgeResume(ctx context.Context, req *pb.PurgeResumeRequest) (*pb.PurgeResumeResponse, error) {
+	id, err := parseResumeID(req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	// Only resumes already in the trash can be purged; revisions cascade
+	result := s.DB.WithContext(ctx).Unscoped().Scopes(ownedBy(ctx)).Where("id = ? AND deleted_at IS NOT NULL", id).Delete(&models.SavedResume{})
+	if result.Error != nil {
+		return nil, status.Errorf(codes.Internal, "failed to purge resume: %v", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return nil, status.Errorf(codes.NotFound, "no deleted resume found with ID: %s", req.Id)
+	}
+
+	log.Printf("Purged resume %s", id)
+	return &pb.PurgeResumeResponse{
+		Success: true,
+	}, nil
+}
+
+// trashRetention reads TRASH_RETENTION_DAYS. Zero disables automatic purging.
+func trashRetention() (time.Duration, error) {
+	days := defaultTrashRetentionDays
+	if v := os.Getenv("TRASH_RETENTION_DAYS"); v != "" {
+		parsed, err := strconv.Atoi(v)
+		if err != nil || parsed < 0 {
+			return 0, errors.New("TRASH_RETENTION_DAYS must be a non-negative number of days")
+		}
+		days = parsed
+	}
+	return time.Duration(days) * 24 * time.Hour, nil
+}
+
+// purgeExpiredTrash periodically hard-deletes resumes that have been in the
+// trash for longer than retention. It runs until ctx is cancelled.
+func purgeExpiredTrash(ctx context.Context, db *gorm.DB, retention time.Duration) {
+	ticker := time.NewTicker(trashPurgeInterval)
+	defer ticker.Stop()
+
+	for {
+		cutoff := time.Now().Add(-retention)
+		result := db.WithContext(ctx).Unscoped().Where("deleted_at < ?", cutoff).Delete(&models.SavedResume{})
+		if result.Error != nil {
+			log.Printf("Failed to purge expired trash: %v", result.Error)
+		} else if result.RowsAffected > 0 {
+			log.Printf("Purged %d resumes deleted before %s", result.RowsAffected, cutoff.Format(time.RFC3339))
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,135 @@
+package main
+
+import (
+	"context"
+	"crypto/subtle"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/iprotoresume/resume-service-go/internal/identity"
+	"github.com/iprotoresume/resume-service-go/internal/models"
+	pb "github.com/iprotoresume/shared/proto"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// serviceTokenInterceptor rejects calls that assert a caller identity
+// without the service token, so only the gateway can act on behalf of a
+// user. Without a token every caller is anonymous.
+func serviceTokenInterceptor(token string) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		if !identity.HasIdentity(ctx) {
+			return handler(ctx, req)
+		}
+		if token == "" {
+			return nil, status.Errorf(codes.Unauthenticated, "caller identity requires a service token, which is not configured")
+		}
+		if subtle.ConstantTimeCompare([]byte(identity.ServiceToken(ctx)), []byte(token)) != 1 {
+			return nil, status.Errorf(codes.Unauthenticated, "invalid service token")
+		}
+		return handler(ctx, req)
+	}
+}
+
+// identityInterceptor resolves the caller of every persistence RPC to a user
+// row, creating it on first sight, and stores it in the request context.
+func identityInterceptor(db *gorm.DB) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		if !strings.HasPrefix(info.FullMethod, "/resume.ResumePersistenceService/") {
+			return handler(ctx, req)
+		}
+
+		user, err := resolveUser(db.WithContext(ctx), identity.FromMetadata(ctx))
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to resolve user: %v", err)
+		}
+		return handler(identity.WithUser(ctx, user), req)
+	}
+}
+
+// resolveUser returns the user row of caller, creating it on first sight.
+// Email and name are only updated when the token carries different,
+// non-empty values, so most requests cost a single read and tokens without
+// profile claims leave the stored ones alone.
+func resolveUser(db *gorm.DB, caller identity.Caller) (*models.User, error) {
+	var user models.User
+	err := db.Where("subject = ?", caller.Subject).Take(&user).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		user = models.User{Subject: caller.Subject, Email: caller.Email, Name: caller.Name}
+		// Another request may create the user first; use its row then
+		err = db.Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "subject"}},
+			DoNothing: true,
+		}).Create(&user).Error
+		if err == nil && user.ID == uuid.Nil {
+			err = db.Where("subject = ?", caller.Subject).Take(&user).Error
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	updates := make(map[string]any)
+	if caller.Email != "" && caller.Email != user.Email {
+		updates["email"] = caller.Email
+	}
+	if caller.Name != "" && caller.Name != user.Name {
+		updates["name"] = caller.Name
+	}
+	if len(updates) > 0 {
+		if err := db.Model(&user).Updates(updates).Error; err != nil {
+			return nil, err
+		}
+	}
+	return &user, nil
+}
+
+// ownerID returns the ID of the user resolved by identityInterceptor, or
+// uuid.Nil (which owns nothing) if the interceptor did not run.
+func ownerID(ctx context.Context) uuid.UUID {
+	if user := identity.UserFromContext(ctx); user != nil {
+		return user.ID
+	}
+	return uuid.Nil
+}
+
+// ownedBy scopes saved resume queries to the caller.
+func ownedBy(ctx context.Context) func(*gorm.DB) *gorm.DB {
+	return ownedIn(ctx, "saved_resumes")
+}
+
+// ownedIn scopes queries on table to rows owned by the caller.
+func ownedIn(ctx context.Context, table string) func(*gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		return db.Where(table+".owner_id = ?", ownerID(ctx))
+	}
+}
+
+func (s *server) GetCurrentUser(ctx context.Context, req *pb.GetCurrentUserRequest) (*pb.User, error) {
+	user := identity.UserFromContext(ctx)
+	if user == nil {
+		return nil, status.Errorf(codes.Unauthenticated, "no caller identity")
+	}
+
+	return &pb.User{
+		Id:        user.ID.String(),
+		Subject:   user.Subject,
+		Email:     user.Email,
+		Name:      user.Name,
+		CreatedAt: user.CreatedAt.Format(time.RFC3339),
+	}, nil
+}
+
+// backfillOwners assigns resumes saved before ownership existed to the anonymous user.
+func backfillOwners(db *gorm.DB) error {
+	anonymous, err := resolveUser(db, identity.Caller{Subject: identity.AnonymousSubject})
+	if err != nil {
+		return err
+	}
+	return db.Exec(`UPDATE saved_resumes SET owner_id = ? WHERE owner_id IS NULL`, anonymous.ID).Error
+}
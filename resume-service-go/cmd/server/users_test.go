@@ -0,0 +1,39 @@
+package main
+
+import (
+	"context"
+	"testing"
+
+	"github.com/iprotoresume/resume-service-go/internal/identity"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func TestServiceTokenInterceptor(t *testing.T) {
+	tests := []struct {
+		name  string
+		token string
+		md    metadata.MD
+		want  codes.Code
+	}{
+		{"anonymous without a token", "", nil, codes.OK},
+		{"anonymous with a token configured", "secret", nil, codes.OK},
+		{"identity with the token", "secret", metadata.Pairs(identity.SubjectKey, "u1", identity.ServiceTokenKey, "secret"), codes.OK},
+		{"identity with a wrong token", "secret", metadata.Pairs(identity.SubjectKey, "u1", identity.ServiceTokenKey, "guess"), codes.Unauthenticated},
+		{"identity without the token", "secret", metadata.Pairs(identity.SubjectKey, "u1"), codes.Unauthenticated},
+		{"email alone is an identity", "secret", metadata.Pairs(identity.EmailKey, "a@example.com"), codes.Unauthenticated},
+		{"identity when no token is configured", "", metadata.Pairs(identity.SubjectKey, "u1", identity.ServiceTokenKey, ""), codes.Unauthenticated},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := metadata.NewIncomingContext(context.Background(), tt.md)
+			handler := func(ctx context.Context, req any) (any, error) { return "ok", nil }
+			_, err := serviceTokenInterceptor(tt.token)(ctx, nil, &grpc.UnaryServerInfo{FullMethod: "/resume.ResumePersistenceService/GetResume"}, handler)
+			if got := status.Code(err); got != tt.want {
+				t.Errorf("code = %v, want %v (%v)", got, tt.want, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,75 @@
+package main
+
+import (
+	"sort"
+	"strings"
+
+	"gorm.io/gorm"
+)
+
+// versionKey normalizes a resume version for uniqueness checks, so "V1 " and
+// "v1" name the same resume. It must agree with versionKeySQL.
+func versionKey(version string) string {
+	return strings.ToLower(strings.Join(strings.Fields(version), " "))
+}
+
+const versionKeySQL = `lower(btrim(regexp_replace(coalesce(version, ''), '\s+', ' ', 'g')))`
+
+// normalizeTags trims, deduplicates and sorts tags so that their order never
+// matters. It must agree with normalizeTagsSQL.
+func normalizeTags(tags []string) []string {
+	seen := make(map[string]bool)
+	normalized := []string{}
+	for _, tag := range tags {
+		tag = strings.TrimSpace(tag)
+		if tag == "" || seen[tag] {
+			continue
+		}
+		seen[tag] = true
+		normalized = append(normalized, tag)
+	}
+	sort.Strings(normalized)
+	return normalized
+}
+
+const normalizeTagsSQL = `ARRAY(SELECT DISTINCT btrim(t) COLLATE "C" FROM unnest(tags) t WHERE btrim(t) <> '' ORDER BY 1)`
+
+// dedupeResumes brings rows saved before versions were unique in line with
+// idx_saved_resumes_owner_version. Rows with the same owner, version and tags
+// were duplicated by reordered tags; all but the latest go to the trash.
+// Remaining live rows sharing a version are distinct resumes, so all but the
+// latest are renamed with a suffix from their ID.
+func dedupeResumes(db *gorm.DB) error {
+	return db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Exec(`UPDATE saved_resumes SET version_key = ` + versionKeySQL + `, tags = ` + normalizeTagsSQL +
+			` WHERE version_key IS DISTINCT FROM ` + versionKeySQL + ` OR tags IS DISTINCT FROM ` + normalizeTagsSQL).Error; err != nil {
+			return err
+		}
+
+		err := tx.Exec(`
+			WITH ranked AS (
+				SELECT id, row_number() OVER (PARTITION BY owner_id, version_key, tags ORDER BY updated_at DESC, id) AS n
+				FROM saved_resumes WHERE deleted_at IS NULL
+			)
+			UPDATE saved_resumes s SET deleted_at = now()
+			FROM ranked r WHERE s.id = r.id AND r.n > 1`).Error
+		if err != nil {
+			return err
+		}
+
+		err = tx.Exec(`
+			WITH ranked AS (
+				SELECT id, row_number() OVER (PARTITION BY owner_id, version_key ORDER BY updated_at DESC, id) AS n
+				FROM saved_resumes WHERE deleted_at IS NULL
+			)
+			UPDATE saved_resumes s
+			SET version = s.version || ' (' || left(s.id::text, 8) || ')',
+				version_key = s.version_key || ' (' || left(s.id::text, 8) || ')'
+			FROM ranked r WHERE s.id = r.id AND r.n > 1`).Error
+		if err != nil {
+			return err
+		}
+
+		return tx.Exec(`CREATE UNIQUE INDEX IF NOT EXISTS idx_saved_resumes_owner_version ON saved_resumes (owner_id, version_key) WHERE deleted_at IS NULL`).Error
+	})
+}
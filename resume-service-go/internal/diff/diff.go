@@ -0,0 +1,150 @@
+package diff
+
+import (
+	"strings"
+
+	pb "github.com/iprotoresume/shared/proto"
+)
+
+// Resumes compares two resumes field by field. Repeated sections are matched
+// by a natural key (experience by title and company, projects by title, skill
+// groups by category) so reordering alone is not reported as a change.
+func Resumes(base, target *pb.ResumeData) *pb.ResumeDiff {
+	if base == nil {
+		base = &pb.ResumeData{}
+	}
+	if target == nil {
+		target = &pb.ResumeData{}
+	}
+
+	var fields []*pb.FieldChange
+	fields = appendIfChanged(fields, valueChange("fullName", base.FullName, target.FullName))
+	fields = appendIfChanged(fields, valueChange("email", base.Email, target.Email))
+	fields = appendIfChanged(fields, valueChange("phone", base.Phone, target.Phone))
+	fields = appendIfChanged(fields, valueChange("jobTitle", base.JobTitle, target.JobTitle))
+	fields = appendIfChanged(fields, valueChange("location", base.Location, target.Location))
+	fields = appendIfChanged(fields, valueChange("linkedin", base.Linkedin, target.Linkedin))
+	fields = appendIfChanged(fields, valueChange("github", base.Github, target.Github))
+	fields = appendIfChanged(fields, valueChange("website", base.Website, target.Website))
+	fields = appendIfChanged(fields, textChange("summary", base.Summary, target.Summary))
+
+	return &pb.ResumeDiff{
+		Fields: fields,
+		Experience: matchItems(base.Experience, target.Experience,
+			func(e *pb.Experience) string { return e.Title + " @ " + e.Company },
+			func(a, b *pb.Experience) []*pb.FieldChange {
+				var changes []*pb.FieldChange
+				changes = appendIfChanged(changes, valueChange("startDate", a.StartDate, b.StartDate))
+				changes = appendIfChanged(changes, valueChange("endDate", a.EndDate, b.EndDate))
+				return appendIfChanged(changes, textChange("description", a.Description, b.Description))
+			}),
+		Skills: matchItems(base.Skills, target.Skills,
+			func(s string) string { return s },
+			func(a, b string) []*pb.FieldChange { return nil }),
+		SkillGroups: matchItems(base.SkillGroups, target.SkillGroups,
+			func(g *pb.SkillGroup) string { return g.Category },
+			func(a, b *pb.SkillGroup) []*pb.FieldChange {
+				return appendIfChanged(nil, listChange("items", a.Items, b.Items))
+			}),
+		Projects: matchItems(base.Projects, target.Projects,
+			func(p *pb.Project) string { return p.Title },
+			func(a, b *pb.Project) []*pb.FieldChange {
+				var changes []*pb.FieldChange
+				changes = appendIfChanged(changes, valueChange("date", a.Date, b.Date))
+				changes = appendIfChanged(changes, valueChange("location", a.Location, b.Location))
+				changes = appendIfChanged(changes, textChange("description", a.Description, b.Description))
+				return appendIfChanged(changes, listChange("techStack", a.TechStack, b.TechStack))
+			}),
+	}
+}
+
+// matchItems pairs base and target entries by key, in order for duplicate keys.
+// Removed and modified entries are reported in base order, followed by added
+// entries in target order.
+func matchItems[T any](base, target []T, key func(T) string, compare func(a, b T) []*pb.FieldChange) []*pb.ItemChange {
+	pending := make(map[string][]int)
+	for i, t := range target {
+		k := normalizeKey(key(t))
+		pending[k] = append(pending[k], i)
+	}
+
+	matched := make([]bool, len(target))
+	var changes []*pb.ItemChange
+	for _, b := range base {
+		k := normalizeKey(key(b))
+		candidates := pending[k]
+		if len(candidates) == 0 {
+			changes = append(changes, &pb.ItemChange{ChangeType: pb.ChangeType_CHANGE_TYPE_REMOVED, Key: key(b)})
+			continue
+		}
+
+		idx := candidates[0]
+		pending[k] = candidates[1:]
+		matched[idx] = true
+		if fields := compare(b, target[idx]); len(fields) > 0 {
+			changes = append(changes, &pb.ItemChange{ChangeType: pb.ChangeType_CHANGE_TYPE_MODIFIED, Key: key(target[idx]), Fields: fields})
+		}
+	}
+
+	for i, t := range target {
+		if !matched[i] {
+			changes = append(changes, &pb.ItemChange{ChangeType: pb.ChangeType_CHANGE_TYPE_ADDED, Key: key(t)})
+		}
+	}
+	return changes
+}
+
+func valueChange(field, oldValue, newValue string) *pb.FieldChange {
+	if oldValue == newValue {
+		return nil
+	}
+	return &pb.FieldChange{Field: field, OldValue: oldValue, NewValue: newValue}
+}
+
+func textChange(field, oldValue, newValue string) *pb.FieldChange {
+	change := valueChange(field, oldValue, newValue)
+	if change != nil {
+		change.Edits = Words(oldValue, newValue)
+	}
+	return change
+}
+
+// listChange reports values added to or removed from a string list, ignoring order and case.
+func listChange(field string, oldValues, newValues []string) *pb.FieldChange {
+	change := &pb.FieldChange{
+		Field:         field,
+		AddedValues:   difference(newValues, oldValues),
+		RemovedValues: difference(oldValues, newValues),
+	}
+	if len(change.AddedValues) == 0 && len(change.RemovedValues) == 0 {
+		return nil
+	}
+	return change
+}
+
+// difference returns the values of a that are not in b.
+func difference(a, b []string) []string {
+	present := make(map[string]bool, len(b))
+	for _, v := range b {
+		present[normalizeKey(v)] = true
+	}
+
+	var out []string
+	for _, v := range a {
+		if !present[normalizeKey(v)] {
+			out = append(out, v)
+		}
+	}
+	return out
+}
+
+func appendIfChanged(changes []*pb.FieldChange, change *pb.FieldChange) []*pb.FieldChange {
+	if change == nil {
+		return changes
+	}
+	return append(changes, change)
+}
+
+func normalizeKey(s string) string {
+	return strings.ToLower(strings.Join(strings.Fields(s), " "))
+}
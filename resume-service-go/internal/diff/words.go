@@ -0,0 +1,75 @@
+package diff
+
+import (
+	"strings"
+
+	pb "github.com/iprotoresume/shared/proto"
+)
+
+// Words computes a word-level diff of two texts using the longest common
+// subsequence of their whitespace-separated words. Consecutive words with the
+// same operation are merged into a single edit.
+func Words(oldText, newText string) []*pb.TextEdit {
+	a, b := strings.Fields(oldText), strings.Fields(newText)
+
+	// Strip the common prefix and suffix to keep the LCS table small
+	prefix := 0
+	for prefix < len(a) && prefix < len(b) && a[prefix] == b[prefix] {
+		prefix++
+	}
+	suffix := 0
+	for suffix < len(a)-prefix && suffix < len(b)-prefix && a[len(a)-1-suffix] == b[len(b)-1-suffix] {
+		suffix++
+	}
+
+	var edits []*pb.TextEdit
+	emit := func(op pb.TextEditOp, words ...string) {
+		if len(words) == 0 {
+			return
+		}
+		text := strings.Join(words, " ")
+		if n := len(edits); n > 0 && edits[n-1].Op == op {
+			edits[n-1].Text += " " + text
+			return
+		}
+		edits = append(edits, &pb.TextEdit{Op: op, Text: text})
+	}
+
+	emit(pb.TextEditOp_TEXT_EDIT_OP_EQUAL, a[:prefix]...)
+
+	x, y := a[prefix:len(a)-suffix], b[prefix:len(b)-suffix]
+	lcs := make([][]int, len(x)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(y)+1)
+	}
+	for i := len(x) - 1; i >= 0; i-- {
+		for j := len(y) - 1; j >= 0; j-- {
+			if x[i] == y[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else {
+				lcs[i][j] = max(lcs[i+1][j], lcs[i][j+1])
+			}
+		}
+	}
+
+	i, j := 0, 0
+	for i < len(x) && j < len(y) {
+		switch {
+		case x[i] == y[j]:
+			emit(pb.TextEditOp_TEXT_EDIT_OP_EQUAL, x[i])
+			i++
+			j++
+		case lcs[i+1][j] >= lcs[i][j+1]:
+			emit(pb.TextEditOp_TEXT_EDIT_OP_DELETE, x[i])
+			i++
+		default:
+			emit(pb.TextEditOp_TEXT_EDIT_OP_INSERT, y[j])
+			j++
+		}
+	}
+	emit(pb.TextEditOp_TEXT_EDIT_OP_DELETE, x[i:]...)
+	emit(pb.TextEditOp_TEXT_EDIT_OP_INSERT, y[j:]...)
+
+	emit(pb.TextEditOp_TEXT_EDIT_OP_EQUAL, a[len(a)-suffix:]...)
+	return edits
+}
@@ -0,0 +1,76 @@
+package identity
+
+import (
+	"context"
+
+	"github.com/iprotoresume/resume-service-go/internal/models"
+	"google.golang.org/grpc/metadata"
+)
+
+// Metadata keys the gateway uses to propagate the caller's identity.
+const (
+	SubjectKey = "x-user-id"
+	EmailKey   = "x-user-email"
+	NameKey    = "x-user-name"
+)
+
+// ServiceTokenKey is the metadata key of the secret the gateway proves itself
+// with. Identity metadata are only trusted alongside it.
+const ServiceTokenKey = "x-service-token"
+
+// AnonymousSubject owns everything saved by callers without an identity,
+// including resumes created before users existed.
+const AnonymousSubject = "anonymous"
+
+type contextKey struct{}
+
+// Caller is the identity asserted in the incoming gRPC metadata.
+type Caller struct {
+	Subject string
+	Email   string
+	Name    string
+}
+
+// FromMetadata reads the caller from incoming metadata, falling back to the anonymous subject.
+func FromMetadata(ctx context.Context) Caller {
+	md, _ := metadata.FromIncomingContext(ctx)
+	caller := Caller{
+		Subject: first(md, SubjectKey),
+		Email:   first(md, EmailKey),
+		Name:    first(md, NameKey),
+	}
+	if caller.Subject == "" {
+		caller = Caller{Subject: AnonymousSubject}
+	}
+	return caller
+}
+
+// HasIdentity reports whether the incoming metadata assert a caller identity.
+func HasIdentity(ctx context.Context) bool {
+	md, _ := metadata.FromIncomingContext(ctx)
+	return first(md, SubjectKey) != "" || first(md, EmailKey) != "" || first(md, NameKey) != ""
+}
+
+// ServiceToken returns the service token in the incoming metadata.
+func ServiceToken(ctx context.Context) string {
+	md, _ := metadata.FromIncomingContext(ctx)
+	return first(md, ServiceTokenKey)
+}
+
+// WithUser returns a context carrying the resolved user.
+func WithUser(ctx context.Context, user *models.User) context.Context {
+	return context.WithValue(ctx, contextKey{}, user)
+}
+
+// UserFromContext returns the user stored by WithUser, or nil.
+func UserFromContext(ctx context.Context) *models.User {
+	user, _ := ctx.Value(contextKey{}).(*models.User)
+	return user
+}
+
+func first(md metadata.MD, key string) string {
+	if values := md.Get(key); len(values) > 0 {
+		return values[0]
+	}
+	return ""
+}
@@ -0,0 +1,109 @@
+package importer
+
+import (
+	"strings"
+	"unicode/utf16"
+)
+
+// cmap is a parsed ToUnicode CMap mapping character codes to text.
+type cmap struct {
+	codeBytes int
+	chars     map[uint32]string
+}
+
+// parseCMap reads the codespace, bfchar and bfrange sections of a CMap.
+func parseCMap(data []byte) *cmap {
+	cm := &cmap{codeBytes: 1, chars: make(map[uint32]string)}
+	lex := lexer{data: data}
+
+	var operands []token
+	for {
+		tok, ok := lex.next()
+		if !ok {
+			break
+		}
+		if tok.kind != tokenOperator {
+			operands = append(operands, tok)
+			continue
+		}
+
+		switch tok.text {
+		case "endcodespacerange":
+			if len(operands) > 0 && len(operands[0].str) > 0 {
+				cm.codeBytes = len(operands[0].str)
+			}
+		case "endbfchar":
+			for i := 0; i+1 < len(operands); i += 2 {
+				cm.chars[codeValue(operands[i].str)] = utf16Text(operands[i+1].str)
+			}
+		case "endbfrange":
+			for i := 0; i+2 < len(operands); i += 3 {
+				lo, hi := codeValue(operands[i].str), codeValue(operands[i+1].str)
+				if hi < lo || hi-lo > 0xFFFF {
+					continue
+				}
+				dst := operands[i+2]
+				for code := lo; code <= hi; code++ {
+					offset := code - lo
+					if dst.kind == tokenArray {
+						if int(offset) < len(dst.arr) {
+							cm.chars[code] = utf16Text(dst.arr[offset].str)
+						}
+						continue
+					}
+					cm.chars[code] = utf16Text(incrementLast(dst.str, offset))
+				}
+			}
+		}
+
+		// Begin operators follow their count operand, which must not leak into the section
+		if strings.HasPrefix(tok.text, "begin") || strings.HasPrefix(tok.text, "end") {
+			operands = operands[:0]
+		}
+	}
+	return cm
+}
+
+func (cm *cmap) decode(s []byte) string {
+	var sb strings.Builder
+	for i := 0; i+cm.codeBytes <= len(s); i += cm.codeBytes {
+		code := codeValue(s[i : i+cm.codeBytes])
+		if text, ok := cm.chars[code]; ok {
+			sb.WriteString(text)
+		} else if cm.codeBytes == 1 {
+			sb.WriteRune(winAnsiRune(s[i]))
+		}
+	}
+	return sb.String()
+}
+
+func codeValue(b []byte) uint32 {
+	var v uint32
+	for _, c := range b {
+		v = v<<8 | uint32(c)
+	}
+	return v
+}
+
+// incrementLast adds n to the big-endian value of b, as bfrange destinations require.
+func incrementLast(b []byte, n uint32) []byte {
+	out := append([]byte(nil), b...)
+	carry := n
+	for i := len(out) - 1; i >= 0 && carry > 0; i-- {
+		sum := uint32(out[i]) + carry
+		out[i] = byte(sum)
+		carry = sum >> 8
+	}
+	return out
+}
+
+func utf16Text(b []byte) string {
+	if len(b)%2 == 1 {
+		return string(b)
+	}
+	units := make([]uint16, len(b)/2)
+	for i := range units {
+		units[i] = uint16(b[2*i])<<8 | uint16(b[2*i+1])
+	}
+	return string(utf16.Decode(units))
+}
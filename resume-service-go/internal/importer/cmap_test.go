@@ -0,0 +1,62 @@
+package importer
+
+import "testing"
+
+func TestCMapDecode(t *testing.T) {
+	tests := []struct {
+		name string
+		cmap string
+		in   []byte
+		want string
+	}{
+		{
+			name: "one-byte bfchar",
+			cmap: "1 begincodespacerange <00> <FF> endcodespacerange 2 beginbfchar <01> <0048> <02> <0069> endbfchar",
+			in:   []byte{1, 2},
+			want: "Hi",
+		},
+		{
+			name: "unmapped one-byte codes fall back to WinAnsi",
+			cmap: "1 begincodespacerange <00> <FF> endcodespacerange 1 beginbfchar <01> <0048> endbfchar",
+			in:   []byte{1, 'i', 0x95},
+			want: "Hi•",
+		},
+		{
+			name: "two-byte bfrange",
+			cmap: "1 begincodespacerange <0000> <FFFF> endcodespacerange 1 beginbfrange <0010> <0012> <0061> endbfrange",
+			in:   []byte{0, 0x10, 0, 0x12, 0, 0x20},
+			want: "ac",
+		},
+		{
+			name: "bfrange with an array of destinations",
+			cmap: "1 begincodespacerange <00> <FF> endcodespacerange 1 beginbfrange <05> <07> [<0058> <0059> <005A>] endbfrange",
+			in:   []byte{7, 5},
+			want: "ZX",
+		},
+		{
+			name: "ligature and surrogate pair destinations",
+			cmap: "1 begincodespacerange <00> <FF> endcodespacerange 2 beginbfchar <01> <00660069> <02> <D83DDE00> endbfchar",
+			in:   []byte{1, 2},
+			want: "fi😀",
+		},
+		{
+			name: "destination carrying into the next byte",
+			cmap: "1 begincodespacerange <00> <FF> endcodespacerange 1 beginbfrange <01> <02> <00FF> endbfrange",
+			in:   []byte{2},
+			want: "Ā",
+		},
+		{
+			name: "reversed range is ignored",
+			cmap: "1 begincodespacerange <0000> <FFFF> endcodespacerange 1 beginbfrange <0002> <0001> <0041> endbfrange",
+			in:   []byte{0, 1},
+			want: "",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := parseCMap([]byte(tt.cmap)).decode(tt.in); got != tt.want {
+				t.Errorf("decode(%x) = %q, want %q", tt.in, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,104 @@
+package importer
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"strings"
+)
+
+// DOCXText extracts the paragraphs of a Word document, one per line. Header
+// parts come first since resumes often keep contact details there, and list
+// paragraphs are prefixed with "• " so the section parser sees bullets.
+func DOCXText(data []byte) (string, error) {
+	zr, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		return "", fmt.Errorf("not a DOCX document: %w", err)
+	}
+
+	var headers []*zip.File
+	var body *zip.File
+	for _, f := range zr.File {
+		switch {
+		case f.Name == "word/document.xml":
+			body = f
+		case path.Dir(f.Name) == "word" && strings.HasPrefix(path.Base(f.Name), "header"):
+			headers = append(headers, f)
+		}
+	}
+	if body == nil {
+		return "", errors.New("not a DOCX document: word/document.xml is missing")
+	}
+
+	var sb strings.Builder
+	b := newBudget()
+	for _, f := range append(headers, body) {
+		if err := writeParagraphs(&sb, f, b); err != nil {
+			return "", err
+		}
+	}
+	return sb.String(), nil
+}
+
+// writeParagraphs writes the text runs of a WordprocessingML part, reading
+// no more of it than the budget allows.
+func writeParagraphs(sb *strings.Builder, f *zip.File, b *budget) error {
+	rc, err := f.Open()
+	if err != nil {
+		return fmt.Errorf("failed to open %s: %w", f.Name, err)
+	}
+	defer rc.Close()
+
+	var (
+		line   strings.Builder
+		isList bool
+	)
+	dec := xml.NewDecoder(b.reader(rc))
+	for {
+		tok, err := dec.Token()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("failed to parse %s: %w", f.Name, err)
+		}
+
+		switch el := tok.(type) {
+		case xml.StartElement:
+			switch el.Name.Local {
+			case "p":
+				line.Reset()
+				isList = false
+			case "numPr":
+				isList = true
+			case "t":
+				var text string
+				if err := dec.DecodeElement(&text, &el); err != nil {
+					return fmt.Errorf("failed to parse %s: %w", f.Name, err)
+				}
+				line.WriteString(text)
+			case "tab":
+				line.WriteString("\t")
+			case "br", "cr":
+				line.WriteString("\n")
+			}
+		case xml.EndElement:
+			if el.Name.Local != "p" {
+				continue
+			}
+			text := strings.TrimSpace(line.String())
+			if text == "" {
+				continue
+			}
+			if isList {
+				sb.WriteString("• ")
+			}
+			sb.WriteString(text)
+			sb.WriteString("\n")
+		}
+	}
+}
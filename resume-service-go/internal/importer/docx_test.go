@@ -0,0 +1,112 @@
+package importer
+
+import (
+	"archive/zip"
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+)
+
+// buildZip writes the named files into a zip archive in order.
+func buildZip(files ...[2]string) []byte {
+	var b bytes.Buffer
+	zw := zip.NewWriter(&b)
+	for _, f := range files {
+		w, _ := zw.Create(f[0])
+		w.Write([]byte(f[1]))
+	}
+	zw.Close()
+	return b.Bytes()
+}
+
+// wordXML wraps paragraphs in a WordprocessingML part.
+func wordXML(root string, paragraphs ...string) string {
+	return `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>` +
+		`<w:` + root + ` xmlns:w="http://schemas.openxmlformats.org/wordprocessingml/2006/main">` +
+		strings.Join(paragraphs, "") + `</w:` + root + `>`
+}
+
+func paragraph(runs ...string) string {
+	return "<w:p>" + strings.Join(runs, "") + "</w:p>"
+}
+
+func run(text string) string {
+	return `<w:r><w:t xml:space="preserve">` + text + `</w:t></w:r>`
+}
+
+func TestDOCXText(t *testing.T) {
+	listItem := `<w:p><w:pPr><w:numPr><w:ilvl w:val="0"/><w:numId w:val="1"/></w:numPr></w:pPr>` + run("Built the API") + `</w:p>`
+
+	tests := []struct {
+		name    string
+		data    []byte
+		want    string
+		wantErr bool
+	}{
+		{
+			name: "paragraphs and runs",
+			data: buildZip([2]string{"word/document.xml", wordXML("document",
+				paragraph(run("Jane "), run("Doe")),
+				paragraph(run("Backend Engineer")),
+			)}),
+			want: "Jane Doe\nBackend Engineer\n",
+		},
+		{
+			name: "list paragraphs as bullets",
+			data: buildZip([2]string{"word/document.xml", wordXML("document",
+				paragraph(run("Experience")),
+				listItem,
+			)}),
+			want: "Experience\n• Built the API\n",
+		},
+		{
+			name: "tabs, breaks and empty paragraphs",
+			data: buildZip([2]string{"word/document.xml", wordXML("document",
+				paragraph(run("Engineer"), "<w:r><w:tab/></w:r>", run("2020 - 2022")),
+				paragraph(),
+				paragraph(run("Berlin"), "<w:r><w:br/></w:r>", run("Germany")),
+			)}),
+			want: "Engineer\t2020 - 2022\nBerlin\nGermany\n",
+		},
+		{
+			name: "headers before the body",
+			data: buildZip(
+				[2]string{"word/document.xml", wordXML("document", paragraph(run("Summary")))},
+				[2]string{"word/header1.xml", wordXML("hdr", paragraph(run("jane@example.com")))},
+				[2]string{"word/media/header.png", "not XML"},
+			),
+			want: "jane@example.com\nSummary\n",
+		},
+		{
+			name:    "no document part",
+			data:    buildZip([2]string{"word/styles.xml", wordXML("styles")}),
+			wantErr: true,
+		},
+		{
+			name:    "not a zip archive",
+			data:    []byte("%PDF-1.4"),
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := DOCXText(tt.data)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("DOCXText() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("DOCXText() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDOCXTextTooLarge(t *testing.T) {
+	// Compresses to a few kilobytes but inflates past the budget
+	body := wordXML("document", paragraph(run(strings.Repeat("a", maxInflatedSize))))
+	_, err := DOCXText(buildZip([2]string{"word/document.xml", body}))
+	if !errors.Is(err, ErrTooLarge) {
+		t.Errorf("DOCXText() error = %v, want %v", err, ErrTooLarge)
+	}
+}
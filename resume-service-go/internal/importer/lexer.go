@@ -0,0 +1,224 @@
+package importer
+
+import (
+	"bytes"
+	"strconv"
+)
+
+type tokenKind int
+
+const (
+	tokenOperator tokenKind = iota
+	tokenNumber
+	tokenName
+	tokenString
+	tokenArray
+)
+
+// token is a lexical element of a PDF content stream or CMap.
+type token struct {
+	kind tokenKind
+	text string  // operator, number or name (with its leading slash)
+	str  []byte  // string bytes
+	arr  []token // array elements
+}
+
+func (t token) number() float64 {
+	n, _ := strconv.ParseFloat(t.text, 64)
+	return n
+}
+
+// lexer tokenizes PDF content streams. Dictionaries are not parsed; their
+// delimiters come through as operators, which the interpreter ignores.
+type lexer struct {
+	data []byte
+	pos  int
+}
+
+func (l *lexer) next() (token, bool) {
+	l.skipSpace()
+	if l.pos >= len(l.data) {
+		return token{}, false
+	}
+
+	c := l.data[l.pos]
+	switch {
+	case c == '(':
+		return token{kind: tokenString, str: l.literalString()}, true
+	case c == '<' && l.peek(1) == '<', c == '>' && l.peek(1) == '>':
+		l.pos += 2
+		return token{kind: tokenOperator, text: string(c) + string(c)}, true
+	case c == '<':
+		return token{kind: tokenString, str: l.hexString()}, true
+	case c == '[':
+		l.pos++
+		var arr []token
+		for {
+			l.skipSpace()
+			if l.pos >= len(l.data) {
+				break
+			}
+			if l.data[l.pos] == ']' {
+				l.pos++
+				break
+			}
+			t, ok := l.next()
+			if !ok {
+				break
+			}
+			arr = append(arr, t)
+		}
+		return token{kind: tokenArray, arr: arr}, true
+	case c == ']' || c == '>' || c == ')' || c == '{' || c == '}':
+		l.pos++
+		return token{kind: tokenOperator, text: string(c)}, true
+	case c == '/':
+		start := l.pos
+		l.pos++
+		for l.pos < len(l.data) && isRegular(l.data[l.pos]) {
+			l.pos++
+		}
+		return token{kind: tokenName, text: string(l.data[start:l.pos])}, true
+	}
+
+	start := l.pos
+	for l.pos < len(l.data) && isRegular(l.data[l.pos]) {
+		l.pos++
+	}
+	text := string(l.data[start:l.pos])
+	if _, err := strconv.ParseFloat(text, 64); err == nil {
+		return token{kind: tokenNumber, text: text}, true
+	}
+	return token{kind: tokenOperator, text: text}, true
+}
+
+func (l *lexer) peek(offset int) byte {
+	if l.pos+offset < len(l.data) {
+		return l.data[l.pos+offset]
+	}
+	return 0
+}
+
+func (l *lexer) skipSpace() {
+	for l.pos < len(l.data) {
+		switch l.data[l.pos] {
+		case ' ', '\t', '\r', '\n', '\f', 0:
+			l.pos++
+		case '%':
+			for l.pos < len(l.data) && l.data[l.pos] != '\n' && l.data[l.pos] != '\r' {
+				l.pos++
+			}
+		default:
+			return
+		}
+	}
+}
+
+// literalString reads a (...) string with nested parentheses and escapes.
+func (l *lexer) literalString() []byte {
+	var out []byte
+	depth := 0
+	for l.pos < len(l.data) {
+		c := l.data[l.pos]
+		l.pos++
+		switch c {
+		case '(':
+			if depth > 0 {
+				out = append(out, c)
+			}
+			depth++
+		case ')':
+			depth--
+			if depth == 0 {
+				return out
+			}
+			out = append(out, c)
+		case '\\':
+			if l.pos >= len(l.data) {
+				return out
+			}
+			e := l.data[l.pos]
+			l.pos++
+			switch e {
+			case 'n':
+				out = append(out, '\n')
+			case 'r':
+				out = append(out, '\r')
+			case 't':
+				out = append(out, '\t')
+			case 'b':
+				out = append(out, '\b')
+			case 'f':
+				out = append(out, '\f')
+			case '\r':
+				if l.peek(0) == '\n' {
+					l.pos++
+				}
+			case '\n':
+			default:
+				if e >= '0' && e <= '7' {
+					n := int(e - '0')
+					for i := 0; i < 2 && l.pos < len(l.data) && l.data[l.pos] >= '0' && l.data[l.pos] <= '7'; i++ {
+						n = n*8 + int(l.data[l.pos]-'0')
+						l.pos++
+					}
+					out = append(out, byte(n))
+				} else {
+					out = append(out, e)
+				}
+			}
+		default:
+			out = append(out, c)
+		}
+	}
+	return out
+}
+
+// hexString reads a <...> string; an odd final digit is padded with zero.
+func (l *lexer) hexString() []byte {
+	l.pos++
+	var digits []byte
+	for l.pos < len(l.data) && l.data[l.pos] != '>' {
+		if v, ok := hexValue(l.data[l.pos]); ok {
+			digits = append(digits, v)
+		}
+		l.pos++
+	}
+	l.pos++
+	if len(digits)%2 == 1 {
+		digits = append(digits, 0)
+	}
+
+	out := make([]byte, len(digits)/2)
+	for i := range out {
+		out[i] = digits[2*i]<<4 | digits[2*i+1]
+	}
+	return out
+}
+
+// skipInlineImage moves past the binary data of an inline image (BI ... ID <data> EI).
+func (l *lexer) skipInlineImage() {
+	for i := l.pos; i+2 < len(l.data); i++ {
+		if bytes.HasPrefix(l.data[i:], []byte("EI")) && isSpace(l.data[i-1]) && (i+2 == len(l.data) || !isRegular(l.data[i+2])) {
+			l.pos = i + 2
+			return
+		}
+	}
+	l.pos = len(l.data)
+}
+
+func isSpace(b byte) bool {
+	return b == ' ' || b == '\t' || b == '\r' || b == '\n' || b == '\f' || b == 0
+}
+
+func hexValue(b byte) (byte, bool) {
+	switch {
+	case b >= '0' && b <= '9':
+		return b - '0', true
+	case b >= 'a' && b <= 'f':
+		return b - 'a' + 10, true
+	case b >= 'A' && b <= 'F':
+		return b - 'A' + 10, true
+	}
+	return 0, false
+}
@@ -0,0 +1,104 @@
+package importer
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestLexer(t *testing.T) {
+	tests := []struct {
+		name string
+		data string
+		want []token
+	}{
+		{
+			name: "operators, numbers and names",
+			data: "BT /F1 12 Tf -3.5 .5 Td",
+			want: []token{
+				{kind: tokenOperator, text: "BT"},
+				{kind: tokenName, text: "/F1"},
+				{kind: tokenNumber, text: "12"},
+				{kind: tokenOperator, text: "Tf"},
+				{kind: tokenNumber, text: "-3.5"},
+				{kind: tokenNumber, text: ".5"},
+				{kind: tokenOperator, text: "Td"},
+			},
+		},
+		{
+			name: "literal string escapes and nesting",
+			data: `(a\(b\) \101\t(c) line\
+end\n)`,
+			want: []token{{kind: tokenString, str: []byte("a(b) A\t(c) lineend\n")}},
+		},
+		{
+			name: "hex strings with spaces and an odd digit",
+			data: "<48 65 6C6C6F> <414>",
+			want: []token{
+				{kind: tokenString, str: []byte("Hello")},
+				{kind: tokenString, str: []byte{0x41, 0x40}},
+			},
+		},
+		{
+			name: "arrays",
+			data: "[(A) -250 <42>] TJ",
+			want: []token{
+				{kind: tokenArray, arr: []token{
+					{kind: tokenString, str: []byte("A")},
+					{kind: tokenNumber, text: "-250"},
+					{kind: tokenString, str: []byte("B")},
+				}},
+				{kind: tokenOperator, text: "TJ"},
+			},
+		},
+		{
+			name: "dictionary delimiters and comments",
+			data: "<< /MCID 0 >> BDC % marked content\nEMC",
+			want: []token{
+				{kind: tokenOperator, text: "<<"},
+				{kind: tokenName, text: "/MCID"},
+				{kind: tokenNumber, text: "0"},
+				{kind: tokenOperator, text: ">>"},
+				{kind: tokenOperator, text: "BDC"},
+				{kind: tokenOperator, text: "EMC"},
+			},
+		},
+		{
+			name: "unterminated string",
+			data: "(abc",
+			want: []token{{kind: tokenString, str: []byte("abc")}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			lex := lexer{data: []byte(tt.data)}
+			var got []token
+			for {
+				tok, ok := lex.next()
+				if !ok {
+					break
+				}
+				got = append(got, tok)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("tokens = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLexerSkipInlineImage(t *testing.T) {
+	lex := lexer{data: []byte("BI /W 2 /H 1 ID \x00EI\xff EI Q")}
+	for {
+		tok, ok := lex.next()
+		if !ok {
+			t.Fatal("lexer ended before ID")
+		}
+		if tok.text == "ID" {
+			break
+		}
+	}
+	lex.skipInlineImage()
+	if tok, ok := lex.next(); !ok || tok.text != "Q" {
+		t.Errorf("token after inline image = %+v, want Q", tok)
+	}
+}
@@ -0,0 +1,52 @@
+package importer
+
+import (
+	"errors"
+	"io"
+)
+
+// maxInflatedSize bounds how much the compressed parts of one uploaded file
+// may inflate to. Uploads are small, but a few kilobytes of deflate data can
+// expand to gigabytes.
+const maxInflatedSize = 32 << 20
+
+// ErrTooLarge is returned for files whose content inflates to more than
+// maxInflatedSize.
+var ErrTooLarge = errors.New("document content is too large")
+
+// budget is what is left of maxInflatedSize for one file. It is shared by
+// every compressed part read from the file.
+type budget struct {
+	remaining int64
+}
+
+func newBudget() *budget {
+	return &budget{remaining: maxInflatedSize}
+}
+
+// reader returns r limited to the budget. Reads fail with ErrTooLarge once
+// it is exceeded.
+func (b *budget) reader(r io.Reader) io.Reader {
+	return &budgetReader{r: r, b: b}
+}
+
+type budgetReader struct {
+	r io.Reader
+	b *budget
+}
+
+func (br *budgetReader) Read(p []byte) (int, error) {
+	if br.b.remaining < 0 {
+		return 0, ErrTooLarge
+	}
+	// One byte past the budget is enough to tell that it is exceeded
+	if int64(len(p)) > br.b.remaining+1 {
+		p = p[:br.b.remaining+1]
+	}
+	n, err := br.r.Read(p)
+	br.b.remaining -= int64(n)
+	if br.b.remaining < 0 {
+		return n, ErrTooLarge
+	}
+	return n, err
+}
@@ -0,0 +1,197 @@
+package importer
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"strings"
+
+	pb "github.com/iprotoresume/shared/proto"
+)
+
+// linkedInFiles are the CSV files of a LinkedIn data export that are mapped.
+var linkedInFiles = []string{
+	"profile.csv", "email addresses.csv", "phonenumbers.csv", "positions.csv", "education.csv",
+	"skills.csv", "certifications.csv", "languages.csv", "projects.csv", "honors.csv",
+}
+
+// LinkedIn maps the ZIP archive from LinkedIn's "Get a copy of your data"
+// export onto ResumeData. Missing files are skipped, so partial exports work
+// as long as one of the known files is present.
+func LinkedIn(data []byte) (*pb.ResumeData, error) {
+	zr, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		return nil, fmt.Errorf("not a ZIP archive: %w", err)
+	}
+
+	tables := make(map[string][]map[string]string)
+	b := newBudget()
+	for _, f := range zr.File {
+		name := strings.ToLower(path.Base(f.Name))
+		for _, known := range linkedInFiles {
+			if name != known {
+				continue
+			}
+			rows, err := readCSV(f, b)
+			if err != nil {
+				return nil, fmt.Errorf("failed to read %s: %w", path.Base(f.Name), err)
+			}
+			tables[name] = rows
+		}
+	}
+	if len(tables) == 0 {
+		return nil, errors.New("not a LinkedIn data export: no known CSV files found")
+	}
+
+	r := &pb.ResumeData{}
+	if rows := tables["profile.csv"]; len(rows) > 0 {
+		p := rows[0]
+		r.FullName = strings.TrimSpace(p["first name"] + " " + p["last name"])
+		r.JobTitle = p["headline"]
+		r.Summary = p["summary"]
+		r.Location = p["geo location"]
+	}
+	r.Email = primaryEmail(tables["email addresses.csv"])
+	if rows := tables["phonenumbers.csv"]; len(rows) > 0 {
+		r.Phone = rows[0]["number"]
+	}
+
+	for _, p := range tables["positions.csv"] {
+		endDate := normalizeDate(p["finished on"])
+		if endDate == "" {
+			endDate = "Present"
+		}
+		r.Experience = append(r.Experience, &pb.Experience{
+			Title:       p["title"],
+			Company:     p["company name"],
+			StartDate:   normalizeDate(p["started on"]),
+			EndDate:     endDate,
+			Description: p["description"],
+		})
+	}
+	for _, e := range tables["education.csv"] {
+		r.Education = append(r.Education, &pb.Education{
+			Degree:         e["degree name"],
+			Institution:    e["school name"],
+			GraduationDate: normalizeDate(e["end date"]),
+		})
+	}
+
+	// LinkedIn skills are not categorized, so they form a single group
+	var skills []string
+	for _, s := range tables["skills.csv"] {
+		if name := strings.TrimSpace(s["name"]); name != "" {
+			skills = append(skills, name)
+		}
+	}
+	if len(skills) > 0 {
+		r.SkillGroups = append(r.SkillGroups, &pb.SkillGroup{Category: "Skills", Items: skills})
+	}
+
+	for _, c := range tables["certifications.csv"] {
+		r.Certificates = append(r.Certificates, &pb.Certificate{
+			Name:   c["name"],
+			Issuer: c["authority"],
+			Date:   normalizeDate(c["started on"]),
+			Link:   c["url"],
+		})
+	}
+	for _, l := range tables["languages.csv"] {
+		r.Languages = append(r.Languages, &pb.Language{
+			Language:    l["name"],
+			Proficiency: l["proficiency"],
+		})
+	}
+	for _, p := range tables["projects.csv"] {
+		desc := p["description"]
+		if url := p["url"]; url != "" {
+			desc = strings.TrimSpace(desc + "\n" + url)
+		}
+		r.Projects = append(r.Projects, &pb.Project{
+			Title:       p["title"],
+			Description: desc,
+			Date:        normalizeDate(p["started on"]),
+		})
+	}
+	for _, h := range tables["honors.csv"] {
+		r.Achievements = append(r.Achievements, &pb.Achievement{
+			Title:       h["title"],
+			Description: h["description"],
+		})
+	}
+
+	return r, nil
+}
+
+// readCSV reads a LinkedIn CSV file into rows keyed by lower-cased column
+// name. Some exports start with free-text notes before the header row; the
+// header is taken to be the first row with more than one column. The file
+// is read no further than the budget allows.
+func readCSV(f *zip.File, b *budget) ([]map[string]string, error) {
+	rc, err := f.Open()
+	if err != nil {
+		return nil, err
+	}
+	defer rc.Close()
+
+	raw, err := io.ReadAll(b.reader(rc))
+	if err != nil {
+		return nil, err
+	}
+	cr := csv.NewReader(bytes.NewReader(bytes.TrimPrefix(raw, []byte("\xef\xbb\xbf"))))
+	cr.FieldsPerRecord = -1
+	cr.LazyQuotes = true
+
+	records, err := cr.ReadAll()
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		header []string
+		rows   []map[string]string
+	)
+	for _, rec := range records {
+		if header == nil {
+			if len(rec) > 1 {
+				header = rec
+				for i, col := range header {
+					header[i] = strings.ToLower(strings.TrimSpace(col))
+				}
+			}
+			continue
+		}
+		row := make(map[string]string, len(header))
+		for i, col := range header {
+			if i < len(rec) {
+				row[col] = strings.TrimSpace(rec[i])
+			}
+		}
+		rows = append(rows, row)
+	}
+	// Single-column files such as Skills.csv have no multi-column header
+	if header == nil && len(records) > 0 {
+		col := strings.ToLower(strings.TrimSpace(records[0][0]))
+		for _, rec := range records[1:] {
+			rows = append(rows, map[string]string{col: strings.TrimSpace(rec[0])})
+		}
+	}
+	return rows, nil
+}
+
+// primaryEmail returns the address marked primary, or the first one.
+func primaryEmail(rows []map[string]string) string {
+	for _, row := range rows {
+		if strings.EqualFold(row["primary"], "yes") {
+			return row["email address"]
+		}
+	}
+	if len(rows) > 0 {
+		return rows[0]["email address"]
+	}
+	return ""
+}
@@ -0,0 +1,147 @@
+package importer
+
+import (
+	"archive/zip"
+	"bytes"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+
+	pb "github.com/iprotoresume/shared/proto"
+	"google.golang.org/protobuf/encoding/prototext"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestReadCSV(t *testing.T) {
+	tests := []struct {
+		name string
+		csv  string
+		want []map[string]string
+	}{
+		{
+			name: "header row",
+			csv:  "First Name,Last Name\nJane,Doe\n",
+			want: []map[string]string{{"first name": "Jane", "last name": "Doe"}},
+		},
+		{
+			name: "notes before the header",
+			csv:  "Notes:\n\"When exporting your connection data, you may notice...\"\n\nName,Proficiency\nGerman,Native or bilingual proficiency\n",
+			want: []map[string]string{{"name": "German", "proficiency": "Native or bilingual proficiency"}},
+		},
+		{
+			name: "byte order mark and padded cells",
+			csv:  "\xef\xbb\xbfTitle , Description\n Speaker ,\"Talk, on Go\"\n",
+			want: []map[string]string{{"title": "Speaker", "description": "Talk, on Go"}},
+		},
+		{
+			name: "short and long rows",
+			csv:  "Name,Authority,Url\nCKA\nAWS,Amazon,https://aws.example,extra\n",
+			want: []map[string]string{{"name": "CKA"}, {"name": "AWS", "authority": "Amazon", "url": "https://aws.example"}},
+		},
+		{
+			name: "single column",
+			csv:  "Name\nGo\n Kubernetes \n",
+			want: []map[string]string{{"name": "Go"}, {"name": "Kubernetes"}},
+		},
+		{
+			name: "header only",
+			csv:  "Name\n",
+		},
+		{
+			name: "empty",
+			csv:  "",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data := buildZip([2]string{"file.csv", tt.csv})
+			zr, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+			if err != nil {
+				t.Fatal(err)
+			}
+			got, err := readCSV(zr.File[0], newBudget())
+			if err != nil {
+				t.Fatalf("readCSV() error = %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("readCSV() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLinkedIn(t *testing.T) {
+	export := buildZip(
+		[2]string{"Basic_LinkedInDataExport/Profile.csv", "First Name,Last Name,Headline,Summary,Geo Location\nJane,Doe,Backend Engineer,Builds APIs.,\"Berlin, Germany\"\n"},
+		[2]string{"Basic_LinkedInDataExport/Email Addresses.csv", "Email Address,Confirmed,Primary\nold@example.com,Yes,No\njane@example.com,Yes,Yes\n"},
+		[2]string{"Basic_LinkedInDataExport/PhoneNumbers.csv", "Extension,Number,Type\n,+49 170 1234567,Mobile\n"},
+		[2]string{"Basic_LinkedInDataExport/Positions.csv", "Company Name,Title,Description,Location,Started On,Finished On\n" +
+			"Acme,Senior Engineer,Led the platform team,Berlin,Mar 2021,\n" +
+			"Initech,Developer,,Remote,2017,Feb 2021\n"},
+		[2]string{"Basic_LinkedInDataExport/Education.csv", "School Name,Start Date,End Date,Notes,Degree Name,Activities\nTU Berlin,2012,2016,,BSc Computer Science,\n"},
+		[2]string{"Basic_LinkedInDataExport/Skills.csv", "Name\nGo\nKubernetes\n\n"},
+		[2]string{"Basic_LinkedInDataExport/Certifications.csv", "Name,Url,Authority,Started On,Finished On,License Number\nCKA,https://cncf.example/cka,CNCF,Oct 2022,,\n"},
+		[2]string{"Basic_LinkedInDataExport/Languages.csv", "Name,Proficiency\nEnglish,Full professional proficiency\n"},
+		[2]string{"Basic_LinkedInDataExport/Projects.csv", "Title,Description,Url,Started On,Finished On\nresumectl,A CLI,https://example.com/resumectl,Jun 2020,\n"},
+		[2]string{"Basic_LinkedInDataExport/Honors.csv", "Title,Description,Issued On\nHackathon winner,Best developer tool,Nov 2019\n"},
+		[2]string{"Basic_LinkedInDataExport/Connections.csv", "First Name,Last Name\nJohn,Smith\n"},
+	)
+	want := &pb.ResumeData{
+		FullName: "Jane Doe",
+		JobTitle: "Backend Engineer",
+		Summary:  "Builds APIs.",
+		Location: "Berlin, Germany",
+		Email:    "jane@example.com",
+		Phone:    "+49 170 1234567",
+		Experience: []*pb.Experience{
+			{Title: "Senior Engineer", Company: "Acme", StartDate: "03/2021", EndDate: "Present", Description: "Led the platform team"},
+			{Title: "Developer", Company: "Initech", StartDate: "2017", EndDate: "02/2021"},
+		},
+		Education: []*pb.Education{
+			{Degree: "BSc Computer Science", Institution: "TU Berlin", GraduationDate: "2016"},
+		},
+		SkillGroups: []*pb.SkillGroup{{Category: "Skills", Items: []string{"Go", "Kubernetes"}}},
+		Certificates: []*pb.Certificate{
+			{Name: "CKA", Issuer: "CNCF", Date: "10/2022", Link: "https://cncf.example/cka"},
+		},
+		Languages: []*pb.Language{{Language: "English", Proficiency: "Full professional proficiency"}},
+		Projects: []*pb.Project{
+			{Title: "resumectl", Description: "A CLI\nhttps://example.com/resumectl", Date: "06/2020"},
+		},
+		Achievements: []*pb.Achievement{{Title: "Hackathon winner", Description: "Best developer tool"}},
+	}
+
+	got, err := LinkedIn(export)
+	if err != nil {
+		t.Fatalf("LinkedIn() error = %v", err)
+	}
+	if !proto.Equal(got, want) {
+		t.Errorf("LinkedIn() = %v\nwant %v", prototext.Format(got), prototext.Format(want))
+	}
+}
+
+func TestLinkedInErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    []byte
+		wantErr string
+		is      error
+	}{
+		{name: "not a zip archive", data: []byte("First Name,Last Name"), wantErr: "not a ZIP archive"},
+		{name: "no known files", data: buildZip([2]string{"Connections.csv", "First Name\nJohn\n"}), wantErr: "no known CSV files"},
+		{
+			name: "inflating past the budget",
+			data: buildZip([2]string{"Skills.csv", "Name\n" + strings.Repeat("Go\n", maxInflatedSize/3+1)}),
+			is:   ErrTooLarge,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := LinkedIn(tt.data)
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) || tt.is != nil && !errors.Is(err, tt.is) {
+				t.Errorf("LinkedIn() error = %v, want %q %v", err, tt.wantErr, tt.is)
+			}
+		})
+	}
+}
@@ -0,0 +1,621 @@
+package importer
+
+import (
+	"regexp"
+	"strings"
+	"unicode"
+
+	pb "github.com/iprotoresume/shared/proto"
+)
+
+type section int
+
+const (
+	sectionHeader section = iota
+	sectionContact
+	sectionSummary
+	sectionExperience
+	sectionEducation
+	sectionSkills
+	sectionProjects
+	sectionCertificates
+	sectionLanguages
+	sectionAchievements
+	sectionOther
+)
+
+// sectionHeadings maps normalized heading lines to the section they start.
+var sectionHeadings = map[string]section{
+	"contact":                     sectionContact,
+	"contact information":         sectionContact,
+	"contact details":             sectionContact,
+	"personal details":            sectionContact,
+	"personal information":        sectionContact,
+	"summary":                     sectionSummary,
+	"professional summary":        sectionSummary,
+	"career summary":              sectionSummary,
+	"profile":                     sectionSummary,
+	"professional profile":        sectionSummary,
+	"about":                       sectionSummary,
+	"about me":                    sectionSummary,
+	"objective":                   sectionSummary,
+	"career objective":            sectionSummary,
+	"experience":                  sectionExperience,
+	"work experience":             sectionExperience,
+	"professional experience":     sectionExperience,
+	"relevant experience":         sectionExperience,
+	"employment":                  sectionExperience,
+	"employment history":          sectionExperience,
+	"work history":                sectionExperience,
+	"career history":              sectionExperience,
+	"education":                   sectionEducation,
+	"education and training":      sectionEducation,
+	"academic background":         sectionEducation,
+	"skills":                      sectionSkills,
+	"technical skills":            sectionSkills,
+	"key skills":                  sectionSkills,
+	"core skills":                 sectionSkills,
+	"core competencies":           sectionSkills,
+	"skills and expertise":        sectionSkills,
+	"technologies":                sectionSkills,
+	"projects":                    sectionProjects,
+	"personal projects":           sectionProjects,
+	"selected projects":           sectionProjects,
+	"key projects":                sectionProjects,
+	"side projects":               sectionProjects,
+	"certifications":              sectionCertificates,
+	"certificates":                sectionCertificates,
+	"licenses and certifications": sectionCertificates,
+	"languages":                   sectionLanguages,
+	"achievements":                sectionAchievements,
+	"accomplishments":             sectionAchievements,
+	"awards":                      sectionAchievements,
+	"honors":                      sectionAchievements,
+	"honors and awards":           sectionAchievements,
+	"awards and honors":           sectionAchievements,
+	"interests":                   sectionOther,
+	"hobbies":                     sectionOther,
+	"references":                  sectionOther,
+	"volunteering":                sectionOther,
+	"volunteer experience":        sectionOther,
+	"publications":                sectionOther,
+}
+
+var (
+	emailPattern    = regexp.MustCompile(`[A-Za-z0-9._%+\-]+@[A-Za-z0-9.\-]+\.[A-Za-z]{2,}`)
+	linkedinPattern = regexp.MustCompile(`(?i)(?:https?://)?(?:[a-z]{2,3}\.)?linkedin\.com/in/[A-Za-z0-9_\-%]+/?`)
+	githubPattern   = regexp.MustCompile(`(?i)(?:https?://)?(?:www\.)?github\.com/[A-Za-z0-9_\-]+`)
+	websitePattern  = regexp.MustCompile(`(?i)(?:https?://)?(?:www\.)?[a-z0-9\-]+(?:\.[a-z0-9\-]+)*\.(?:com|io|dev|me|net|org|co|app|site|tech|page)(?:/[^\s,|]*)?`)
+	phonePattern    = regexp.MustCompile(`\+?\(?\d[\d\s().\-]{7,}\d`)
+
+	month     = `(?:jan(?:uary)?|feb(?:ruary)?|mar(?:ch)?|apr(?:il)?|may|june?|july?|aug(?:ust)?|sep(?:t(?:ember)?)?|oct(?:ober)?|nov(?:ember)?|dec(?:ember)?)\.?`
+	date      = `(?:` + month + `\s+\d{4}|\d{1,2}/\d{4}|\d{4})`
+	dateRange = regexp.MustCompile(`(?i)(` + date + `)\s*(?:-|–|—|to|until)\s*(` + date + `|present|current|now|today)`)
+	dateOnly  = regexp.MustCompile(`(?i)\b` + date + `\b`)
+	monthYear = regexp.MustCompile(`(?i)^(` + month + `)\s+(\d{4})$`)
+	numYear   = regexp.MustCompile(`^(\d{1,2})/(\d{4})$`)
+
+	degreePattern      = regexp.MustCompile(`(?i)(bachelor|master|doctor|diploma|associate|high school|abitur|ph\.?\s?d|\bmba\b|\bb\.?sc\b|\bm\.?sc\b|\bb\.?eng\b|\bm\.?eng\b|\bbs\b|\bms\b|\bba\b|\bma\b|\bb\.[as]\.|\bm\.[as]\.)`)
+	institutionPattern = regexp.MustCompile(`(?i)(university|universit[äé]|universidad|college|institute|school|academy|polytechnic)`)
+	rolePattern        = regexp.MustCompile(`(?i)\b(engineer|developer|manager|lead|intern|analyst|designer|consultant|architect|director|specialist|scientist|administrator|officer|head|vp|president|founder|coordinator|assistant|associate|programmer|devops|sre|cto|ceo|tester|owner)\b`)
+	companyPattern     = regexp.MustCompile(`(?i)\b(inc|llc|ltd|gmbh|corp|corporation|company|co|technologies|labs|group|ag|sa|plc|solutions|systems|studio)\b\.?`)
+	techLabel          = regexp.MustCompile(`(?i)^(tech(?:nologies|nology| stack)?|stack|tools|built with)\s*:\s*`)
+
+	bulletPrefixes   = []string{"•", "●", "▪", "■", "◦", "‣", "∙", "·", "", "*", "-", "–", "—"}
+	roleSeparators   = []string{" at ", " @ ", " | ", " — ", " – ", " - ", ", "}
+	listSeparators   = regexp.MustCompile(`\s*[,;•|·●▪]\s*`)
+	degreeSeparators = regexp.MustCompile(`\s*(?:,|\||–|—| - | at )\s*`)
+	months           = map[string]string{
+		"jan": "01", "feb": "02", "mar": "03", "apr": "04", "may": "05", "jun": "06",
+		"jul": "07", "aug": "08", "sep": "09", "oct": "10", "nov": "11", "dec": "12",
+	}
+)
+
+// Parse builds a ResumeData draft from the plain text of a resume. Contact
+// details are picked up from the lines before the first section heading (or
+// a contact section), which also provide the name and job title; the rest is
+// split into sections by well-known headings and parsed per section.
+func Parse(text string) *pb.ResumeData {
+	r := &pb.ResumeData{}
+
+	sections := make(map[section][]string)
+	current := sectionHeader
+	for _, line := range strings.Split(text, "\n") {
+		line = strings.TrimSpace(strings.ReplaceAll(line, "\t", " "))
+		if line == "" {
+			continue
+		}
+		if s, ok := headingSection(line); ok {
+			current = s
+			continue
+		}
+		sections[current] = append(sections[current], line)
+	}
+
+	parseHeader(r, sections[sectionHeader], sections[sectionContact])
+	if summary := joinWrapped(sections[sectionSummary]); summary != "" {
+		r.Summary = summary
+	}
+	r.Experience = parseExperience(sections[sectionExperience])
+	r.Education = parseEducation(sections[sectionEducation])
+	r.SkillGroups, r.Skills = parseSkills(sections[sectionSkills])
+	r.Projects = parseProjects(sections[sectionProjects])
+	r.Certificates = parseCertificates(sections[sectionCertificates])
+	r.Languages = parseLanguages(sections[sectionLanguages])
+	r.Achievements = parseAchievements(sections[sectionAchievements])
+	return r
+}
+
+// headingSection reports whether line is a section heading such as
+// "WORK EXPERIENCE" or "Skills:".
+func headingSection(line string) (section, bool) {
+	if len(line) > 40 {
+		return 0, false
+	}
+	key := strings.ToLower(strings.TrimFunc(line, func(r rune) bool { return !unicode.IsLetter(r) }))
+	key = strings.ReplaceAll(key, "&", "and")
+	key = strings.Join(strings.Fields(key), " ")
+	s, ok := sectionHeadings[key]
+	return s, ok
+}
+
+// parseHeader extracts contact details, then takes the first remaining short
+// line as the name, the next as the job title and a "City, Country" line as
+// the location. Long header lines become the summary.
+func parseHeader(r *pb.ResumeData, header, contact []string) {
+	var summary []string
+	for _, line := range append(header, contact...) {
+		rest := line
+		rest = extract(rest, emailPattern, &r.Email)
+		rest = extract(rest, linkedinPattern, &r.Linkedin)
+		rest = extract(rest, githubPattern, &r.Github)
+		rest = extract(rest, websitePattern, &r.Website)
+		rest = extractPhone(rest, &r.Phone)
+		rest = trimSeparators(rest)
+		if rest == "" {
+			continue
+		}
+
+		words := len(strings.Fields(rest))
+		switch {
+		case r.FullName == "" && words <= 5 && !strings.ContainsAny(rest, "0123456789,"):
+			r.FullName = rest
+		case words > 15:
+			summary = append(summary, rest)
+		case r.Location == "" && words <= 6 && strings.Contains(rest, ","):
+			r.Location = rest
+		case r.JobTitle == "" && words <= 10:
+			r.JobTitle = rest
+		}
+	}
+
+	r.Linkedin = stripScheme(r.Linkedin)
+	r.Github = stripScheme(r.Github)
+	r.Website = stripScheme(r.Website)
+	r.Summary = joinWrapped(summary)
+}
+
+// extract stores the first match of pattern in dst when dst is still empty
+// and returns line with all matches removed.
+func extract(line string, pattern *regexp.Regexp, dst *string) string {
+	if m := pattern.FindString(line); m != "" && *dst == "" {
+		*dst = strings.TrimSuffix(m, "/")
+	}
+	return pattern.ReplaceAllString(line, " ")
+}
+
+// extractPhone is extract for phone numbers, ignoring digit runs too short
+// to be one, such as year ranges.
+func extractPhone(line string, dst *string) string {
+	for _, m := range phonePattern.FindAllString(line, -1) {
+		digits := strings.IndexFunc(m, unicode.IsDigit)
+		count := 0
+		for _, c := range m[digits:] {
+			if unicode.IsDigit(c) {
+				count++
+			}
+		}
+		if count < 9 {
+			continue
+		}
+		if *dst == "" {
+			*dst = strings.TrimSpace(m)
+		}
+		line = strings.Replace(line, m, " ", 1)
+	}
+	return line
+}
+
+// parseExperience starts an entry at every line with a date range. The
+// non-bullet lines right before it (or the rest of the date line) name the
+// role and company; the lines after it form the description.
+func parseExperience(lines []string) []*pb.Experience {
+	var (
+		entries []*pb.Experience
+		current *pb.Experience
+		desc    []string
+		pending []string // non-bullet lines since the last bullet or date line
+	)
+	flush := func() {
+		if current != nil {
+			current.Description = strings.Join(desc, "\n")
+			entries = append(entries, current)
+		}
+	}
+
+	for _, line := range lines {
+		if m := dateRange.FindStringSubmatchIndex(line); m != nil {
+			rest := trimSeparators(line[:m[0]] + " " + line[m[1]:])
+			headerLines := 2
+			if rest != "" {
+				headerLines = 1
+			}
+			header := pending
+			if len(header) > headerLines {
+				header = header[len(header)-headerLines:]
+			}
+			desc = desc[:len(desc)-len(header)]
+			flush()
+
+			parts := append([]string(nil), header...)
+			if rest != "" {
+				parts = append(parts, rest)
+			}
+			title, company := splitRole(parts)
+			current = &pb.Experience{
+				Title:     title,
+				Company:   company,
+				StartDate: normalizeDate(line[m[2]:m[3]]),
+				EndDate:   normalizeDate(line[m[4]:m[5]]),
+			}
+			desc, pending = nil, nil
+			continue
+		}
+
+		text, bullet := stripBullet(line)
+		switch {
+		case current != nil && current.Company == "" && len(desc) == 0 && !bullet && len(strings.Fields(text)) <= 6:
+			// "Title | Dates" followed by the company on its own line
+			current.Company = text
+		case bullet:
+			desc = append(desc, "• "+text)
+			pending = nil
+		case len(desc) > 0 && strings.HasPrefix(desc[len(desc)-1], "• ") && startsLower(text):
+			// A bullet wrapped onto the next line
+			desc[len(desc)-1] += " " + text
+		default:
+			desc = append(desc, text)
+			pending = append(pending, text)
+		}
+	}
+	flush()
+	return entries
+}
+
+// splitRole decides which header part is the job title and which the
+// company, splitting a single "Title at Company" style line first.
+func splitRole(parts []string) (title, company string) {
+	if len(parts) == 1 {
+		for _, sep := range roleSeparators {
+			if before, after, ok := strings.Cut(parts[0], sep); ok {
+				parts = []string{strings.TrimSpace(before), strings.TrimSpace(after)}
+				break
+			}
+		}
+	}
+	switch len(parts) {
+	case 0:
+		return "", ""
+	case 1:
+		return parts[0], ""
+	}
+
+	title, company = parts[0], parts[1]
+	firstIsCompany := companyPattern.MatchString(title) || !rolePattern.MatchString(title)
+	secondIsRole := rolePattern.MatchString(company) && !companyPattern.MatchString(company)
+	if firstIsCompany && secondIsRole {
+		title, company = company, title
+	}
+	return title, company
+}
+
+// parseEducation starts a new entry whenever a line repeats a degree or an
+// institution the current entry already has.
+func parseEducation(lines []string) []*pb.Education {
+	var entries []*pb.Education
+	current := &pb.Education{}
+	flush := func() {
+		if current.Degree != "" || current.Institution != "" {
+			entries = append(entries, current)
+		}
+		current = &pb.Education{}
+	}
+
+	for _, line := range lines {
+		text, _ := stripBullet(line)
+		if m := dateRange.FindStringSubmatch(text); m != nil {
+			setOnce(&current.GraduationDate, normalizeDate(m[2]))
+			text = dateRange.ReplaceAllString(text, " ")
+		}
+		if years := dateOnly.FindAllString(text, -1); len(years) > 0 {
+			setOnce(&current.GraduationDate, normalizeDate(years[len(years)-1]))
+			text = dateOnly.ReplaceAllString(text, " ")
+		}
+		text = trimSeparators(text)
+		if text == "" {
+			continue
+		}
+
+		isDegree, isInstitution := degreePattern.MatchString(text), institutionPattern.MatchString(text)
+		switch {
+		case isDegree && isInstitution:
+			if current.Degree != "" || current.Institution != "" {
+				flush()
+			}
+			current.Degree, current.Institution = splitDegree(text)
+		case isDegree:
+			if current.Degree != "" {
+				flush()
+			}
+			current.Degree = text
+		case isInstitution:
+			if current.Institution != "" {
+				flush()
+			}
+			current.Institution = text
+		}
+	}
+	flush()
+	return entries
+}
+
+// splitDegree separates "BSc Computer Science, MIT" style lines into the
+// degree and the institution.
+func splitDegree(text string) (degree, institution string) {
+	var rest []string
+	for _, part := range degreeSeparators.Split(text, -1) {
+		if institution == "" && institutionPattern.MatchString(part) && !degreePattern.MatchString(part) {
+			institution = part
+		} else if part != "" {
+			rest = append(rest, part)
+		}
+	}
+	if institution == "" {
+		return text, ""
+	}
+	return strings.Join(rest, ", "), institution
+}
+
+// parseSkills turns "Category: a, b" lines into skill groups and splits
+// every other line into individual skills.
+func parseSkills(lines []string) ([]*pb.SkillGroup, []string) {
+	var (
+		groups []*pb.SkillGroup
+		skills []string
+		seen   = make(map[string]bool)
+	)
+	for _, line := range lines {
+		text, _ := stripBullet(line)
+		if category, items, ok := strings.Cut(text, ":"); ok && len(category) <= 40 {
+			if list := splitList(items, seen); len(list) > 0 {
+				groups = append(groups, &pb.SkillGroup{Category: strings.TrimSpace(category), Items: list})
+			}
+			continue
+		}
+		skills = append(skills, splitList(text, seen)...)
+	}
+	return groups, skills
+}
+
+func splitList(text string, seen map[string]bool) []string {
+	var items []string
+	for _, item := range listSeparators.Split(text, -1) {
+		item = trimSeparators(item)
+		key := strings.ToLower(item)
+		if item == "" || len(item) > 40 || seen[key] {
+			continue
+		}
+		seen[key] = true
+		items = append(items, item)
+	}
+	return items
+}
+
+// parseProjects starts a project at every short non-bullet line that does
+// not read like a sentence; other lines describe the current project.
+func parseProjects(lines []string) []*pb.Project {
+	var (
+		projects []*pb.Project
+		desc     []string
+	)
+	flush := func() {
+		if len(projects) > 0 {
+			projects[len(projects)-1].Description = strings.Join(desc, "\n")
+		}
+		desc = nil
+	}
+
+	for _, line := range lines {
+		text, bullet := stripBullet(line)
+		if m := techLabel.FindStringIndex(text); m != nil && len(projects) > 0 {
+			p := projects[len(projects)-1]
+			seen := make(map[string]bool)
+			for _, tech := range p.TechStack {
+				seen[strings.ToLower(tech)] = true
+			}
+			p.TechStack = append(p.TechStack, splitList(text[m[1]:], seen)...)
+			continue
+		}
+		if bullet || len(strings.Fields(text)) > 8 || strings.HasSuffix(text, ".") || len(projects) > 0 && startsLower(text) {
+			if len(projects) == 0 {
+				projects = append(projects, &pb.Project{})
+			}
+			if !bullet && len(desc) > 0 && startsLower(text) {
+				desc[len(desc)-1] += " " + text
+			} else if bullet {
+				desc = append(desc, "• "+text)
+			} else {
+				desc = append(desc, text)
+			}
+			continue
+		}
+
+		flush()
+		p := &pb.Project{}
+		if m := dateRange.FindString(text); m != "" {
+			p.Date = normalizeDate(dateRange.FindStringSubmatch(text)[1])
+			text = strings.Replace(text, m, " ", 1)
+		} else if m := dateOnly.FindString(text); m != "" {
+			p.Date = normalizeDate(m)
+			text = strings.Replace(text, m, " ", 1)
+		}
+		title, tech, ok := strings.Cut(text, "|")
+		if ok {
+			p.TechStack = splitList(tech, map[string]bool{})
+		}
+		p.Title = trimSeparators(title)
+		projects = append(projects, p)
+	}
+	flush()
+	return projects
+}
+
+// parseCertificates reads one certificate per line as "Name - Issuer (Date)".
+func parseCertificates(lines []string) []*pb.Certificate {
+	var certs []*pb.Certificate
+	for _, line := range lines {
+		text, _ := stripBullet(line)
+		c := &pb.Certificate{}
+		if m := dateOnly.FindString(text); m != "" {
+			c.Date = normalizeDate(m)
+			text = strings.Replace(text, m, " ", 1)
+		}
+		text = trimSeparators(text)
+		for _, sep := range []string{" - ", " – ", " | ", ", "} {
+			if name, issuer, ok := strings.Cut(text, sep); ok {
+				c.Name, c.Issuer = trimSeparators(name), trimSeparators(issuer)
+				break
+			}
+		}
+		if c.Name == "" {
+			c.Name = text
+		}
+		if c.Name != "" {
+			certs = append(certs, c)
+		}
+	}
+	return certs
+}
+
+// parseLanguages reads entries like "English (Native)", "German - B2" or
+// "French: Fluent", several per line when separated by commas.
+func parseLanguages(lines []string) []*pb.Language {
+	var languages []*pb.Language
+	for _, line := range lines {
+		text, _ := stripBullet(line)
+		for _, item := range listSeparators.Split(text, -1) {
+			item = strings.TrimSpace(item)
+			if item == "" {
+				continue
+			}
+			l := &pb.Language{Language: item}
+			if name, level, ok := strings.Cut(item, "("); ok {
+				l.Language, l.Proficiency = strings.TrimSpace(name), strings.TrimSpace(strings.TrimSuffix(level, ")"))
+			} else {
+				for _, sep := range []string{":", " - ", " – "} {
+					if name, level, ok := strings.Cut(item, sep); ok {
+						l.Language, l.Proficiency = strings.TrimSpace(name), strings.TrimSpace(level)
+						break
+					}
+				}
+			}
+			languages = append(languages, l)
+		}
+	}
+	return languages
+}
+
+// parseAchievements reads one achievement per line, splitting "Title: details".
+func parseAchievements(lines []string) []*pb.Achievement {
+	var achievements []*pb.Achievement
+	for _, line := range lines {
+		text, bullet := stripBullet(line)
+		if !bullet && len(achievements) > 0 && startsLower(text) {
+			last := achievements[len(achievements)-1]
+			if last.Description != "" {
+				last.Description += " " + text
+			} else {
+				last.Title += " " + text
+			}
+			continue
+		}
+		a := &pb.Achievement{Title: text}
+		if title, desc, ok := strings.Cut(text, ": "); ok && len(title) <= 60 {
+			a.Title, a.Description = title, desc
+		}
+		achievements = append(achievements, a)
+	}
+	return achievements
+}
+
+// normalizeDate converts "Jan 2020" and "1/2020" to the editor's MM/YYYY
+// format and ongoing markers to "Present"; other text is kept.
+func normalizeDate(s string) string {
+	s = strings.TrimSpace(s)
+	switch strings.ToLower(s) {
+	case "present", "current", "now", "today":
+		return "Present"
+	}
+	if m := monthYear.FindStringSubmatch(s); m != nil {
+		return months[strings.ToLower(m[1])[:3]] + "/" + m[2]
+	}
+	if m := numYear.FindStringSubmatch(s); m != nil {
+		if len(m[1]) == 1 {
+			return "0" + m[1] + "/" + m[2]
+		}
+		return m[1] + "/" + m[2]
+	}
+	return s
+}
+
+func stripBullet(line string) (string, bool) {
+	for _, prefix := range bulletPrefixes {
+		if rest, ok := strings.CutPrefix(line, prefix); ok {
+			return strings.TrimSpace(rest), true
+		}
+	}
+	return line, false
+}
+
+// joinWrapped joins lines into a paragraph, undoing the line wrapping of
+// PDF text.
+func joinWrapped(lines []string) string {
+	return strings.Join(strings.Fields(strings.Join(lines, " ")), " ")
+}
+
+// trimSeparators removes punctuation left over after extracting parts of a line.
+func trimSeparators(s string) string {
+	s = strings.Join(strings.Fields(s), " ")
+	return strings.TrimFunc(s, func(r rune) bool {
+		return unicode.IsSpace(r) || strings.ContainsRune("|,;:-–—·•()", r)
+	})
+}
+
+func startsLower(s string) bool {
+	for _, r := range s {
+		return unicode.IsLower(r)
+	}
+	return false
+}
+
+func setOnce(dst *string, value string) {
+	if *dst == "" {
+		*dst = value
+	}
+}
+
+func stripScheme(url string) string {
+	url = strings.TrimPrefix(url, "https://")
+	url = strings.TrimPrefix(url, "http://")
+	return strings.TrimPrefix(url, "www.")
+}
@@ -0,0 +1,256 @@
+package importer
+
+import (
+	"testing"
+
+	pb "github.com/iprotoresume/shared/proto"
+	"google.golang.org/protobuf/encoding/prototext"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name string
+		text string
+		want *pb.ResumeData
+	}{
+		{
+			name: "complete resume",
+			text: `Jane Doe
+Senior Backend Engineer
+Berlin, Germany
+jane@example.com | +49 170 1234567 | linkedin.com/in/janedoe | github.com/janedoe
+
+SUMMARY
+Backend engineer with eight years of experience building
+distributed systems in Go.
+
+WORK EXPERIENCE
+Senior Engineer at Acme GmbH
+Jan 2020 - Present
+• Led the migration to Kubernetes
+and cut costs by 30%
+• Built the billing API
+
+Backend Developer | 03/2016 – Dec 2019
+Initech
+- Maintained the PHP monolith
+
+Education
+BSc Computer Science, Technical University of Berlin, 2015
+
+Skills
+Languages: Go, Python, SQL
+Docker, Kubernetes; Terraform
+
+Projects
+resumectl | Go, Cobra
+• CLI for managing resumes
+Tech: Go, SQLite
+
+Certifications
+AWS Solutions Architect - Amazon (2021)
+
+Languages
+English (Native), German - B2
+
+Awards
+Hackathon winner: Best developer tool 2019
+
+Interests
+Climbing`,
+			want: &pb.ResumeData{
+				FullName: "Jane Doe",
+				JobTitle: "Senior Backend Engineer",
+				Location: "Berlin, Germany",
+				Email:    "jane@example.com",
+				Phone:    "+49 170 1234567",
+				Linkedin: "linkedin.com/in/janedoe",
+				Github:   "github.com/janedoe",
+				Summary:  "Backend engineer with eight years of experience building distributed systems in Go.",
+				Experience: []*pb.Experience{
+					{
+						Title:       "Senior Engineer",
+						Company:     "Acme GmbH",
+						StartDate:   "01/2020",
+						EndDate:     "Present",
+						Description: "• Led the migration to Kubernetes and cut costs by 30%\n• Built the billing API",
+					},
+					{
+						Title:       "Backend Developer",
+						Company:     "Initech",
+						StartDate:   "03/2016",
+						EndDate:     "12/2019",
+						Description: "• Maintained the PHP monolith",
+					},
+				},
+				Education: []*pb.Education{
+					{Degree: "BSc Computer Science", Institution: "Technical University of Berlin", GraduationDate: "2015"},
+				},
+				SkillGroups: []*pb.SkillGroup{
+					{Category: "Languages", Items: []string{"Go", "Python", "SQL"}},
+				},
+				Skills: []string{"Docker", "Kubernetes", "Terraform"},
+				Projects: []*pb.Project{
+					{Title: "resumectl", Description: "• CLI for managing resumes", TechStack: []string{"Go", "Cobra", "SQLite"}},
+				},
+				Certificates: []*pb.Certificate{
+					{Name: "AWS Solutions Architect", Issuer: "Amazon", Date: "2021"},
+				},
+				Languages: []*pb.Language{
+					{Language: "English", Proficiency: "Native"},
+					{Language: "German", Proficiency: "B2"},
+				},
+				Achievements: []*pb.Achievement{
+					{Title: "Hackathon winner", Description: "Best developer tool 2019"},
+				},
+			},
+		},
+		{
+			name: "contact section and website",
+			text: `John Smith
+Contact Details:
+john@example.org
+https://www.johnsmith.dev/
+(555) 123-4567`,
+			want: &pb.ResumeData{
+				FullName: "John Smith",
+				Email:    "john@example.org",
+				Website:  "johnsmith.dev",
+				Phone:    "(555) 123-4567",
+			},
+		},
+		{
+			name: "company before role",
+			text: `Experience
+Globex Corporation, Staff Engineer, 2018 - 2021
+Ran the platform team`,
+			want: &pb.ResumeData{
+				Experience: []*pb.Experience{
+					{Title: "Staff Engineer", Company: "Globex Corporation", StartDate: "2018", EndDate: "2021", Description: "Ran the platform team"},
+				},
+			},
+		},
+		{
+			name: "role and company on lines before the dates",
+			text: `Professional Experience
+Data Analyst
+Umbrella Labs
+Sept 2017 to Aug 2018
+Reporting dashboards`,
+			want: &pb.ResumeData{
+				Experience: []*pb.Experience{
+					{Title: "Data Analyst", Company: "Umbrella Labs", StartDate: "09/2017", EndDate: "08/2018", Description: "Reporting dashboards"},
+				},
+			},
+		},
+		{
+			name: "education entries split on repeated degrees",
+			text: `EDUCATION
+Master of Science in Informatics
+University of Munich
+2014 - 2016
+Bachelor of Science
+University of Cologne, 2014`,
+			want: &pb.ResumeData{
+				Education: []*pb.Education{
+					{Degree: "Master of Science in Informatics", Institution: "University of Munich", GraduationDate: "2016"},
+					{Degree: "Bachelor of Science", Institution: "University of Cologne", GraduationDate: "2014"},
+				},
+			},
+		},
+		{
+			name: "duplicate skills dropped",
+			text: `Technical Skills
+• Go, go, Rust
+• Rust | PostgreSQL`,
+			want: &pb.ResumeData{
+				Skills: []string{"Go", "Rust", "PostgreSQL"},
+			},
+		},
+		{
+			name: "project lines without a title line",
+			text: `Side Projects
+Wrote a static site generator in Rust.
+Open Source Contributor
+Maintained a popular Go library.`,
+			want: &pb.ResumeData{
+				Projects: []*pb.Project{
+					{Description: "Wrote a static site generator in Rust."},
+					{Title: "Open Source Contributor", Description: "Maintained a popular Go library."},
+				},
+			},
+		},
+		{
+			name: "headings in other styles",
+			text: `About Me:
+Curious engineer.
+== HONORS & AWARDS ==
+Employee of the year
+Licenses and Certifications
+CKA`,
+			want: &pb.ResumeData{
+				Summary:      "Curious engineer.",
+				Achievements: []*pb.Achievement{{Title: "Employee of the year"}},
+				Certificates: []*pb.Certificate{{Name: "CKA"}},
+			},
+		},
+		{
+			name: "text without headings",
+			text: `Jane Doe
+jane@example.com`,
+			want: &pb.ResumeData{
+				FullName: "Jane Doe",
+				Email:    "jane@example.com",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Parse(tt.text); !proto.Equal(got, tt.want) {
+				t.Errorf("Parse() = %v\nwant %v", prototext.Format(got), prototext.Format(tt.want))
+			}
+		})
+	}
+}
+
+func TestNormalizeDate(t *testing.T) {
+	tests := []struct{ in, want string }{
+		{"Jan 2020", "01/2020"},
+		{"September 2019", "09/2019"},
+		{"Sept. 2019", "09/2019"},
+		{"3/2018", "03/2018"},
+		{"11/2018", "11/2018"},
+		{"2015", "2015"},
+		{"current", "Present"},
+		{" Today ", "Present"},
+		{"Spring 2020", "Spring 2020"},
+	}
+	for _, tt := range tests {
+		if got := normalizeDate(tt.in); got != tt.want {
+			t.Errorf("normalizeDate(%q) = %q, want %q", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestHeadingSection(t *testing.T) {
+	tests := []struct {
+		line string
+		want section
+		ok   bool
+	}{
+		{"WORK EXPERIENCE", sectionExperience, true},
+		{"Skills:", sectionSkills, true},
+		{"Skills & Expertise", sectionSkills, true},
+		{"— Education —", sectionEducation, true},
+		{"Volunteer   Experience", sectionOther, true},
+		{"Experienced backend engineer", 0, false},
+		{"Skills: Go, Rust", 0, false},
+	}
+	for _, tt := range tests {
+		got, ok := headingSection(tt.line)
+		if got != tt.want || ok != tt.ok {
+			t.Errorf("headingSection(%q) = %v, %v, want %v, %v", tt.line, got, ok, tt.want, tt.ok)
+		}
+	}
+}
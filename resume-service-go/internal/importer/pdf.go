@@ -0,0 +1,582 @@
+package importer
+
+import (
+	"bytes"
+	"compress/zlib"
+	"errors"
+	"io"
+	"math"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// ErrEncryptedPDF is returned for password protected PDFs.
+var ErrEncryptedPDF = errors.New("encrypted PDFs are not supported")
+
+// maxFormDepth bounds recursion into nested form XObjects.
+const maxFormDepth = 8
+
+// maxPages bounds how many pages are read; resumes run to a few.
+const maxPages = 100
+
+// maxOperators bounds the content stream operators run per file, counting
+// those of form XObjects each time they are drawn. Real resumes need a few
+// thousand per page.
+const maxOperators = 1 << 20
+
+// errTooComplex is returned when a file would run more than maxOperators.
+var errTooComplex = errors.New("PDF content is too complex")
+
+var (
+	objHeader    = regexp.MustCompile(`(\d+)\s+\d+\s+obj\b`)
+	refPattern   = regexp.MustCompile(`^(\d+)\s+\d+\s+R`)
+	refList      = regexp.MustCompile(`(\d+)\s+\d+\s+R`)
+	namedRefList = regexp.MustCompile(`/([^\s/<>\[\]()]+)\s+(\d+)\s+\d+\s+R`)
+	rootRef      = regexp.MustCompile(`/Root\s+(\d+)\s+\d+\s+R`)
+	pageType     = regexp.MustCompile(`/Type\s*/Page\b`)
+	pagesType    = regexp.MustCompile(`/Type\s*/Pages\b`)
+	lengthValue  = regexp.MustCompile(`/Length\s+(\d+)(\s+\d+\s+R)?`)
+)
+
+type pdfObject struct {
+	dict   []byte // the object body before "stream", or all of it
+	stream []byte // decoded stream data, nil when absent or undecodable
+}
+
+type pdfFile struct {
+	objects   map[int]*pdfObject
+	fonts     map[int]*pdfFont
+	budget    *budget
+	operators int          // run so far, see maxOperators
+	forms     map[int]bool // form XObjects being drawn, to refuse cycles
+	err       error        // set when the file exceeds the budget or maxOperators
+}
+
+type pdfFont struct {
+	cmap *cmap // ToUnicode mapping; nil for simple fonts without one
+}
+
+// PDFText extracts the text of a PDF document in page order. It understands
+// Flate-compressed content streams, object streams, form XObjects and
+// ToUnicode CMaps, which covers PDFs exported by word processors and
+// browsers. Scanned PDFs contain no text and yield an empty string.
+func PDFText(data []byte) (string, error) {
+	if !bytes.HasPrefix(data, []byte("%PDF")) {
+		return "", errors.New("not a PDF document")
+	}
+	if bytes.Contains(data, []byte("/Encrypt")) {
+		return "", ErrEncryptedPDF
+	}
+
+	f := &pdfFile{objects: make(map[int]*pdfObject), fonts: make(map[int]*pdfFont), budget: newBudget(), forms: make(map[int]bool)}
+	f.parseObjects(data)
+	if f.err != nil {
+		return "", f.err
+	}
+
+	var w textWriter
+	for _, page := range f.pages(data) {
+		resources := f.resolve(dictValue(page.dict, "Resources"))
+		var content []byte
+		seen := make(map[string]bool)
+		for _, m := range refList.FindAllSubmatch(dictValue(page.dict, "Contents"), -1) {
+			if seen[string(m[1])] {
+				continue
+			}
+			seen[string(m[1])] = true
+			if obj := f.object(m[1]); obj != nil {
+				content = append(content, obj.stream...)
+				content = append(content, '\n')
+			}
+		}
+		f.extract(&w, content, resources, 0)
+		if f.err != nil {
+			return "", f.err
+		}
+		w.newline()
+	}
+	return w.String(), nil
+}
+
+// parseObjects indexes every "N G obj" in the file, including objects packed
+// into object streams. Later definitions win, as in incremental updates.
+func (f *pdfFile) parseObjects(data []byte) {
+	for pos := 0; pos < len(data); {
+		m := objHeader.FindSubmatchIndex(data[pos:])
+		if m == nil {
+			break
+		}
+		num, _ := strconv.Atoi(string(data[pos+m[2] : pos+m[3]]))
+		start := pos + m[1]
+
+		obj, end := f.parseObjectBody(data, start)
+		f.objects[num] = obj
+		pos = end
+	}
+
+	for _, obj := range f.objects {
+		if bytes.Contains(obj.dict, []byte("/ObjStm")) && obj.stream != nil {
+			f.unpackObjectStream(obj)
+		}
+	}
+}
+
+// parseObjectBody reads an object starting at start and returns it with the
+// offset just past it. Streams are skipped by length so binary data cannot
+// be mistaken for object headers.
+func (f *pdfFile) parseObjectBody(data []byte, start int) (*pdfObject, int) {
+	endObj := bytes.Index(data[start:], []byte("endobj"))
+	streamAt := bytes.Index(data[start:], []byte("stream"))
+	if streamAt < 0 || (endObj >= 0 && endObj < streamAt) {
+		if endObj < 0 {
+			return &pdfObject{dict: data[start:]}, len(data)
+		}
+		return &pdfObject{dict: data[start : start+endObj]}, start + endObj + len("endobj")
+	}
+
+	obj := &pdfObject{dict: data[start : start+streamAt]}
+	dataStart := start + streamAt + len("stream")
+	if dataStart < len(data) && data[dataStart] == '\r' {
+		dataStart++
+	}
+	if dataStart < len(data) && data[dataStart] == '\n' {
+		dataStart++
+	}
+
+	dataEnd := -1
+	if m := lengthValue.FindSubmatch(obj.dict); m != nil && m[2] == nil {
+		n, _ := strconv.Atoi(string(m[1]))
+		if end := dataStart + n; end <= len(data) && bytes.HasPrefix(bytes.TrimLeft(data[end:], "\r\n "), []byte("endstream")) {
+			dataEnd = end
+		}
+	}
+	if dataEnd < 0 {
+		i := bytes.Index(data[dataStart:], []byte("endstream"))
+		if i < 0 {
+			return obj, len(data)
+		}
+		dataEnd = dataStart + i
+	}
+
+	obj.stream = f.decodeStream(obj.dict, data[dataStart:dataEnd])
+	next := dataEnd
+	if i := bytes.Index(data[dataEnd:], []byte("endobj")); i >= 0 {
+		next = dataEnd + i + len("endobj")
+	}
+	return obj, next
+}
+
+// decodeStream applies the stream's filter. Only FlateDecode is supported;
+// other filters are used for images, which carry no text. Streams share the
+// file's budget, and the parse fails once they exceed it.
+func (f *pdfFile) decodeStream(dict, raw []byte) []byte {
+	filter := dictValue(dict, "Filter")
+	switch {
+	case len(filter) == 0:
+		return raw
+	case bytes.Contains(filter, []byte("FlateDecode")) && bytes.Count(filter, []byte("/")) == 1:
+		r, err := zlib.NewReader(bytes.NewReader(raw))
+		if err != nil {
+			return nil
+		}
+		// Keep whatever was inflated before a checksum or truncation error
+		out, err := io.ReadAll(f.budget.reader(r))
+		if errors.Is(err, ErrTooLarge) {
+			f.err = err
+			return nil
+		}
+		return out
+	default:
+		return nil
+	}
+}
+
+func (f *pdfFile) unpackObjectStream(obj *pdfObject) {
+	n, _ := strconv.Atoi(string(dictValue(obj.dict, "N")))
+	first, _ := strconv.Atoi(string(dictValue(obj.dict, "First")))
+	if first <= 0 || first > len(obj.stream) {
+		return
+	}
+
+	header := strings.Fields(string(obj.stream[:first]))
+	for i := 0; i < n && 2*i+1 < len(header); i++ {
+		num, err1 := strconv.Atoi(header[2*i])
+		offset, err2 := strconv.Atoi(header[2*i+1])
+		if err1 != nil || err2 != nil {
+			return
+		}
+		end := len(obj.stream)
+		if 2*i+3 < len(header) {
+			if next, err := strconv.Atoi(header[2*i+3]); err == nil {
+				end = first + next
+			}
+		}
+		// Offsets come from the file and may be negative or out of order
+		start := first + offset
+		if start < first || end < start || end > len(obj.stream) {
+			return
+		}
+		if _, ok := f.objects[num]; !ok {
+			f.objects[num] = &pdfObject{dict: obj.stream[start:end]}
+		}
+	}
+}
+
+func (f *pdfFile) object(num []byte) *pdfObject {
+	n, err := strconv.Atoi(string(num))
+	if err != nil {
+		return nil
+	}
+	return f.objects[n]
+}
+
+// resolve returns the dictionary of a reference, or value itself when it is
+// a direct object.
+func (f *pdfFile) resolve(value []byte) []byte {
+	if m := refPattern.FindSubmatch(value); m != nil {
+		if obj := f.object(m[1]); obj != nil {
+			return obj.dict
+		}
+		return nil
+	}
+	return value
+}
+
+// pages returns the page objects in document order by walking the page tree
+// from the catalog, falling back to every page object in the file.
+func (f *pdfFile) pages(data []byte) []*pdfObject {
+	var pages []*pdfObject
+	if m := rootRef.FindAllSubmatch(data, -1); m != nil {
+		if root := f.object(m[len(m)-1][1]); root != nil {
+			f.walkPages(f.resolve(dictValue(root.dict, "Pages")), nil, &pages, make(map[int]bool), 0)
+		}
+	}
+	if len(pages) > 0 {
+		return pages
+	}
+
+	nums := make([]int, 0, len(f.objects))
+	for num, obj := range f.objects {
+		if pageType.Match(obj.dict) {
+			nums = append(nums, num)
+		}
+	}
+	slices.Sort(nums)
+	if len(nums) > maxPages {
+		nums = nums[:maxPages]
+	}
+	for _, num := range nums {
+		pages = append(pages, f.objects[num])
+	}
+	return pages
+}
+
+// walkPages collects the leaves of the page tree, up to maxPages. Resources
+// are inheritable, so pages without their own get a copy of the nearest
+// ancestor's. Each object is visited once, so a tree listing a node more
+// than once or containing a cycle cannot make the walk explode.
+func (f *pdfFile) walkPages(node, inherited []byte, pages *[]*pdfObject, visited map[int]bool, depth int) {
+	if node == nil || depth > 32 || len(*pages) >= maxPages {
+		return
+	}
+	if res := dictValue(node, "Resources"); res != nil {
+		inherited = res
+	}
+	if !pagesType.Match(node) {
+		page := &pdfObject{dict: node}
+		if dictValue(node, "Resources") == nil && inherited != nil {
+			page.dict = append(append([]byte("/Resources "), inherited...), node...)
+		}
+		*pages = append(*pages, page)
+		return
+	}
+	for _, m := range refList.FindAllSubmatch(dictValue(node, "Kids"), -1) {
+		num, _ := strconv.Atoi(string(m[1]))
+		if visited[num] {
+			continue
+		}
+		visited[num] = true
+		if kid := f.objects[num]; kid != nil {
+			f.walkPages(kid.dict, inherited, pages, visited, depth+1)
+		}
+	}
+}
+
+// fontsOf maps the font resource names of a resource dictionary to fonts.
+func (f *pdfFile) fontsOf(resources []byte) map[string]*pdfFont {
+	fonts := make(map[string]*pdfFont)
+	for _, m := range namedRefList.FindAllSubmatch(f.resolve(dictValue(resources, "Font")), -1) {
+		num, _ := strconv.Atoi(string(m[2]))
+		fonts[string(m[1])] = f.font(num)
+	}
+	return fonts
+}
+
+func (f *pdfFile) font(num int) *pdfFont {
+	if font, ok := f.fonts[num]; ok {
+		return font
+	}
+	font := &pdfFont{}
+	if obj := f.objects[num]; obj != nil {
+		if m := refPattern.FindSubmatch(dictValue(obj.dict, "ToUnicode")); m != nil {
+			if cm := f.object(m[1]); cm != nil && cm.stream != nil {
+				font.cmap = parseCMap(cm.stream)
+			}
+		}
+	}
+	f.fonts[num] = font
+	return font
+}
+
+// extract interprets a content stream, writing its text operators to w.
+func (f *pdfFile) extract(w *textWriter, content, resources []byte, depth int) {
+	fonts := f.fontsOf(resources)
+	xobjects := make(map[string][]byte)
+	for _, m := range namedRefList.FindAllSubmatch(f.resolve(dictValue(resources, "XObject")), -1) {
+		xobjects[string(m[1])] = m[2]
+	}
+
+	var (
+		font     *pdfFont
+		operands []token
+		y        float64 // baseline of the current text line
+		leading  float64
+		lastY    = math.NaN()
+	)
+	// show writes text, starting a new line when the baseline moved
+	show := func(s []byte) {
+		if !math.IsNaN(lastY) && math.Abs(y-lastY) > 1 {
+			w.newline()
+		}
+		lastY = y
+		w.write(font.decode(s))
+	}
+	nextLine := func() {
+		if leading == 0 {
+			w.newline()
+		}
+		y -= leading
+	}
+
+	lex := lexer{data: content}
+	for {
+		tok, ok := lex.next()
+		if !ok {
+			break
+		}
+		if tok.kind != tokenOperator {
+			operands = append(operands, tok)
+			continue
+		}
+		if f.operators++; f.operators > maxOperators {
+			f.err = errTooComplex
+			return
+		}
+
+		switch tok.text {
+		case "BT":
+			y = 0
+		case "Tf":
+			if len(operands) >= 2 {
+				font = fonts[strings.TrimPrefix(operands[0].text, "/")]
+			}
+		case "TL":
+			if len(operands) >= 1 {
+				leading = operands[0].number()
+			}
+		case "Td", "TD":
+			if len(operands) >= 2 {
+				tx, ty := operands[0].number(), operands[1].number()
+				if tok.text == "TD" {
+					leading = -ty
+				}
+				y += ty
+				if ty == 0 && tx > 0 {
+					w.space()
+				}
+			}
+		case "Tm":
+			if len(operands) >= 6 {
+				y = operands[5].number()
+			}
+		case "T*":
+			nextLine()
+		case "Tj":
+			if len(operands) >= 1 {
+				show(operands[len(operands)-1].str)
+			}
+		case "'", "\"":
+			nextLine()
+			if len(operands) >= 1 {
+				show(operands[len(operands)-1].str)
+			}
+		case "TJ":
+			if len(operands) >= 1 {
+				for _, el := range operands[len(operands)-1].arr {
+					if el.kind == tokenString {
+						show(el.str)
+					} else if el.kind == tokenNumber && el.number() < -250 {
+						// Large negative kerning is how many generators encode spaces
+						w.space()
+					}
+				}
+			}
+		case "ET":
+			w.space()
+		case "Do":
+			if len(operands) >= 1 && depth < maxFormDepth {
+				if ref, ok := xobjects[strings.TrimPrefix(operands[0].text, "/")]; ok {
+					// A form drawing itself, directly or not, is skipped
+					num, _ := strconv.Atoi(string(ref))
+					if form := f.objects[num]; form != nil && !f.forms[num] && bytes.Contains(form.dict, []byte("/Form")) {
+						formResources := f.resolve(dictValue(form.dict, "Resources"))
+						if formResources == nil {
+							formResources = resources
+						}
+						f.forms[num] = true
+						f.extract(w, form.stream, formResources, depth+1)
+						delete(f.forms, num)
+						if f.err != nil {
+							return
+						}
+					}
+				}
+			}
+		case "ID":
+			lex.skipInlineImage()
+		}
+		operands = operands[:0]
+	}
+}
+
+// decode maps string bytes to text through the font's ToUnicode CMap, or as
+// WinAnsi for simple fonts.
+func (font *pdfFont) decode(s []byte) string {
+	if font != nil && font.cmap != nil {
+		return font.cmap.decode(s)
+	}
+
+	var sb strings.Builder
+	for _, b := range s {
+		sb.WriteRune(winAnsiRune(b))
+	}
+	return sb.String()
+}
+
+// winAnsi covers the 0x80-0x9F range where WinAnsiEncoding differs from Latin-1.
+var winAnsi = map[byte]rune{
+	0x80: '€', 0x85: '…', 0x91: '‘', 0x92: '’', 0x93: '“', 0x94: '”',
+	0x95: '•', 0x96: '–', 0x97: '—', 0x99: '™',
+}
+
+func winAnsiRune(b byte) rune {
+	if r, ok := winAnsi[b]; ok {
+		return r
+	}
+	return rune(b)
+}
+
+// dictValue returns the raw value of key in a PDF dictionary: a nested
+// dictionary or array including its delimiters, an indirect reference, or a
+// single token. It returns nil when the key is absent.
+func dictValue(dict []byte, key string) []byte {
+	needle := []byte("/" + key)
+	for i := 0; ; {
+		j := bytes.Index(dict[i:], needle)
+		if j < 0 {
+			return nil
+		}
+		start := i + j + len(needle)
+		// Reject prefixes of longer names, e.g. /Font in /FontFile
+		if start < len(dict) && isRegular(dict[start]) {
+			i = start
+			continue
+		}
+
+		rest := bytes.TrimLeft(dict[start:], " \t\r\n")
+		switch {
+		case bytes.HasPrefix(rest, []byte("<<")):
+			return balanced(rest, "<<", ">>")
+		case bytes.HasPrefix(rest, []byte("[")):
+			return balanced(rest, "[", "]")
+		}
+		if m := refPattern.Find(rest); m != nil {
+			return m
+		}
+		end := 1
+		for end < len(rest) && isRegular(rest[end]) {
+			end++
+		}
+		return rest[:min(end, len(rest))]
+	}
+}
+
+// balanced returns the prefix of s up to the delimiter closing its first open.
+func balanced(s []byte, open, close string) []byte {
+	depth := 0
+	for i := 0; i < len(s); {
+		switch {
+		case bytes.HasPrefix(s[i:], []byte(open)):
+			depth++
+			i += len(open)
+		case bytes.HasPrefix(s[i:], []byte(close)):
+			depth--
+			i += len(close)
+			if depth == 0 {
+				return s[:i]
+			}
+		default:
+			i++
+		}
+	}
+	return s
+}
+
+func isRegular(b byte) bool {
+	switch b {
+	case ' ', '\t', '\r', '\n', '\f', 0, '/', '<', '>', '[', ']', '(', ')', '{', '}', '%':
+		return false
+	}
+	return true
+}
+
+// textWriter accumulates extracted text, collapsing repeated separators.
+type textWriter struct {
+	sb strings.Builder
+}
+
+func (w *textWriter) write(s string) {
+	w.sb.WriteString(s)
+}
+
+func (w *textWriter) last() byte {
+	s := w.sb.String()
+	if s == "" {
+		return '\n'
+	}
+	return s[len(s)-1]
+}
+
+func (w *textWriter) space() {
+	if c := w.last(); c != ' ' && c != '\n' {
+		w.sb.WriteByte(' ')
+	}
+}
+
+func (w *textWriter) newline() {
+	if w.last() != '\n' {
+		w.sb.WriteByte('\n')
+	}
+}
+
+func (w *textWriter) String() string {
+	lines := strings.Split(ligatures.Replace(w.sb.String()), "\n")
+	for i, line := range lines {
+		lines[i] = strings.Join(strings.Fields(line), " ")
+	}
+	return strings.Join(lines, "\n")
+}
+
+var ligatures = strings.NewReplacer("ﬀ", "ff", "ﬁ", "fi", "ﬂ", "fl", "ﬃ", "ffi", "ﬄ", "ffl")
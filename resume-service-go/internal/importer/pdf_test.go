@@ -0,0 +1,286 @@
+package importer
+
+import (
+	"bytes"
+	"compress/zlib"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+)
+
+// buildPDF assembles a PDF from object bodies numbered from 1, with object 1
+// as the catalog named by the trailer. There is no xref table; PDFText
+// finds objects by their headers.
+func buildPDF(objects ...string) []byte {
+	var b bytes.Buffer
+	b.WriteString("%PDF-1.4\n")
+	for i, obj := range objects {
+		fmt.Fprintf(&b, "%d 0 obj\n%s\nendobj\n", i+1, obj)
+	}
+	b.WriteString("trailer\n<< /Root 1 0 R >>\n%%EOF\n")
+	return b.Bytes()
+}
+
+// stream returns the body of a stream object with the given dictionary
+// entries and data.
+func stream(dict, data string) string {
+	return fmt.Sprintf("<< %s /Length %d >>\nstream\n%s\nendstream", dict, len(data), data)
+}
+
+// flateStream is stream with the data Flate-compressed.
+func flateStream(dict, data string) string {
+	var b bytes.Buffer
+	w := zlib.NewWriter(&b)
+	w.Write([]byte(data))
+	w.Close()
+	return stream(dict+" /Filter /FlateDecode", b.String())
+}
+
+const (
+	catalog   = "<< /Type /Catalog /Pages 2 0 R >>"
+	helvetica = "<< /Type /Font /Subtype /Type1 /BaseFont /Helvetica >>"
+)
+
+// pdfTextWithin runs PDFText, failing the test if it takes longer than
+// timeout rather than letting a regression hang the suite.
+func pdfTextWithin(t *testing.T, data []byte, timeout time.Duration) (string, error) {
+	t.Helper()
+	type result struct {
+		text string
+		err  error
+	}
+	done := make(chan result, 1)
+	go func() {
+		text, err := PDFText(data)
+		done <- result{text, err}
+	}()
+	select {
+	case r := <-done:
+		return r.text, r.err
+	case <-time.After(timeout):
+		t.Fatalf("PDFText did not finish within %v", timeout)
+		return "", nil
+	}
+}
+
+// onePage is a PDF whose only page draws content with Helvetica as /F1 and
+// the font /F2 given as an object body, when there is one.
+func onePage(content string, f2 ...string) []byte {
+	objects := []string{
+		catalog,
+		"<< /Type /Pages /Kids [3 0 R] /Count 1 >>",
+		"<< /Type /Page /Parent 2 0 R /Contents 4 0 R /Resources << /Font << /F1 5 0 R /F2 6 0 R >> >> >>",
+		stream("", content),
+		helvetica,
+	}
+	return buildPDF(append(objects, f2...)...)
+}
+
+func TestPDFText(t *testing.T) {
+	// Objects 5 and 6 live in object stream 2, in reverse order of their numbers
+	pagesObj := "<< /Type /Pages /Kids [6 0 R] /Count 1 >>"
+	pageObj := "<< /Type /Page /Parent 5 0 R /Contents 3 0 R /Resources << /Font << /F1 4 0 R >> >> >>"
+	objStmHeader := fmt.Sprintf("5 0 6 %d ", len(pagesObj)+1)
+
+	tests := []struct {
+		name    string
+		data    []byte
+		want    string
+		wantErr error
+	}{
+		{
+			name: "plain content stream",
+			data: onePage("BT /F1 12 Tf 72 720 Td (Jane Doe) Tj ET"),
+			want: "Jane Doe",
+		},
+		{
+			name: "lines moved by TD and T*",
+			data: onePage("BT /F1 12 Tf 0 -14 TD (Line one) Tj T* (Line two) Tj ET"),
+			want: "Line one\nLine two",
+		},
+		{
+			name: "TJ kerning as spaces",
+			data: onePage("BT /F1 12 Tf [(Hello) -300 (World) -20 (!)] TJ ET"),
+			want: "Hello World!",
+		},
+		{
+			name: "WinAnsi bullets and escapes",
+			data: onePage(`BT /F1 12 Tf (\225 Go \(Golang\)) Tj ET`),
+			want: "• Go (Golang)",
+		},
+		{
+			name: "Flate-compressed content",
+			data: buildPDF(
+				catalog,
+				"<< /Type /Pages /Kids [3 0 R] /Count 1 >>",
+				"<< /Type /Page /Parent 2 0 R /Contents 4 0 R /Resources << /Font << /F1 5 0 R >> >> >>",
+				flateStream("", "BT /F1 12 Tf (Compressed) Tj ET"),
+				helvetica,
+			),
+			want: "Compressed",
+		},
+		{
+			name: "resources inherited from the page tree",
+			data: buildPDF(
+				catalog,
+				"<< /Type /Pages /Kids [3 0 R] /Count 1 /Resources << /Font << /F1 5 0 R >> >> >>",
+				"<< /Type /Page /Parent 2 0 R /Contents 4 0 R >>",
+				stream("", "BT /F1 12 Tf (Inherited) Tj ET"),
+				helvetica,
+			),
+			want: "Inherited",
+		},
+		{
+			name: "pages in page tree order",
+			data: buildPDF(
+				catalog,
+				"<< /Type /Pages /Kids [4 0 R 3 0 R] /Count 2 >>",
+				"<< /Type /Page /Parent 2 0 R /Contents 5 0 R /Resources << /Font << /F1 7 0 R >> >> >>",
+				"<< /Type /Page /Parent 2 0 R /Contents 6 0 R /Resources << /Font << /F1 7 0 R >> >> >>",
+				stream("", "BT /F1 12 Tf (Second) Tj ET"),
+				stream("", "BT /F1 12 Tf (First) Tj ET"),
+				helvetica,
+			),
+			want: "First\nSecond",
+		},
+		{
+			name: "page tree in an object stream",
+			data: buildPDF(
+				"<< /Type /Catalog /Pages 5 0 R >>",
+				flateStream(fmt.Sprintf("/Type /ObjStm /N 2 /First %d", len(objStmHeader)), objStmHeader+pagesObj+" "+pageObj),
+				stream("", "BT /F1 12 Tf (Packed) Tj ET"),
+				helvetica,
+			),
+			want: "Packed",
+		},
+		{
+			name: "ToUnicode CMap",
+			data: onePage("BT /F2 12 Tf <010203> Tj ET",
+				"<< /Type /Font /Subtype /Type0 /BaseFont /Custom /ToUnicode 7 0 R >>",
+				stream("", "1 begincodespacerange <00> <FF> endcodespacerange "+
+					"1 beginbfchar <01> <0048> endbfchar 1 beginbfrange <02> <03> <0069> endbfrange"),
+			),
+			want: "Hij",
+		},
+		{
+			name: "form XObject",
+			data: buildPDF(
+				catalog,
+				"<< /Type /Pages /Kids [3 0 R] /Count 1 >>",
+				"<< /Type /Page /Parent 2 0 R /Contents 4 0 R /Resources << /XObject << /Fm1 6 0 R >> /Font << /F1 5 0 R >> >> >>",
+				stream("", "/Fm1 Do"),
+				helvetica,
+				stream("/Type /XObject /Subtype /Form", "BT /F1 12 Tf (In a form) Tj ET"),
+			),
+			want: "In a form",
+		},
+		{
+			name: "negative object stream offset",
+			data: buildPDF(
+				"<< /Type /Catalog /Pages 5 0 R >>",
+				stream("/Type /ObjStm /N 2 /First 12", "5 -40 6 -20 << >>"),
+			),
+			want: "",
+		},
+		{
+			name:    "encrypted",
+			data:    []byte("%PDF-1.4\ntrailer\n<< /Root 1 0 R /Encrypt 2 0 R >>\n%%EOF\n"),
+			wantErr: ErrEncryptedPDF,
+		},
+		{
+			name:    "stream inflating past the budget",
+			data:    onePage("", flateStream("/Type /XObject", strings.Repeat("\x00", maxInflatedSize+1))),
+			wantErr: ErrTooLarge,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := pdfTextWithin(t, tt.data, 5*time.Second)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("PDFText() error = %v, want %v", err, tt.wantErr)
+			}
+			if got := strings.TrimSpace(got); got != tt.want {
+				t.Errorf("PDFText() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPDFTextBoundsWork(t *testing.T) {
+	// Form 6 draws form 7 fifty times, which draws form 8 fifty times, and
+	// so on: no cycle, but 50^8 operators if every form were drawn in full.
+	fanOut := []string{
+		catalog,
+		"<< /Type /Pages /Kids [3 0 R] /Count 1 >>",
+		"<< /Type /Page /Parent 2 0 R /Contents 4 0 R /Resources << /XObject << /X 6 0 R >> >> >>",
+		stream("", "/X Do"),
+		helvetica,
+	}
+	for i := 6; i <= 13; i++ {
+		fanOut = append(fanOut, stream(
+			fmt.Sprintf("/Type /XObject /Subtype /Form /Resources << /XObject << /X %d 0 R >> /Font << /F1 5 0 R >> >>", i+1),
+			"BT /F1 12 Tf (Hi) Tj ET "+strings.Repeat("/X Do ", 50),
+		))
+	}
+
+	tests := []struct {
+		name    string
+		data    []byte
+		want    string
+		wantErr error
+	}{
+		{
+			name: "page tree listing a node repeatedly",
+			data: buildPDF(
+				catalog,
+				"<< /Type /Pages /Kids [2 0 R 2 0 R 2 0 R 3 0 R 3 0 R] /Count 1 >>",
+				"<< /Type /Page /Parent 2 0 R /Contents 4 0 R /Resources << /Font << /F1 5 0 R >> >> >>",
+				stream("", "BT /F1 12 Tf (Hello) Tj ET"),
+				helvetica,
+			),
+			want: "Hello",
+		},
+		{
+			name: "repeated content stream references",
+			data: buildPDF(
+				catalog,
+				"<< /Type /Pages /Kids [3 0 R] /Count 1 >>",
+				"<< /Type /Page /Parent 2 0 R /Contents [4 0 R 4 0 R 4 0 R] /Resources << /Font << /F1 5 0 R >> >> >>",
+				stream("", "BT /F1 12 Tf (Hello) Tj ET"),
+				helvetica,
+			),
+			want: "Hello",
+		},
+		{
+			name: "form drawing itself",
+			data: buildPDF(
+				catalog,
+				"<< /Type /Pages /Kids [3 0 R] /Count 1 >>",
+				"<< /Type /Page /Parent 2 0 R /Contents 4 0 R /Resources << /XObject << /X 6 0 R >> >> >>",
+				stream("", "/X Do"),
+				helvetica,
+				stream("/Type /XObject /Subtype /Form /Resources << /XObject << /X 6 0 R >> /Font << /F1 5 0 R >> >>",
+					"BT /F1 12 Tf (Hi) Tj ET "+strings.Repeat("/X Do ", 50)),
+			),
+			want: "Hi",
+		},
+		{
+			name:    "forms fanning out",
+			data:    buildPDF(fanOut...),
+			wantErr: errTooComplex,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := pdfTextWithin(t, tt.data, 5*time.Second)
+			if err != tt.wantErr {
+				t.Fatalf("PDFText() error = %v, want %v", err, tt.wantErr)
+			}
+			if got := strings.TrimSpace(got); got != tt.want {
+				t.Errorf("PDFText() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
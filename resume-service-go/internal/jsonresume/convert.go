@@ -0,0 +1,265 @@
+package jsonresume
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	pb "github.com/iprotoresume/shared/proto"
+)
+
+const schemaURL = "https://raw.githubusercontent.com/jsonresume/resume-schema/v1.0.0/schema.json"
+
+var (
+	monthYear = regexp.MustCompile(`^(\d{1,2})/(\d{4})$`)
+	isoDate   = regexp.MustCompile(`^(\d{4})(?:-(\d{2}))?(?:-\d{2})?$`)
+)
+
+// Export renders resume as an indented JSON Resume document.
+func Export(resume *pb.ResumeData) ([]byte, error) {
+	return json.MarshalIndent(FromResumeData(resume), "", "  ")
+}
+
+// Import parses a JSON Resume document into ResumeData.
+func Import(data []byte) (*pb.ResumeData, error) {
+	var r Resume
+	if err := json.Unmarshal(data, &r); err != nil {
+		return nil, fmt.Errorf("invalid JSON Resume document: %w", err)
+	}
+	return ToResumeData(&r), nil
+}
+
+// FromResumeData maps ResumeData onto the JSON Resume schema. Skill groups
+// become skills with keywords; ungrouped skills become keyword-less skills.
+func FromResumeData(p *pb.ResumeData) *Resume {
+	r := &Resume{
+		Schema: schemaURL,
+		Basics: Basics{
+			Name:    p.FullName,
+			Label:   p.JobTitle,
+			Image:   p.ProfileImage,
+			Email:   p.Email,
+			Phone:   p.Phone,
+			URL:     withScheme(p.Website),
+			Summary: p.Summary,
+		},
+	}
+	if p.Location != "" {
+		r.Basics.Location = &Location{Address: p.Location}
+	}
+	if p.Linkedin != "" {
+		r.Basics.Profiles = append(r.Basics.Profiles, Profile{Network: "LinkedIn", URL: withScheme(p.Linkedin)})
+	}
+	if p.Github != "" {
+		r.Basics.Profiles = append(r.Basics.Profiles, Profile{Network: "GitHub", URL: withScheme(p.Github)})
+	}
+
+	for _, e := range p.Experience {
+		r.Work = append(r.Work, Work{
+			Name:      e.Company,
+			Position:  e.Title,
+			StartDate: toISODate(e.StartDate),
+			EndDate:   toISODate(e.EndDate),
+			Summary:   e.Description,
+		})
+	}
+	for _, e := range p.Education {
+		r.Education = append(r.Education, Education{
+			Institution: e.Institution,
+			StudyType:   e.Degree,
+			EndDate:     toISODate(e.GraduationDate),
+		})
+	}
+	for _, prj := range p.Projects {
+		r.Projects = append(r.Projects, Project{
+			Name:        prj.Title,
+			Description: prj.Description,
+			Keywords:    prj.TechStack,
+			StartDate:   toISODate(prj.Date),
+			Location:    prj.Location,
+		})
+	}
+	for _, sg := range p.SkillGroups {
+		r.Skills = append(r.Skills, Skill{Name: sg.Category, Keywords: sg.Items})
+	}
+	for _, s := range p.Skills {
+		r.Skills = append(r.Skills, Skill{Name: s})
+	}
+	for _, l := range p.Languages {
+		r.Languages = append(r.Languages, Language{Language: l.Language, Fluency: l.Proficiency})
+	}
+	for _, c := range p.Certificates {
+		r.Certificates = append(r.Certificates, Certificate{
+			Name:   c.Name,
+			Date:   toISODate(c.Date),
+			Issuer: c.Issuer,
+			URL:    c.Link,
+		})
+	}
+	for _, a := range p.Achievements {
+		r.Awards = append(r.Awards, Award{Title: a.Title, Summary: a.Description})
+	}
+
+	return r
+}
+
+// ToResumeData maps a JSON Resume document onto ResumeData. Work highlights
+// are appended to the description as bullet lines.
+func ToResumeData(r *Resume) *pb.ResumeData {
+	p := &pb.ResumeData{
+		FullName:     r.Basics.Name,
+		JobTitle:     r.Basics.Label,
+		ProfileImage: r.Basics.Image,
+		Email:        r.Basics.Email,
+		Phone:        r.Basics.Phone,
+		Website:      withoutScheme(r.Basics.URL),
+		Summary:      r.Basics.Summary,
+		Location:     formatLocation(r.Basics.Location),
+	}
+
+	for _, prof := range r.Basics.Profiles {
+		switch strings.ToLower(prof.Network) {
+		case "linkedin":
+			p.Linkedin = profileURL(prof, "linkedin.com/in/")
+		case "github":
+			p.Github = profileURL(prof, "github.com/")
+		}
+	}
+
+	for _, w := range r.Work {
+		endDate := fromISODate(w.EndDate)
+		if endDate == "" && w.StartDate != "" {
+			endDate = "Present"
+		}
+		p.Experience = append(p.Experience, &pb.Experience{
+			Title:       w.Position,
+			Company:     w.Name,
+			StartDate:   fromISODate(w.StartDate),
+			EndDate:     endDate,
+			Description: withHighlights(w.Summary, w.Highlights),
+		})
+	}
+	for _, e := range r.Education {
+		degree := e.StudyType
+		switch {
+		case degree == "":
+			degree = e.Area
+		case e.Area != "":
+			degree += " in " + e.Area
+		}
+		p.Education = append(p.Education, &pb.Education{
+			Degree:         degree,
+			Institution:    e.Institution,
+			GraduationDate: fromISODate(e.EndDate),
+		})
+	}
+	for _, prj := range r.Projects {
+		p.Projects = append(p.Projects, &pb.Project{
+			Title:       prj.Name,
+			Description: withHighlights(prj.Description, prj.Highlights),
+			TechStack:   prj.Keywords,
+			Date:        fromISODate(prj.StartDate),
+			Location:    prj.Location,
+		})
+	}
+	for _, s := range r.Skills {
+		if len(s.Keywords) > 0 {
+			p.SkillGroups = append(p.SkillGroups, &pb.SkillGroup{Category: s.Name, Items: s.Keywords})
+		} else if s.Name != "" {
+			p.Skills = append(p.Skills, s.Name)
+		}
+	}
+	for _, l := range r.Languages {
+		p.Languages = append(p.Languages, &pb.Language{Language: l.Language, Proficiency: l.Fluency})
+	}
+	for _, c := range r.Certificates {
+		p.Certificates = append(p.Certificates, &pb.Certificate{
+			Name:   c.Name,
+			Issuer: c.Issuer,
+			Date:   fromISODate(c.Date),
+			Link:   c.URL,
+		})
+	}
+	for _, a := range r.Awards {
+		p.Achievements = append(p.Achievements, &pb.Achievement{Title: a.Title, Description: a.Summary})
+	}
+
+	return p
+}
+
+// toISODate converts the editor's MM/YYYY dates to ISO 8601. "Present" maps
+// to an empty date, which JSON Resume reads as ongoing; other text is kept.
+func toISODate(s string) string {
+	s = strings.TrimSpace(s)
+	switch strings.ToLower(s) {
+	case "present", "current", "now":
+		return ""
+	}
+	if m := monthYear.FindStringSubmatch(s); m != nil {
+		month, _ := strconv.Atoi(m[1])
+		return fmt.Sprintf("%s-%02d", m[2], month)
+	}
+	return s
+}
+
+// fromISODate converts ISO 8601 dates to the editor's MM/YYYY format.
+func fromISODate(s string) string {
+	s = strings.TrimSpace(s)
+	m := isoDate.FindStringSubmatch(s)
+	if m == nil || m[2] == "" {
+		return s
+	}
+	return m[2] + "/" + m[1]
+}
+
+func withHighlights(text string, highlights []string) string {
+	var lines []string
+	if text != "" {
+		lines = append(lines, text)
+	}
+	for _, h := range highlights {
+		lines = append(lines, "• "+h)
+	}
+	return strings.Join(lines, "\n")
+}
+
+func formatLocation(l *Location) string {
+	if l == nil {
+		return ""
+	}
+	if l.Address != "" {
+		return l.Address
+	}
+
+	var parts []string
+	for _, part := range []string{l.City, l.Region, l.CountryCode} {
+		if part != "" {
+			parts = append(parts, part)
+		}
+	}
+	return strings.Join(parts, ", ")
+}
+
+func profileURL(prof Profile, prefix string) string {
+	if prof.URL != "" {
+		return withoutScheme(prof.URL)
+	}
+	if prof.Username != "" {
+		return prefix + prof.Username
+	}
+	return ""
+}
+
+func withScheme(url string) string {
+	if url == "" || strings.Contains(url, "://") {
+		return url
+	}
+	return "https://" + url
+}
+
+func withoutScheme(url string) string {
+	url = strings.TrimPrefix(url, "https://")
+	return strings.TrimPrefix(url, "http://")
+}
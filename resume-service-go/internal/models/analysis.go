@@ -0,0 +1,26 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+)
+
+// Analysis is an ATS result for a revision of a saved resume scored against
+// a job description. Analyses are append-only and form the score history.
+type Analysis struct {
+	ID               uuid.UUID       `gorm:"type:uuid;default:gen_random_uuid();primaryKey"`
+	OwnerID          uuid.UUID       `gorm:"type:uuid;not null;index"`
+	ResumeID         uuid.UUID       `gorm:"type:uuid;not null;index:idx_analysis_resume_job"`
+	Resume           *SavedResume    `gorm:"constraint:OnDelete:CASCADE"`
+	ResumeRevision   int32           `gorm:"not null"`
+	JobDescriptionID uuid.UUID       `gorm:"type:uuid;not null;index:idx_analysis_resume_job"`
+	JobDescription   *JobDescription `gorm:"constraint:OnDelete:CASCADE"`
+	Score            int32           `gorm:"not null"`
+	Feedback         pq.StringArray  `gorm:"type:text[]"`
+	MissingKeywords  pq.StringArray  `gorm:"type:text[]"`
+	Reasoning        string
+	Source           string `gorm:"not null"` // lower-cased AnalysisSource name, e.g. "llm"
+	CreatedAt        time.Time
+}
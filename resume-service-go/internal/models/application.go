@@ -0,0 +1,36 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Application is a job the owner applied to, linked to the saved resume
+// (and revision of it) that was sent.
+type Application struct {
+	ID             uuid.UUID    `gorm:"type:uuid;default:gen_random_uuid();primaryKey"`
+	OwnerID        uuid.UUID    `gorm:"type:uuid;not null;index"`
+	ResumeID       *uuid.UUID   `gorm:"type:uuid;index"`
+	Resume         *SavedResume `gorm:"constraint:OnDelete:SET NULL"`
+	ResumeRevision int32
+	Company        string `gorm:"not null"`
+	Role           string `gorm:"not null"`
+	JobDescription string
+	URL            string
+	Status         string `gorm:"not null;index"` // lower-cased ApplicationStatus name, e.g. "interview"
+	AppliedAt      time.Time
+	Notes          string
+	History        []ApplicationStatusChange `gorm:"foreignKey:ApplicationID;constraint:OnDelete:CASCADE"`
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+}
+
+// ApplicationStatusChange records an application entering a status.
+type ApplicationStatusChange struct {
+	ID            uuid.UUID `gorm:"type:uuid;default:gen_random_uuid();primaryKey"`
+	ApplicationID uuid.UUID `gorm:"type:uuid;not null;index"`
+	Status        string    `gorm:"not null"`
+	Note          string
+	ChangedAt     time.Time `gorm:"not null"`
+}
@@ -0,0 +1,53 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// CoverLetter is a cover letter saved by its owner, optionally linked to the
+// application, resume and job description it was written for.
+type CoverLetter struct {
+	ID               uuid.UUID       `gorm:"type:uuid;default:gen_random_uuid();primaryKey"`
+	OwnerID          uuid.UUID       `gorm:"type:uuid;not null;index"`
+	ApplicationID    *uuid.UUID      `gorm:"type:uuid;index"`
+	Application      *Application    `gorm:"constraint:OnDelete:SET NULL"`
+	ResumeID         *uuid.UUID      `gorm:"type:uuid;index"`
+	Resume           *SavedResume    `gorm:"constraint:OnDelete:SET NULL"`
+	JobDescriptionID *uuid.UUID      `gorm:"type:uuid;index"`
+	JobDescription   *JobDescription `gorm:"constraint:OnDelete:SET NULL"`
+	Content          string          `gorm:"not null"`
+	CreatedAt        time.Time
+	UpdatedAt        time.Time
+}
+
+// QuestionSet is a set of interview questions saved for practice, linked
+// like a CoverLetter.
+type QuestionSet struct {
+	ID               uuid.UUID          `gorm:"type:uuid;default:gen_random_uuid();primaryKey"`
+	OwnerID          uuid.UUID          `gorm:"type:uuid;not null;index"`
+	ApplicationID    *uuid.UUID         `gorm:"type:uuid;index"`
+	Application      *Application       `gorm:"constraint:OnDelete:SET NULL"`
+	ResumeID         *uuid.UUID         `gorm:"type:uuid;index"`
+	Resume           *SavedResume       `gorm:"constraint:OnDelete:SET NULL"`
+	JobDescriptionID *uuid.UUID         `gorm:"type:uuid;index"`
+	JobDescription   *JobDescription    `gorm:"constraint:OnDelete:SET NULL"`
+	Questions        []PracticeQuestion `gorm:"foreignKey:QuestionSetID;constraint:OnDelete:CASCADE"`
+	CreatedAt        time.Time
+	UpdatedAt        time.Time
+}
+
+// PracticeQuestion is a question of a QuestionSet along with the owner's
+// practice progress.
+type PracticeQuestion struct {
+	ID            uuid.UUID `gorm:"type:uuid;default:gen_random_uuid();primaryKey"`
+	QuestionSetID uuid.UUID `gorm:"type:uuid;not null;index"`
+	Position      int       `gorm:"not null"`
+	Question      string    `gorm:"not null"`
+	Type          string
+	AnswerGuide   string
+	Practiced     bool `gorm:"not null;default:false"`
+	UserAnswer    string
+	UpdatedAt     time.Time
+}
@@ -0,0 +1,18 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// JobDescription is a job posting saved by its owner.
+type JobDescription struct {
+	ID        uuid.UUID `gorm:"type:uuid;default:gen_random_uuid();primaryKey"`
+	OwnerID   uuid.UUID `gorm:"type:uuid;not null;index"`
+	Title     string
+	Company   string
+	SourceURL string
+	Text      string `gorm:"not null"`
+	CreatedAt time.Time
+}
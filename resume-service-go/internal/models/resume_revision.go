@@ -0,0 +1,16 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ResumeRevision is an append-only snapshot of a SavedResume's data
+type ResumeRevision struct {
+	ID         uuid.UUID `gorm:"type:uuid;default:gen_random_uuid();primaryKey"`
+	ResumeID   uuid.UUID `gorm:"type:uuid;not null;uniqueIndex:idx_resume_revision"`
+	Revision   int32     `gorm:"not null;uniqueIndex:idx_resume_revision"`
+	ResumeData []byte    `gorm:"type:jsonb"`
+	CreatedAt  time.Time
+}
@@ -13,7 +13,12 @@ type SavedResume struct {
 	ID         uuid.UUID      `gorm:"type:uuid;default:gen_random_uuid();primaryKey"`
 	ResumeData []byte         `gorm:"type:jsonb"` // Store as JSON blob for flexibility
 	Tags       pq.StringArray `gorm:"type:text[]"`
+	OwnerID    uuid.UUID      `gorm:"type:uuid;index"`
+	Owner      *User
 	Version    string
+	VersionKey string           `gorm:"not null;default:''"` // Normalized Version, unique per owner among live resumes
+	Revision   int32            `gorm:"not null;default:0"`  // Latest revision number, see ResumeRevision
+	Revisions  []ResumeRevision `gorm:"foreignKey:ResumeID;constraint:OnDelete:CASCADE"`
 	CreatedAt  time.Time
 	UpdatedAt  time.Time
 	DeletedAt  gorm.DeletedAt `gorm:"index"`
@@ -0,0 +1,18 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// User is a person who owns saved resumes. Subject is the stable identity
+// asserted by the gateway (e.g. a JWT "sub" claim).
+type User struct {
+	ID        uuid.UUID `gorm:"type:uuid;default:gen_random_uuid();primaryKey"`
+	Subject   string    `gorm:"not null;uniqueIndex"`
+	Email     string
+	Name      string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
@@ -0,0 +1,33 @@
+package render
+
+import (
+	"strings"
+
+	pb "github.com/iprotoresume/shared/proto"
+)
+
+// classicTemplate is a single column layout with a centered header.
+type classicTemplate struct{}
+
+func (classicTemplate) Name() string { return "classic" }
+
+func (classicTemplate) Render(d *Document, r *pb.ResumeData) {
+	accent := color{30, 30, 30}
+	d.PDF().AddPage()
+
+	d.Font("B", 20, 20)
+	d.Line(r.FullName, "C")
+	d.Font("", 12, 80)
+	d.Line(r.JobTitle, "C")
+	d.Font("", 9, 90)
+	d.Line(strings.Join(nonEmpty(r.Email, r.Phone, r.Location, r.Linkedin, r.Github, r.Website), "  |  "), "C")
+
+	summarySection(d, r, accent)
+	experienceSection(d, r, accent)
+	projectsSection(d, r, accent)
+	educationSection(d, r, accent)
+	skillsSection(d, r, accent)
+	certificatesSection(d, r, accent)
+	languagesSection(d, r, accent)
+	achievementsSection(d, r, accent)
+}
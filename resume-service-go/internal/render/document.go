@@ -0,0 +1,170 @@
+package render
+
+import (
+	"embed"
+	"encoding/base64"
+	"regexp"
+	"strings"
+
+	"github.com/jung-kurt/gofpdf"
+)
+
+const (
+	fontFamily = "DejaVu"
+	lineHeight = 5.0
+)
+
+// fonts are DejaVu Sans Condensed, embedded as TrueType so that text in any
+// script it covers renders, not just what the cp1252 core fonts can encode.
+//
+//go:embed fonts/*.ttf
+var fonts embed.FS
+
+// fontFiles maps font styles to their files.
+var fontFiles = map[string]string{
+	"":   "fonts/DejaVuSansCondensed.ttf",
+	"B":  "fonts/DejaVuSansCondensed-Bold.ttf",
+	"I":  "fonts/DejaVuSansCondensed-Oblique.ttf",
+	"BI": "fonts/DejaVuSansCondensed-BoldOblique.ttf",
+}
+
+var imageDataURL = regexp.MustCompile(`^data:image/(png|jpe?g|gif);base64,(.+)$`)
+
+// Document wraps a PDF with the text primitives shared by all templates.
+// Drawing always happens between the current left and right margins, so a
+// template can lay out columns by moving the margins.
+type Document struct {
+	pdf *gofpdf.Fpdf
+}
+
+func newDocument() *Document {
+	pdf := gofpdf.New("P", "mm", "A4", "")
+	pdf.SetMargins(15, 15, 15)
+	pdf.SetAutoPageBreak(true, 15)
+	for style, file := range fontFiles {
+		data, err := fonts.ReadFile(file)
+		if err != nil {
+			panic("render: missing font " + file)
+		}
+		pdf.AddUTF8FontFromBytes(fontFamily, style, data)
+	}
+
+	return &Document{pdf: pdf}
+}
+
+// PDF exposes the underlying document for template specific drawing.
+func (d *Document) PDF() *gofpdf.Fpdf {
+	return d.pdf
+}
+
+// Font sets the font style ("", "B", "I" or "BI"), size in points and gray level.
+func (d *Document) Font(style string, size float64, gray int) {
+	d.pdf.SetFont(fontFamily, style, size)
+	d.pdf.SetTextColor(gray, gray, gray)
+}
+
+// Line writes a single line of text aligned "L", "C" or "R" and moves below it.
+func (d *Document) Line(text, align string) {
+	if text == "" {
+		return
+	}
+	d.pdf.CellFormat(0, lineHeight+1, text, "", 1, align, false, 0, "")
+}
+
+// Paragraph writes wrapping text.
+func (d *Document) Paragraph(text string) {
+	if text == "" {
+		return
+	}
+	d.pdf.MultiCell(0, lineHeight, text, "", "L", false)
+}
+
+// SplitLine writes left aligned text and right aligned text on one line, e.g. a title and its dates.
+func (d *Document) SplitLine(left, right string) {
+	width := d.contentWidth()
+	rightWidth := d.pdf.GetStringWidth(right) + 2
+	d.pdf.CellFormat(width-rightWidth, lineHeight+1, left, "", 0, "L", false, 0, "")
+	d.pdf.CellFormat(rightWidth, lineHeight+1, right, "", 1, "R", false, 0, "")
+}
+
+// Heading writes a section title followed by a rule.
+func (d *Document) Heading(title string, r, g, b int) {
+	d.pdf.Ln(3)
+	d.pdf.SetFont(fontFamily, "B", 11)
+	d.pdf.SetTextColor(r, g, b)
+	d.pdf.CellFormat(0, lineHeight+1, strings.ToUpper(title), "", 1, "L", false, 0, "")
+
+	left, _, right, _ := d.pdf.GetMargins()
+	pageWidth, _ := d.pdf.GetPageSize()
+	y := d.pdf.GetY()
+	d.pdf.SetDrawColor(r, g, b)
+	d.pdf.SetLineWidth(0.3)
+	d.pdf.Line(left, y, pageWidth-right, y)
+	d.pdf.Ln(2)
+}
+
+// RichText writes text the way the editor previews it: lines starting with
+// "* ", "- " or "• " become indented bullets and **text** is bold.
+func (d *Document) RichText(text string, size float64, gray int) {
+	if strings.TrimSpace(text) == "" {
+		return
+	}
+
+	left, _, _, _ := d.pdf.GetMargins()
+	for _, line := range strings.Split(text, "\n") {
+		trimmed, bullet := splitBullet(line)
+		if bullet {
+			d.pdf.SetX(left + 2)
+			d.Font("", size, gray)
+			d.pdf.Write(lineHeight, "•")
+			d.pdf.SetLeftMargin(left + 6)
+			d.pdf.SetX(left + 6)
+		}
+		d.writeBold(trimmed, size, gray)
+		d.pdf.Ln(lineHeight)
+		d.pdf.SetLeftMargin(left)
+	}
+}
+
+// writeBold writes a line, toggling bold at every "**" marker.
+func (d *Document) writeBold(text string, size float64, gray int) {
+	for i, segment := range boldSpans(text) {
+		style := ""
+		if i%2 == 1 {
+			style = "B"
+		}
+		d.Font(style, size, gray)
+		d.pdf.Write(lineHeight, segment)
+	}
+}
+
+// Image draws a base64 data URL image, returning false if it cannot be decoded.
+func (d *Document) Image(dataURL string, x, y, w, h float64) bool {
+	m := imageDataURL.FindStringSubmatch(dataURL)
+	if m == nil {
+		return false
+	}
+	raw, err := base64.StdEncoding.DecodeString(m[2])
+	if err != nil {
+		return false
+	}
+
+	imageType := strings.ToUpper(m[1])
+	if imageType == "JPEG" {
+		imageType = "JPG"
+	}
+	opts := gofpdf.ImageOptions{ImageType: imageType}
+	d.pdf.RegisterImageOptionsReader("profile", opts, strings.NewReader(string(raw)))
+	if d.pdf.Err() {
+		d.pdf.ClearError()
+		return false
+	}
+	d.pdf.ImageOptions("profile", x, y, w, h, false, opts, 0, "")
+	return true
+}
+
+func (d *Document) contentWidth() float64 {
+	left, _, right, _ := d.pdf.GetMargins()
+	pageWidth, _ := d.pdf.GetPageSize()
+	return pageWidth - left - right
+}
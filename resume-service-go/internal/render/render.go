@@ -0,0 +1,64 @@
+package render
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"sort"
+
+	pb "github.com/iprotoresume/shared/proto"
+)
+
+// DefaultTemplate is used when no template is requested.
+const DefaultTemplate = "classic"
+
+// ErrUnknownTemplate is returned when a template name is not registered.
+var ErrUnknownTemplate = errors.New("unknown template")
+
+// Template lays out a resume on a PDF document.
+type Template interface {
+	Name() string
+	Render(d *Document, resume *pb.ResumeData)
+}
+
+var registry = map[string]Template{}
+
+func init() {
+	Register(classicTemplate{})
+	Register(sidebarTemplate{})
+}
+
+// Register makes a template available by name, replacing any template with the same name.
+func Register(t Template) {
+	registry[t.Name()] = t
+}
+
+// Templates returns the names of all registered templates.
+func Templates() []string {
+	names := make([]string, 0, len(registry))
+	for name := range registry {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// PDF renders resume with the named template.
+func PDF(resume *pb.ResumeData, template string) ([]byte, error) {
+	if template == "" {
+		template = DefaultTemplate
+	}
+	t, ok := registry[template]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrUnknownTemplate, template)
+	}
+
+	d := newDocument()
+	t.Render(d, resume)
+
+	var buf bytes.Buffer
+	if err := d.pdf.Output(&buf); err != nil {
+		return nil, fmt.Errorf("failed to render PDF: %w", err)
+	}
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,37 @@
+package render
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/iprotoresume/resume-service-go/internal/importer"
+	pb "github.com/iprotoresume/shared/proto"
+)
+
+func TestPDFKeepsNonLatinText(t *testing.T) {
+	resume := &pb.ResumeData{
+		FullName: "Zoë Łukasiewicz",
+		JobTitle: "Инженер-программист",
+		Summary:  "Αθήνα → Kraków, 10 € per commit",
+		Experience: []*pb.Experience{
+			{Title: "Entwicklerin", Company: "Müller & Søn", StartDate: "01/2020", EndDate: "Present", Description: "• **Straße** für Ümlaute"},
+		},
+	}
+	for _, name := range Templates() {
+		t.Run(name, func(t *testing.T) {
+			data, err := PDF(resume, name)
+			if err != nil {
+				t.Fatalf("PDF() error = %v", err)
+			}
+			text, err := importer.PDFText(data)
+			if err != nil {
+				t.Fatalf("PDFText() error = %v", err)
+			}
+			for _, want := range []string{"Zoë Łukasiewicz", "Инженер-программист", "Αθήνα → Kraków, 10 € per commit", "Müller & Søn", "Straße"} {
+				if !strings.Contains(text, want) {
+					t.Errorf("rendered text does not contain %q:\n%s", want, text)
+				}
+			}
+		})
+	}
+}
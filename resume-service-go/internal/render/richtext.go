@@ -0,0 +1,49 @@
+package render
+
+import "strings"
+
+// bulletMarkers start a bullet line in the editor's rich text.
+var bulletMarkers = []string{"* ", "- ", "• "}
+
+// block is a run of rich text lines: either a plain paragraph or a bullet list.
+type block struct {
+	Text    string
+	Bullets []string
+}
+
+// splitBullet strips a bullet marker from line, reporting whether it had one.
+func splitBullet(line string) (string, bool) {
+	trimmed := strings.TrimSpace(line)
+	for _, marker := range bulletMarkers {
+		if rest, ok := strings.CutPrefix(trimmed, marker); ok {
+			return rest, true
+		}
+	}
+	return trimmed, false
+}
+
+// richBlocks groups the lines of text into paragraphs and bullet lists.
+// Blank lines are dropped.
+func richBlocks(text string) []block {
+	var blocks []block
+	for _, line := range strings.Split(text, "\n") {
+		content, bullet := splitBullet(line)
+		switch {
+		case content == "":
+			continue
+		case !bullet:
+			blocks = append(blocks, block{Text: content})
+		case len(blocks) > 0 && len(blocks[len(blocks)-1].Bullets) > 0:
+			last := &blocks[len(blocks)-1]
+			last.Bullets = append(last.Bullets, content)
+		default:
+			blocks = append(blocks, block{Bullets: []string{content}})
+		}
+	}
+	return blocks
+}
+
+// boldSpans splits text at "**" markers; odd spans are bold.
+func boldSpans(text string) []string {
+	return strings.Split(text, "**")
+}
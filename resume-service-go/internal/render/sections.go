@@ -0,0 +1,143 @@
+package render
+
+import (
+	"strings"
+
+	pb "github.com/iprotoresume/shared/proto"
+)
+
+// color is an RGB accent used for section headings.
+type color struct{ r, g, b int }
+
+func (d *Document) section(title string, accent color) {
+	d.Heading(title, accent.r, accent.g, accent.b)
+}
+
+func summarySection(d *Document, r *pb.ResumeData, accent color) {
+	if r.Summary == "" {
+		return
+	}
+	d.section("Summary", accent)
+	d.RichText(r.Summary, 10, 50)
+}
+
+func experienceSection(d *Document, r *pb.ResumeData, accent color) {
+	if len(r.Experience) == 0 {
+		return
+	}
+	d.section("Experience", accent)
+	for _, e := range r.Experience {
+		d.Font("B", 10.5, 20)
+		d.SplitLine(e.Title, dateRange(e.StartDate, e.EndDate))
+		d.Font("I", 10, 80)
+		d.Line(e.Company, "L")
+		d.RichText(e.Description, 9.5, 50)
+		d.PDF().Ln(2)
+	}
+}
+
+func projectsSection(d *Document, r *pb.ResumeData, accent color) {
+	if len(r.Projects) == 0 {
+		return
+	}
+	d.section("Projects", accent)
+	for _, p := range r.Projects {
+		d.Font("B", 10.5, 20)
+		d.SplitLine(p.Title, strings.Join(nonEmpty(p.Location, p.Date), " | "))
+		d.RichText(p.Description, 9.5, 50)
+		if len(p.TechStack) > 0 {
+			d.Font("I", 9, 90)
+			d.Paragraph(strings.Join(p.TechStack, ", "))
+		}
+		d.PDF().Ln(2)
+	}
+}
+
+func educationSection(d *Document, r *pb.ResumeData, accent color) {
+	if len(r.Education) == 0 {
+		return
+	}
+	d.section("Education", accent)
+	for _, e := range r.Education {
+		d.Font("B", 10.5, 20)
+		d.SplitLine(e.Degree, e.GraduationDate)
+		d.Font("", 10, 80)
+		d.Line(e.Institution, "L")
+	}
+}
+
+// skillsSection lists skill groups as "Category: items", then any ungrouped skills.
+func skillsSection(d *Document, r *pb.ResumeData, accent color) {
+	if len(r.SkillGroups) == 0 && len(r.Skills) == 0 {
+		return
+	}
+	d.section("Skills", accent)
+	for _, g := range r.SkillGroups {
+		d.writeBold("**"+g.Category+":** "+strings.Join(g.Items, ", "), 9.5, 50)
+		d.PDF().Ln(lineHeight)
+	}
+	if len(r.Skills) > 0 {
+		d.Font("", 9.5, 50)
+		d.Paragraph(strings.Join(r.Skills, ", "))
+	}
+}
+
+func certificatesSection(d *Document, r *pb.ResumeData, accent color) {
+	if len(r.Certificates) == 0 {
+		return
+	}
+	d.section("Certificates", accent)
+	for _, c := range r.Certificates {
+		d.Font("B", 10, 20)
+		d.Paragraph(c.Name)
+		d.Font("", 9, 80)
+		d.Paragraph(strings.Join(nonEmpty(c.Issuer, c.Date), " | "))
+	}
+}
+
+func languagesSection(d *Document, r *pb.ResumeData, accent color) {
+	if len(r.Languages) == 0 {
+		return
+	}
+	d.section("Languages", accent)
+	for _, l := range r.Languages {
+		d.writeBold("**"+l.Language+"**", 9.5, 50)
+		if l.Proficiency != "" {
+			d.Font("", 9.5, 80)
+			d.PDF().Write(lineHeight, " - "+l.Proficiency)
+		}
+		d.PDF().Ln(lineHeight)
+	}
+}
+
+func achievementsSection(d *Document, r *pb.ResumeData, accent color) {
+	if len(r.Achievements) == 0 {
+		return
+	}
+	d.section("Achievements", accent)
+	for _, a := range r.Achievements {
+		d.Font("B", 10, 20)
+		d.Paragraph(a.Title)
+		d.RichText(a.Description, 9.5, 50)
+	}
+}
+
+func dateRange(start, end string) string {
+	if start == "" {
+		return end
+	}
+	if end == "" {
+		return start
+	}
+	return start + " - " + end
+}
+
+func nonEmpty(values ...string) []string {
+	var out []string
+	for _, v := range values {
+		if strings.TrimSpace(v) != "" {
+			out = append(out, v)
+		}
+	}
+	return out
+}
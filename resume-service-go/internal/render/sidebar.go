@@ -0,0 +1,63 @@
+package render
+
+import (
+	pb "github.com/iprotoresume/shared/proto"
+)
+
+const (
+	sidebarWidth = 64.0
+	photoSize    = 30.0
+)
+
+// sidebarTemplate puts the photo, contact details, skills, languages and
+// certificates in a shaded left column and the narrative sections on the right.
+type sidebarTemplate struct{}
+
+func (sidebarTemplate) Name() string { return "sidebar" }
+
+func (sidebarTemplate) Render(d *Document, r *pb.ResumeData) {
+	accent := color{37, 99, 235}
+	pdf := d.PDF()
+	pageWidth, pageHeight := pdf.GetPageSize()
+
+	// Shade the sidebar on every page, including overflow pages of the main column
+	pdf.SetHeaderFunc(func() {
+		pdf.SetFillColor(241, 245, 249)
+		pdf.Rect(0, 0, sidebarWidth, pageHeight, "F")
+	})
+
+	// Sidebar: content that does not fit is clipped rather than paginated
+	pdf.SetMargins(8, 12, pageWidth-sidebarWidth+6)
+	pdf.SetAutoPageBreak(false, 0)
+	pdf.AddPage()
+
+	if r.ProfileImage != "" && d.Image(r.ProfileImage, (sidebarWidth-photoSize)/2, 12, photoSize, photoSize) {
+		pdf.SetY(12 + photoSize + 4)
+	}
+	d.Font("B", 15, 20)
+	d.Paragraph(r.FullName)
+	d.Font("", 10.5, 80)
+	d.Paragraph(r.JobTitle)
+
+	if contact := nonEmpty(r.Email, r.Phone, r.Location, r.Linkedin, r.Github, r.Website); len(contact) > 0 {
+		d.section("Contact", accent)
+		d.Font("", 8.5, 60)
+		for _, c := range contact {
+			d.Paragraph(c)
+		}
+	}
+	skillsSection(d, r, accent)
+	languagesSection(d, r, accent)
+	certificatesSection(d, r, accent)
+
+	// Main column
+	pdf.SetMargins(sidebarWidth+8, 15, 12)
+	pdf.SetAutoPageBreak(true, 15)
+	pdf.SetXY(sidebarWidth+8, 12)
+
+	summarySection(d, r, accent)
+	experienceSection(d, r, accent)
+	projectsSection(d, r, accent)
+	educationSection(d, r, accent)
+	achievementsSection(d, r, accent)
+}
@@ -0,0 +1,204 @@
+package render
+
+import (
+	"bytes"
+	"embed"
+	"fmt"
+	htmltemplate "html/template"
+	"io"
+	"strings"
+	texttemplate "text/template"
+
+	pb "github.com/iprotoresume/shared/proto"
+)
+
+//go:embed templates
+var templateFS embed.FS
+
+var (
+	markdownTemplate = texttemplate.Must(texttemplate.New("resume.md.tmpl").
+				Funcs(commonFuncs).Funcs(texttemplate.FuncMap{"rich": markdownRich}).
+				ParseFS(templateFS, "templates/resume.md.tmpl"))
+
+	htmlTemplate = htmltemplate.Must(htmltemplate.New("resume.html.tmpl").
+			Funcs(commonFuncs).Funcs(htmltemplate.FuncMap{"rich": htmlRich, "url": withScheme, "image": imageSource}).
+			ParseFS(templateFS, "templates/resume.html.tmpl"))
+
+	// LaTeX is full of braces, so its template uses << >> as delimiters.
+	latexTemplate = texttemplate.Must(texttemplate.New("resume.tex.tmpl").Delims("<<", ">>").
+			Funcs(commonFuncs).Funcs(texttemplate.FuncMap{"rich": latexRich, "tex": latexEscape, "handle": profileHandle, "firstName": firstName, "lastName": lastName}).
+			ParseFS(templateFS, "templates/resume.tex.tmpl"))
+)
+
+var commonFuncs = map[string]any{
+	"dateRange": dateRange,
+	"join":      strings.Join,
+	"nonEmpty":  nonEmpty,
+	"contact": func(r *pb.ResumeData) []string {
+		return nonEmpty(r.Email, r.Phone, r.Location, r.Linkedin, r.Github, r.Website)
+	},
+}
+
+type executor interface {
+	Execute(w io.Writer, data any) error
+}
+
+// Markdown renders resume as a Markdown document.
+func Markdown(resume *pb.ResumeData) ([]byte, error) {
+	return execute(markdownTemplate, resume)
+}
+
+// HTML renders resume as a standalone HTML page with inline styles.
+func HTML(resume *pb.ResumeData) ([]byte, error) {
+	return execute(htmlTemplate, resume)
+}
+
+// LaTeX renders resume as a moderncv document.
+func LaTeX(resume *pb.ResumeData) ([]byte, error) {
+	return execute(latexTemplate, resume)
+}
+
+func execute(t executor, resume *pb.ResumeData) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := t.Execute(&buf, resume); err != nil {
+		return nil, fmt.Errorf("failed to render resume: %w", err)
+	}
+	return buf.Bytes(), nil
+}
+
+// markdownRich normalizes bullets to "- "; bold markers are already Markdown.
+func markdownRich(text string) string {
+	var parts []string
+	for _, b := range richBlocks(text) {
+		if b.Bullets == nil {
+			parts = append(parts, b.Text)
+			continue
+		}
+		items := make([]string, len(b.Bullets))
+		for i, item := range b.Bullets {
+			items[i] = "- " + item
+		}
+		parts = append(parts, strings.Join(items, "\n"))
+	}
+	return strings.Join(parts, "\n\n")
+}
+
+func htmlRich(text string) htmltemplate.HTML {
+	var sb strings.Builder
+	for _, b := range richBlocks(text) {
+		if b.Bullets == nil {
+			sb.WriteString("<p>" + htmlSpans(b.Text) + "</p>")
+			continue
+		}
+		sb.WriteString("<ul>")
+		for _, item := range b.Bullets {
+			sb.WriteString("<li>" + htmlSpans(item) + "</li>")
+		}
+		sb.WriteString("</ul>")
+	}
+	return htmltemplate.HTML(sb.String())
+}
+
+func htmlSpans(text string) string {
+	var sb strings.Builder
+	for i, span := range boldSpans(text) {
+		span = htmltemplate.HTMLEscapeString(span)
+		if i%2 == 1 {
+			span = "<strong>" + span + "</strong>"
+		}
+		sb.WriteString(span)
+	}
+	return sb.String()
+}
+
+// latexRich keeps the output free of blank lines, since moderncv entry
+// arguments cannot contain paragraph breaks.
+func latexRich(text string) string {
+	var sb strings.Builder
+	afterText := false
+	for _, b := range richBlocks(text) {
+		if b.Bullets == nil {
+			if afterText {
+				sb.WriteString("\\newline\n")
+			}
+			sb.WriteString(latexSpans(b.Text))
+			afterText = true
+			continue
+		}
+		afterText = false
+		sb.WriteString("\n\\begin{itemize}\n")
+		for _, item := range b.Bullets {
+			sb.WriteString(`\item ` + latexSpans(item) + "\n")
+		}
+		sb.WriteString(`\end{itemize}`)
+	}
+	return sb.String()
+}
+
+func latexSpans(text string) string {
+	var sb strings.Builder
+	for i, span := range boldSpans(text) {
+		span = latexEscape(span)
+		if i%2 == 1 {
+			span = `\textbf{` + span + `}`
+		}
+		sb.WriteString(span)
+	}
+	return sb.String()
+}
+
+var latexReplacer = strings.NewReplacer(
+	`\`, `\textbackslash{}`,
+	`&`, `\&`,
+	`%`, `\%`,
+	`$`, `\$`,
+	`#`, `\#`,
+	`_`, `\_`,
+	`{`, `\{`,
+	`}`, `\}`,
+	`~`, `\textasciitilde{}`,
+	`^`, `\textasciicircum{}`,
+)
+
+func latexEscape(text string) string {
+	return latexReplacer.Replace(text)
+}
+
+// profileHandle reduces a profile URL like "linkedin.com/in/jane" to "jane".
+func profileHandle(url string) string {
+	url = strings.TrimRight(url, "/")
+	return url[strings.LastIndex(url, "/")+1:]
+}
+
+func firstName(fullName string) string {
+	fields := strings.Fields(fullName)
+	if len(fields) < 2 {
+		return fullName
+	}
+	return strings.Join(fields[:len(fields)-1], " ")
+}
+
+func lastName(fullName string) string {
+	fields := strings.Fields(fullName)
+	if len(fields) < 2 {
+		return ""
+	}
+	return fields[len(fields)-1]
+}
+
+// imageSource returns a profile image for an img src. Only the data URLs
+// the PDF renderers accept are passed through, marked safe since
+// html/template rejects data URLs; anything else is dropped.
+func imageSource(src string) htmltemplate.URL {
+	if !imageDataURL.MatchString(src) {
+		return ""
+	}
+	return htmltemplate.URL(src)
+}
+
+func withScheme(url string) string {
+	if url == "" || strings.Contains(url, "://") {
+		return url
+	}
+	return "https://" + url
+}
@@ -0,0 +1,99 @@
+package scorer
+
+import (
+	"embed"
+	"strings"
+	"unicode"
+
+	"github.com/blevesearch/snowballstem"
+	"github.com/blevesearch/snowballstem/english"
+	"github.com/blevesearch/snowballstem/french"
+	"github.com/blevesearch/snowballstem/german"
+	"github.com/blevesearch/snowballstem/portuguese"
+	"github.com/blevesearch/snowballstem/spanish"
+)
+
+//go:embed stopwords/*.txt
+var stopwordFiles embed.FS
+
+// language is a language job descriptions and resumes are scored in.
+type language struct {
+	Code      string // ISO 639-1
+	Name      string
+	stopWords map[string]bool
+	stemmer   func(*snowballstem.Env) bool
+	phrases   map[string]bool // curated phrases keyed by this language's stems
+}
+
+// languages are the supported languages, the first being the default when
+// none is recognized.
+var languages = []*language{
+	newLanguage("en", "English", english.Stem),
+	newLanguage("de", "German", german.Stem),
+	newLanguage("fr", "French", french.Stem),
+	newLanguage("es", "Spanish", spanish.Stem),
+	newLanguage("pt", "Portuguese", portuguese.Stem),
+}
+
+// newLanguage loads the embedded stop words of a language, one or more per
+// line with lines starting with # ignored.
+func newLanguage(code, name string, stemmer func(*snowballstem.Env) bool) *language {
+	data, err := stopwordFiles.ReadFile("stopwords/" + code + ".txt")
+	if err != nil {
+		panic("scorer: no stop words for " + code + ": " + err.Error())
+	}
+	l := &language{Code: code, Name: name, stopWords: make(map[string]bool), stemmer: stemmer}
+	for _, line := range strings.Split(string(data), "\n") {
+		if strings.HasPrefix(line, "#") {
+			continue
+		}
+		for _, w := range strings.Fields(line) {
+			l.stopWords[w] = true
+		}
+	}
+
+	l.phrases = make(map[string]bool, len(phrases))
+	for phrase := range phrases {
+		var keys []string
+		for _, token := range strings.Fields(phrase) {
+			keys = append(keys, l.stem(token))
+		}
+		l.phrases[strings.Join(keys, " ")] = true
+	}
+	return l
+}
+
+// detectLanguage guesses the language of text as the one with the most
+// stop words in it. Text without any, such as a bare list of skills, is
+// taken to be in the default language.
+func detectLanguage(text string) *language {
+	counts := make([]int, len(languages))
+	for _, clause := range tokenize(text) {
+		for _, token := range clause {
+			for i, l := range languages {
+				if l.stopWords[token] {
+					counts[i]++
+				}
+			}
+		}
+	}
+
+	best, bestCount := languages[0], 0
+	for i, l := range languages {
+		if counts[i] > bestCount {
+			best, bestCount = l, counts[i]
+		}
+	}
+	return best
+}
+
+// stem reduces a word to its Snowball stem. Technology tokens and anything
+// but plain words are returned as they are.
+func (l *language) stem(token string) string {
+	if isTechToken(token) || strings.IndexFunc(token, func(r rune) bool { return !unicode.IsLetter(r) }) >= 0 {
+		return token
+	}
+	env := snowballstem.NewEnv(token)
+	l.stemmer(env)
+	return env.Current()
+}
@@ -0,0 +1,63 @@
+package scorer
+
+import (
+	"reflect"
+	"testing"
+
+	pb "github.com/iprotoresume/shared/proto"
+)
+
+func TestDetectLanguage(t *testing.T) {
+	tests := []struct {
+		text string
+		want string
+	}{
+		{"We are looking for an engineer who will own the platform.", "en"},
+		{"Wir suchen einen Entwickler mit Erfahrung in der Cloud und mit Kubernetes.", "de"},
+		{"Nous recherchons un développeur avec une expérience dans le cloud et les outils.", "fr"},
+		{"Buscamos un desarrollador con experiencia en la nube y en los equipos.", "es"},
+		{"Procuramos um desenvolvedor com experiência na nuvem e em equipes ágeis.", "pt"},
+		{"Go, Kubernetes, PostgreSQL", "en"},
+		{"", "en"},
+	}
+	for _, tt := range tests {
+		if got := detectLanguage(tt.text); got.Code != tt.want {
+			t.Errorf("detectLanguage(%q) = %s, want %s", tt.text, got.Code, tt.want)
+		}
+	}
+}
+
+func TestLanguageStopWords(t *testing.T) {
+	de := languages[1]
+	if de.Code != "de" {
+		t.Fatalf("languages[1] = %s, want de", de.Code)
+	}
+	// German stop words such as "wir", "und" and "mit" are not keywords
+	var got []string
+	for _, term := range weighTerms("Wir suchen Entwickler und Tester mit Erfahrung", de) {
+		got = append(got, term.surface)
+	}
+	if want := []string{"suchen", "entwickler", "tester", "erfahrung"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("weighTerms() = %q, want %q", got, want)
+	}
+}
+
+func TestCalculateLanguages(t *testing.T) {
+	jd := "Wir suchen einen Entwickler mit Erfahrung in der Cloud und mit Kubernetes."
+
+	german := Calculate(&pb.ResumeData{Summary: "Ich bin ein Entwickler mit viel Erfahrung in der Cloud und mit Kubernetes."}, jd)
+	if german.Language != "de" || german.ResumeLanguage != "de" {
+		t.Errorf("languages = %s, %s, want de, de", german.Language, german.ResumeLanguage)
+	}
+	if len(german.Feedback) != 1 {
+		t.Errorf("Feedback = %q, want no language mismatch", german.Feedback)
+	}
+
+	english := Calculate(&pb.ResumeData{Summary: "I am a developer with experience in the cloud and with Kubernetes."}, jd)
+	if english.Language != "de" || english.ResumeLanguage != "en" {
+		t.Errorf("languages = %s, %s, want de, en", english.Language, english.ResumeLanguage)
+	}
+	if n := len(english.Feedback); n == 0 || english.Feedback[n-1] != "The job description appears to be in German but the resume in English, so mostly technical terms could be matched. Consider a resume in German." {
+		t.Errorf("Feedback = %q, want a language mismatch last", english.Feedback)
+	}
+}
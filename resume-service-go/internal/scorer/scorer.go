@@ -1,43 +1,132 @@
 package scorer
 
 import (
+	"fmt"
+	"math"
+	"sort"
 	"strings"
 	"unicode"
+	"unicode/utf8"
+
+	pb "github.com/iprotoresume/shared/proto"
 )
 
+// maxReasoningKeywords caps how many missing keywords are quoted in Reasoning.
+const maxReasoningKeywords = 5
+
+// positionBonus is the extra weight of a keyword first mentioned at the very
+// start of the job description, where titles and key requirements usually
+// are. It shrinks linearly to nothing at the end.
+const positionBonus = 0.5
+
+// weakMatchWeight is the section weight below which a match is pointed out
+// in the feedback as counting for less.
+const weakMatchWeight = summaryWeight
+
 type Result struct {
 	Score           int32
-	MissingKeywords []string
+	MissingKeywords []string // most important first
 	Feedback        []string
+	Reasoning       string
+	Sections        []SectionScore
+	Language        string // ISO 639-1 code of the job description's language
+	ResumeLanguage  string // and of the resume's
 }
 
-// Calculate checks the resume against the job description.
-// It extracts keywords from the JD and checks if they exist in the resume text.
-func Calculate(resumeText string, jobDescription string) Result {
-	// Simple keyword extraction (for MVP: split by space, filter small words)
-	targetKeywords := extractKeywords(jobDescription)
-	resumeWords := normalize(resumeText)
+// SectionScore is how much of the job description one resume section covers.
+type SectionScore struct {
+	Section         string
+	Score           int32 // weighted share of job description keywords found in the section, 0-100
+	MatchedKeywords []string
+}
+
+// term is a job description keyword and its importance.
+type term struct {
+	keyword string // key the resume is matched on
+	surface string // as first written in the job description
+	weight  float64
+}
 
-	matchedCount := 0
-	var missing []string
+// Calculate checks the resume against the job description. Keywords of the
+// job description are weighted by how often and how early they appear, and
+// each one found in the resume earns its weight times that of the best
+// section it occurs in, so a skill listed under Skills or the latest role
+// counts for more than one only mentioned under Education. Skills are
+// matched through the taxonomy, so "Postgres" satisfies "PostgreSQL" and
+// React satisfies JavaScript. The job description and the resume are each
+// read with the stop words and stemmer of the language detected in them.
+func Calculate(resume *pb.ResumeData, jobDescription string) Result {
+	jobLanguage := detectLanguage(jobDescription)
+	terms := weighTerms(jobDescription, jobLanguage)
 
-	scanMap := make(map[string]bool)
-	for _, word := range resumeWords {
-		scanMap[word] = true
+	resumeParts := parts(resume)
+	texts := make([]string, len(resumeParts))
+	for i, p := range resumeParts {
+		texts[i] = p.text
 	}
+	resumeLanguage := detectLanguage(strings.Join(texts, "\n"))
+	words := make([]map[string]bool, len(resumeParts))
+	for i, p := range resumeParts {
+		words[i] = termSet(p.text, resumeLanguage)
+	}
+
+	var total, credit float64
+	matchedCount, weakCount := 0, 0
+	var missing []term
+	sectionCredit := make(map[string]float64)
+	sectionMatches := make(map[string][]string)
 
-	for _, kw := range targetKeywords {
-		if scanMap[kw] {
-			matchedCount++
-		} else {
-			missing = append(missing, kw)
+	for _, t := range terms {
+		total += t.weight
+
+		best := 0.0
+		for i, p := range resumeParts {
+			if !words[i][t.keyword] {
+				continue
+			}
+			best = math.Max(best, p.weight)
+			if matches := sectionMatches[p.section]; len(matches) == 0 || matches[len(matches)-1] != t.surface {
+				sectionMatches[p.section] = append(matches, t.surface)
+				sectionCredit[p.section] += t.weight
+			}
+		}
+
+		if best == 0 {
+			missing = append(missing, t)
+			continue
+		}
+		matchedCount++
+		credit += t.weight * best
+		if best < weakMatchWeight {
+			weakCount++
 		}
 	}
 
-	// Calculate Score (Percentage of matched keywords)
+	// Calculate Score (weighted percentage of matched keywords)
 	score := int32(0)
-	if len(targetKeywords) > 0 {
-		score = int32((float64(matchedCount) / float64(len(targetKeywords))) * 100)
+	if total > 0 {
+		score = int32(math.Round(credit / total * 100))
+	}
+
+	sort.SliceStable(missing, func(i, j int) bool {
+		return missing[i].weight > missing[j].weight
+	})
+	var missingKeywords []string
+	for _, t := range missing {
+		missingKeywords = append(missingKeywords, t.surface)
+	}
+
+	var sections []SectionScore
+	for _, name := range sectionOrder {
+		sectionScore := int32(0)
+		if total > 0 {
+			sectionScore = int32(math.Round(sectionCredit[name] / total * 100))
+		}
+		sections = append(sections, SectionScore{
+			Section:         name,
+			Score:           sectionScore,
+			MatchedKeywords: sectionMatches[name],
+		})
 	}
 
 	var feedback []string
@@ -48,39 +137,189 @@ func Calculate(resumeText string, jobDescription string) Result {
 	} else {
 		feedback = append(feedback, "Excellent match! High probability of passing ATS.")
 	}
+	if weakCount > 0 {
+		feedback = append(feedback, fmt.Sprintf("%d matched keywords appear only in education, certificates, languages, achievements or older roles. Mention them in your skills or recent experience to make them count fully.", weakCount))
+	}
+	if resumeLanguage != jobLanguage {
+		feedback = append(feedback, fmt.Sprintf("The job description appears to be in %s but the resume in %s, so mostly technical terms could be matched. Consider a resume in %s.", jobLanguage.Name, resumeLanguage.Name, jobLanguage.Name))
+	}
 
 	return Result{
 		Score:           score,
-		MissingKeywords: missing,
+		MissingKeywords: missingKeywords,
 		Feedback:        feedback,
+		Reasoning:       buildReasoning(matchedCount, len(terms), score, missingKeywords),
+		Sections:        sections,
+		Language:        jobLanguage.Code,
+		ResumeLanguage:  resumeLanguage.Code,
+	}
+}
+
+// buildReasoning explains the score in plain text, quoting the most important missing keywords.
+func buildReasoning(matched, total int, score int32, missing []string) string {
+	if total == 0 {
+		return "No keywords could be extracted from the job description, so the resume could not be scored."
+	}
+
+	reasoning := fmt.Sprintf("The resume matches %d of %d keywords extracted from the job description. Weighted by their importance and by where they appear in the resume, it scores %d%%.", matched, total, score)
+	if len(missing) == 0 {
+		return reasoning + " Every keyword was found."
+	}
+
+	quoted := missing
+	if len(quoted) > maxReasoningKeywords {
+		quoted = quoted[:maxReasoningKeywords]
+	}
+	reasoning += fmt.Sprintf(" Missing keywords include: %s", strings.Join(quoted, ", "))
+	if rest := len(missing) - len(quoted); rest > 0 {
+		reasoning += fmt.Sprintf(" and %d more", rest)
 	}
+	return reasoning + "."
 }
 
-func extractKeywords(text string) []string {
-	words := normalize(text)
+// Keywords returns the keywords Calculate looks for when text is used as a
+// job description, in order of first appearance.
+func Keywords(text string) []string {
 	var keywords []string
-	stopWords := map[string]bool{"and": true, "the": true, "for": true, "with": true, "this": true, "that": true}
+	for _, t := range weighTerms(text, detectLanguage(text)) {
+		keywords = append(keywords, t.surface)
+	}
+	return keywords
+}
 
-	for _, w := range words {
-		if len(w) > 3 && !stopWords[w] {
-			keywords = append(keywords, w)
+// isKeyword reports whether a single word can be a keyword: a technology
+// token, or a word longer than three letters that is not a stop word or a
+// number. Words are judged as they were written, so that stemming "uses"
+// to "use" does not make it too short to count.
+func isKeyword(w word) bool {
+	if isTechToken(w.surface) {
+		return true
+	}
+	if utf8.RuneCountInString(w.surface) <= 3 || w.stop {
+		return false
+	}
+	return strings.IndexFunc(w.surface, unicode.IsLetter) >= 0
+}
+
+// weighTerms extracts the keywords of a job description in order of first
+// appearance, each shown as it was first written. Phrases are kept when
+// they are well-known terms or repeated, and words are dropped when they
+// only occur inside a kept phrase. A keyword mentioned n times weighs
+// 1+ln(n), scaled up by positionBonus the earlier it is first mentioned.
+func weighTerms(text string, lang *language) []term {
+	clauses := normalize(text, lang)
+
+	// Start positions of every n-gram, counting tokens across clauses
+	occurrences := make(map[string][]int)
+	firsts := make(map[string][]word)
+	var bySize [maxPhraseWords + 1][]string
+	total := 0
+	for _, clause := range clauses {
+		for i := range clause {
+			for n := 1; n <= maxPhraseWords && i+n <= len(clause); n++ {
+				key := strings.Join(keys(clause[i:i+n]), " ")
+				if len(occurrences[key]) == 0 {
+					bySize[n] = append(bySize[n], key)
+					firsts[key] = clause[i : i+n]
+				}
+				occurrences[key] = append(occurrences[key], total+i)
+			}
 		}
+		total += len(clause)
+	}
+
+	type candidate struct {
+		term
+		first, size int
 	}
-	// Deduplicate
-	unique := make(map[string]bool)
-	var final []string
-	for _, k := range keywords {
-		if !unique[k] {
-			unique[k] = true
-			final = append(final, k)
+	var selected []candidate
+	covered := make([]bool, total)
+	for n := maxPhraseWords; n >= 1; n-- {
+		var spans [][2]int
+		for _, key := range bySize[n] {
+			if !isTerm(firsts[key], len(occurrences[key]), lang) {
+				continue
+			}
+
+			var uncovered []int
+			for _, pos := range occurrences[key] {
+				for i := pos; i < pos+n; i++ {
+					if !covered[i] {
+						uncovered = append(uncovered, pos)
+						break
+					}
+				}
+			}
+			if len(uncovered) == 0 {
+				continue
+			}
+
+			position := 1 - float64(uncovered[0])/float64(total)
+			weight := (1 + math.Log(float64(len(uncovered)))) * (1 + positionBonus*position)
+			selected = append(selected, candidate{term{key, surface(firsts[key]), weight}, uncovered[0], n})
+			for _, pos := range uncovered {
+				spans = append(spans, [2]int{pos, pos + n})
+			}
+		}
+		for _, span := range spans {
+			for i := span[0]; i < span[1]; i++ {
+				covered[i] = true
+			}
 		}
 	}
-	return final
+
+	sort.SliceStable(selected, func(i, j int) bool {
+		if selected[i].first != selected[j].first {
+			return selected[i].first < selected[j].first
+		}
+		return selected[i].size > selected[j].size
+	})
+	terms := make([]term, len(selected))
+	for i, c := range selected {
+		terms[i] = c.term
+	}
+	return terms
 }
 
-func normalize(text string) []string {
-	f := func(c rune) bool {
-		return !unicode.IsLetter(c) && !unicode.IsNumber(c)
+// isTerm reports whether an n-gram of a job description in lang occurring
+// count times is a keyword.
+func isTerm(words []word, count int, lang *language) bool {
+	if len(words) == 1 {
+		return isKeyword(words[0]) || activeTaxonomy.known(words[0].key)
+	}
+	if lang.phrases[strings.Join(keys(words), " ")] {
+		return true
+	}
+	if count < 2 {
+		return false
+	}
+	for _, w := range words {
+		if !isKeyword(w) {
+			return false
+		}
+	}
+	return true
+}
+
+// termSet returns every token and phrase of text, for matching keywords
+// against, along with every skill implied by the skills in it.
+func termSet(text string, lang *language) map[string]bool {
+	clauses := normalize(text, lang)
+	set := make(map[string]bool)
+	for _, clause := range clauses {
+		for _, w := range clause {
+			set[w.key] = true
+			for _, parent := range activeTaxonomy.ancestors(w.key) {
+				set[parent] = true
+			}
+		}
+	}
+	keyed := make([][]string, len(clauses))
+	for i, clause := range clauses {
+		keyed[i] = keys(clause)
+	}
+	for _, gram := range ngrams(keyed) {
+		set[gram] = true
 	}
-	return strings.Fields(strings.ToLower(strings.Join(strings.FieldsFunc(text, f), " ")))
+	return set
 }
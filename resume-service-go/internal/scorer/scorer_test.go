@@ -0,0 +1,200 @@
+package scorer
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	pb "github.com/iprotoresume/shared/proto"
+)
+
+// weakFeedback starts the feedback line about keywords matched only in
+// low-weighted sections.
+const weakFeedback = "matched keywords appear only in"
+
+func TestCalculate(t *testing.T) {
+	roles := func(descriptions ...string) []*pb.Experience {
+		var experience []*pb.Experience
+		for _, d := range descriptions {
+			experience = append(experience, &pb.Experience{Title: "Engineer", Description: d})
+		}
+		return experience
+	}
+
+	tests := []struct {
+		name        string
+		resume      *pb.ResumeData
+		jd          string
+		wantScore   int32
+		wantMissing []string
+		wantWeak    bool
+		wantMatches map[string][]string // matched keywords by section
+		wantReason  string              // substring of Reasoning
+	}{
+		{
+			name:        "every keyword under skills",
+			resume:      &pb.ResumeData{Skills: []string{"Go", "Kubernetes", "PostgreSQL"}},
+			jd:          "Go, Kubernetes, PostgreSQL",
+			wantScore:   100,
+			wantMatches: map[string][]string{SectionSkills: {"Go", "Kubernetes", "PostgreSQL"}},
+			wantReason:  "Every keyword was found.",
+		},
+		{
+			name:       "nothing to match",
+			resume:     &pb.ResumeData{Skills: []string{"Go"}},
+			jd:         "",
+			wantScore:  0,
+			wantReason: "No keywords could be extracted",
+		},
+		{
+			name:        "missing keywords by weight",
+			resume:      &pb.ResumeData{Skills: []string{"Go"}},
+			jd:          "Terraform\nKubernetes and Kubernetes",
+			wantScore:   0,
+			wantMissing: []string{"Kubernetes", "Terraform"},
+			wantReason:  "Missing keywords include: Kubernetes, Terraform.",
+		},
+		{
+			name:        "education counts half",
+			resume:      &pb.ResumeData{Education: []*pb.Education{{Degree: "Kubernetes course"}}},
+			jd:          "Kubernetes",
+			wantScore:   50,
+			wantWeak:    true,
+			wantMatches: map[string][]string{SectionEducation: {"Kubernetes"}},
+		},
+		{
+			name:        "achievements count half",
+			resume:      &pb.ResumeData{Achievements: []*pb.Achievement{{Title: "Kubernetes contributor"}}},
+			jd:          "Kubernetes",
+			wantScore:   50,
+			wantWeak:    true,
+			wantMatches: map[string][]string{SectionOther: {"Kubernetes"}},
+		},
+		{
+			name:        "certificates",
+			resume:      &pb.ResumeData{Certificates: []*pb.Certificate{{Name: "Certified Kubernetes Administrator"}}},
+			jd:          "Kubernetes",
+			wantScore:   70,
+			wantWeak:    true,
+			wantMatches: map[string][]string{SectionCertificates: {"Kubernetes"}},
+		},
+		{
+			name:        "second role",
+			resume:      &pb.ResumeData{Experience: roles("Rust", "Kubernetes")},
+			jd:          "Kubernetes",
+			wantScore:   85,
+			wantMatches: map[string][]string{SectionExperience: {"Kubernetes"}},
+		},
+		{
+			name:        "third role is weak",
+			resume:      &pb.ResumeData{Experience: roles("Rust", "Rust", "Kubernetes")},
+			jd:          "Kubernetes",
+			wantScore:   70,
+			wantWeak:    true,
+			wantMatches: map[string][]string{SectionExperience: {"Kubernetes"}},
+		},
+		{
+			name:        "best section wins",
+			resume:      &pb.ResumeData{Skills: []string{"Kubernetes"}, Education: []*pb.Education{{Degree: "Kubernetes course"}}},
+			jd:          "Kubernetes",
+			wantScore:   100,
+			wantMatches: map[string][]string{SectionSkills: {"Kubernetes"}, SectionEducation: {"Kubernetes"}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Calculate(tt.resume, tt.jd)
+			if got.Score != tt.wantScore {
+				t.Errorf("Score = %d, want %d", got.Score, tt.wantScore)
+			}
+			if !reflect.DeepEqual(got.MissingKeywords, tt.wantMissing) {
+				t.Errorf("MissingKeywords = %q, want %q", got.MissingKeywords, tt.wantMissing)
+			}
+			weak := false
+			for _, f := range got.Feedback {
+				weak = weak || strings.Contains(f, weakFeedback)
+			}
+			if weak != tt.wantWeak {
+				t.Errorf("weak match feedback = %v, want %v in %q", weak, tt.wantWeak, got.Feedback)
+			}
+			if !strings.Contains(got.Reasoning, tt.wantReason) {
+				t.Errorf("Reasoning = %q, want it to contain %q", got.Reasoning, tt.wantReason)
+			}
+
+			if len(got.Sections) != len(sectionOrder) {
+				t.Fatalf("got %d sections, want %d", len(got.Sections), len(sectionOrder))
+			}
+			for i, s := range got.Sections {
+				if s.Section != sectionOrder[i] {
+					t.Errorf("Sections[%d] = %s, want %s", i, s.Section, sectionOrder[i])
+				}
+				if want := tt.wantMatches[s.Section]; !reflect.DeepEqual(s.MatchedKeywords, want) {
+					t.Errorf("%s matched %q, want %q", s.Section, s.MatchedKeywords, want)
+				}
+			}
+		})
+	}
+}
+
+func TestWeighTerms(t *testing.T) {
+	tests := []struct {
+		name string
+		jd   string
+		want []string // surfaces in order of first appearance
+	}{
+		{
+			name: "stop words and short words dropped",
+			jd:   "We are looking for an engineer who knows Kubernetes.",
+			want: []string{"looking", "engineer", "knows", "Kubernetes"},
+		},
+		{
+			name: "repeated phrase kept, its words dropped",
+			jd:   "Payment platform team\nThe payment platform handles refunds",
+			want: []string{"payment platform", "team", "handles", "refunds"},
+		},
+		{
+			name: "unrepeated phrase split",
+			jd:   "Payment platform team",
+			want: []string{"payment", "platform", "team"},
+		},
+		{
+			name: "curated phrase kept once",
+			jd:   "Experience with distributed systems",
+			want: []string{"experience", "distributed systems"},
+		},
+		{
+			name: "phrases stop at punctuation",
+			jd:   "Kafka, Terraform. Kafka; Terraform",
+			want: []string{"Kafka", "Terraform"},
+		},
+	}
+	en := languages[0]
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got []string
+			for _, term := range weighTerms(tt.jd, en) {
+				got = append(got, term.surface)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("weighTerms() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestWeighTermsWeights(t *testing.T) {
+	weights := make(map[string]float64)
+	for _, term := range weighTerms("Terraform\nKafka\nKubernetes and Kubernetes and Kubernetes", languages[0]) {
+		weights[term.surface] = term.weight
+	}
+	if !(weights["Terraform"] > weights["Kafka"]) {
+		t.Errorf("earlier Terraform weighs %v, not more than Kafka's %v", weights["Terraform"], weights["Kafka"])
+	}
+	if !(weights["Kubernetes"] > weights["Terraform"]) {
+		t.Errorf("repeated Kubernetes weighs %v, not more than Terraform's %v", weights["Kubernetes"], weights["Terraform"])
+	}
+	// First of 7 tokens, mentioned once: 1 * (1 + positionBonus)
+	if want := 1 + positionBonus; weights["Terraform"] != want {
+		t.Errorf("Terraform weighs %v, want %v", weights["Terraform"], want)
+	}
+}
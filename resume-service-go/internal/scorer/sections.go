@@ -0,0 +1,99 @@
+package scorer
+
+import (
+	"strings"
+
+	pb "github.com/iprotoresume/shared/proto"
+)
+
+// Section names, in the order they are reported.
+const (
+	SectionSkills       = "skills"
+	SectionExperience   = "experience"
+	SectionSummary      = "summary"
+	SectionProjects     = "projects"
+	SectionCertificates = "certificates"
+	SectionEducation    = "education"
+	SectionOther        = "other"
+)
+
+var sectionOrder = []string{
+	SectionSkills,
+	SectionExperience,
+	SectionSummary,
+	SectionProjects,
+	SectionCertificates,
+	SectionEducation,
+	SectionOther,
+}
+
+// Weights of a keyword match by where it occurs. Experience entries decay
+// from experienceWeight for the first (most recent) role by
+// experienceDecay per role, down to minExperienceWeight.
+const (
+	skillsWeight        = 1.0
+	experienceWeight    = 1.0
+	experienceDecay     = 0.15
+	minExperienceWeight = 0.6
+	summaryWeight       = 0.8
+	projectsWeight      = 0.8
+	certificatesWeight  = 0.7
+	educationWeight     = 0.5
+	otherWeight         = 0.5
+)
+
+// part is a piece of resume text belonging to a section, with the weight of
+// keywords matched in it.
+type part struct {
+	section string
+	weight  float64
+	text    string
+}
+
+// parts splits the text an ATS would read into weighted sections. Contact
+// details and the profile image are skipped. Experience is assumed to be
+// listed most recent first, as resumes conventionally are.
+func parts(r *pb.ResumeData) []part {
+	skills := append([]string{}, r.Skills...)
+	for _, sg := range r.SkillGroups {
+		skills = append(skills, sg.Category)
+		skills = append(skills, sg.Items...)
+	}
+	result := []part{
+		{SectionSkills, skillsWeight, strings.Join(skills, "\n")},
+		{SectionSummary, summaryWeight, r.JobTitle + "\n" + r.Summary},
+	}
+
+	for i, e := range r.Experience {
+		weight := experienceWeight - experienceDecay*float64(i)
+		if weight < minExperienceWeight {
+			weight = minExperienceWeight
+		}
+		result = append(result, part{SectionExperience, weight, strings.Join([]string{e.Title, e.Company, e.Description}, "\n")})
+	}
+
+	var projects, certificates, education, other []string
+	for _, p := range r.Projects {
+		projects = append(projects, p.Title, p.Description)
+		projects = append(projects, p.TechStack...)
+	}
+	for _, c := range r.Certificates {
+		certificates = append(certificates, c.Name, c.Issuer)
+	}
+	for _, e := range r.Education {
+		education = append(education, e.Degree, e.Institution)
+	}
+	for _, l := range r.Languages {
+		other = append(other, l.Language)
+	}
+	for _, a := range r.Achievements {
+		other = append(other, a.Title, a.Description)
+	}
+
+	return append(result,
+		part{SectionProjects, projectsWeight, strings.Join(projects, "\n")},
+		part{SectionCertificates, certificatesWeight, strings.Join(certificates, "\n")},
+		part{SectionEducation, educationWeight, strings.Join(education, "\n")},
+		part{SectionOther, otherWeight, strings.Join(other, "\n")},
+	)
+}
@@ -0,0 +1,51 @@
+package scorer
+
+import "strings"
+
+// word is a normalized token: key is what keywords are matched on and
+// surface is how the token was written, for showing to people.
+type word struct {
+	key, surface string
+	stop         bool // a stop word of the text's language
+}
+
+// normalize splits text in the given language into clauses of normalized
+// words, see tokenize. Skills the taxonomy knows become a single word keyed
+// and shown by the skill, and other plain words are keyed by their stem so
+// that "deploying" matches "deployment".
+func normalize(text string, lang *language) [][]word {
+	clauses := activeTaxonomy.canonicalize(tokenize(text))
+	words := make([][]word, len(clauses))
+	for i, clause := range clauses {
+		words[i] = make([]word, len(clause))
+		for j, token := range clause {
+			words[i][j] = lang.normalizeToken(token)
+		}
+	}
+	return words
+}
+
+func (l *language) normalizeToken(token string) word {
+	if activeTaxonomy.known(token) {
+		return word{key: token, surface: activeTaxonomy.display(token)}
+	}
+	return word{key: l.stem(token), surface: token, stop: l.stopWords[token]}
+}
+
+// keys returns the key of each word.
+func keys(words []word) []string {
+	keys := make([]string, len(words))
+	for i, w := range words {
+		keys[i] = w.key
+	}
+	return keys
+}
+
+// surface joins the surface forms of words with spaces.
+func surface(words []word) string {
+	forms := make([]string, len(words))
+	for i, w := range words {
+		forms[i] = w.surface
+	}
+	return strings.Join(forms, " ")
+}
@@ -0,0 +1,62 @@
+package scorer
+
+import (
+	"reflect"
+	"testing"
+
+	pb "github.com/iprotoresume/shared/proto"
+)
+
+func TestStem(t *testing.T) {
+	en := languages[0]
+	tests := []struct {
+		token, want string
+	}{
+		{"deploying", "deploy"},
+		{"deployment", "deploy"},
+		{"deployments", "deploy"},
+		{"leadership", "leadership"},
+		{"c++", "c++"},
+		{"node.js", "node.js"},
+		{"k8s", "k8s"},
+		{"aws", "aws"},
+		{"3d", "3d"},
+	}
+	for _, tt := range tests {
+		if got := en.stem(tt.token); got != tt.want {
+			t.Errorf("stem(%q) = %q, want %q", tt.token, got, tt.want)
+		}
+	}
+}
+
+func TestNormalizeKeepsSurface(t *testing.T) {
+	got := normalize("Deploying servers, Postgres", languages[0])
+	want := [][]word{
+		{{key: "deploy", surface: "deploying"}, {key: "server", surface: "servers"}},
+		{{key: "postgresql", surface: "PostgreSQL"}},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("normalize() = %+v, want %+v", got, want)
+	}
+}
+
+func TestCalculateMatchesStems(t *testing.T) {
+	tests := []struct {
+		name        string
+		summary     string
+		jd          string
+		wantMissing []string
+	}{
+		{"inflections match", "Deploying services and mentoring engineers", "Deployment of services. Mentorship of engineers.", []string{"mentorship"}},
+		{"missing keywords as written", "Backend work", "Scalable deployments", []string{"scalable", "deployments"}},
+		{"phrases match by stem", "Built a scalable payments platform", "payment platforms\nOur payment platforms", nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Calculate(&pb.ResumeData{Summary: tt.summary}, tt.jd)
+			if !reflect.DeepEqual(got.MissingKeywords, tt.wantMissing) {
+				t.Errorf("MissingKeywords = %q, want %q", got.MissingKeywords, tt.wantMissing)
+			}
+		})
+	}
+}
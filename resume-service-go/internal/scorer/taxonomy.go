@@ -0,0 +1,194 @@
+package scorer
+
+import (
+	_ "embed"
+	"fmt"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+//go:embed taxonomy.yaml
+var defaultTaxonomy []byte
+
+// activeTaxonomy is used by Calculate, Keywords and NormalizeSkills.
+var activeTaxonomy = mustParseTaxonomy(defaultTaxonomy)
+
+// Skill is an entry of a taxonomy file.
+type Skill struct {
+	Name    string   `yaml:"name"`
+	Aliases []string `yaml:"aliases"`
+	Parents []string `yaml:"parents"`
+}
+
+// Taxonomy knows the canonical name of skills written in different ways
+// ("Postgres" is PostgreSQL) and which skills imply others (React implies
+// JavaScript).
+type Taxonomy struct {
+	keys     map[string]string   // normalized name or alias -> key of the skill
+	names    map[string]string   // key -> canonical name
+	parents  map[string][]string // key -> keys of parents
+	maxWords int                 // tokens in the longest name or alias
+}
+
+// ParseTaxonomy reads a taxonomy in the format of the embedded taxonomy.yaml.
+// Names and aliases are compared after tokenizing, so case and punctuation
+// such as "React.js" versus "react js" do not matter.
+func ParseTaxonomy(data []byte) (*Taxonomy, error) {
+	var file struct {
+		Skills []Skill `yaml:"skills"`
+	}
+	if err := yaml.Unmarshal(data, &file); err != nil {
+		return nil, fmt.Errorf("parse taxonomy: %w", err)
+	}
+
+	t := &Taxonomy{
+		keys:    make(map[string]string),
+		names:   make(map[string]string),
+		parents: make(map[string][]string),
+	}
+	for _, skill := range file.Skills {
+		key := keyOf(skill.Name)
+		if key == "" {
+			return nil, fmt.Errorf("taxonomy skill %q has no name", skill.Name)
+		}
+		if _, ok := t.names[key]; ok {
+			return nil, fmt.Errorf("taxonomy skill %q is defined twice", skill.Name)
+		}
+		t.names[key] = skill.Name
+
+		for _, name := range append([]string{skill.Name}, skill.Aliases...) {
+			alias, words := taxonomyKey(name)
+			if alias == "" {
+				return nil, fmt.Errorf("taxonomy skill %q has an empty alias", skill.Name)
+			}
+			if other, ok := t.keys[alias]; ok && other != key {
+				return nil, fmt.Errorf("taxonomy alias %q of %q already names %q", name, skill.Name, t.names[other])
+			}
+			t.keys[alias] = key
+			t.maxWords = max(t.maxWords, words)
+		}
+	}
+
+	for _, skill := range file.Skills {
+		key := keyOf(skill.Name)
+		for _, parent := range skill.Parents {
+			parentKey, ok := t.keys[keyOf(parent)]
+			if !ok {
+				return nil, fmt.Errorf("taxonomy skill %q has unknown parent %q", skill.Name, parent)
+			}
+			t.parents[key] = append(t.parents[key], parentKey)
+		}
+	}
+	return t, nil
+}
+
+func mustParseTaxonomy(data []byte) *Taxonomy {
+	t, err := ParseTaxonomy(data)
+	if err != nil {
+		panic(err)
+	}
+	return t
+}
+
+// SetTaxonomy replaces the embedded taxonomy. It must be called before any
+// scoring starts.
+func SetTaxonomy(t *Taxonomy) {
+	activeTaxonomy = t
+}
+
+// NormalizeSkills normalizes items with the active taxonomy, see
+// Taxonomy.NormalizeSkills.
+func NormalizeSkills(items []string) []string {
+	return activeTaxonomy.NormalizeSkills(items)
+}
+
+// NormalizeSkills replaces skills the taxonomy knows with their canonical
+// names and drops duplicates, keeping the order of the rest.
+func (t *Taxonomy) NormalizeSkills(items []string) []string {
+	seen := make(map[string]bool)
+	var normalized []string
+	for _, item := range items {
+		item = strings.TrimSpace(item)
+		if key, ok := t.keys[keyOf(item)]; ok {
+			item = t.names[key]
+		}
+		if item == "" || seen[strings.ToLower(item)] {
+			continue
+		}
+		seen[strings.ToLower(item)] = true
+		normalized = append(normalized, item)
+	}
+	return normalized
+}
+
+// canonicalize replaces every name or alias in the clauses with the key of
+// its skill, as a single token. Longer aliases win over shorter ones.
+func (t *Taxonomy) canonicalize(clauses [][]string) [][]string {
+	result := make([][]string, len(clauses))
+	for c, clause := range clauses {
+		for i := 0; i < len(clause); {
+			n := min(t.maxWords, len(clause)-i)
+			for ; n > 0; n-- {
+				if key, ok := t.keys[strings.Join(clause[i:i+n], " ")]; ok {
+					result[c] = append(result[c], key)
+					break
+				}
+			}
+			if n == 0 {
+				result[c] = append(result[c], clause[i])
+				n = 1
+			}
+			i += n
+		}
+	}
+	return result
+}
+
+// known reports whether key is the key of a skill.
+func (t *Taxonomy) known(key string) bool {
+	_, ok := t.names[key]
+	return ok
+}
+
+// ancestors returns the keys of every skill that key implies.
+func (t *Taxonomy) ancestors(key string) []string {
+	var result []string
+	seen := map[string]bool{key: true}
+	queue := t.parents[key]
+	for len(queue) > 0 {
+		parent := queue[0]
+		queue = queue[1:]
+		if seen[parent] {
+			continue
+		}
+		seen[parent] = true
+		result = append(result, parent)
+		queue = append(queue, t.parents[parent]...)
+	}
+	return result
+}
+
+// display returns the canonical name of a skill key, or key itself.
+func (t *Taxonomy) display(key string) string {
+	if name, ok := t.names[key]; ok {
+		return name
+	}
+	return key
+}
+
+// taxonomyKey tokenizes a skill name or alias, returning the tokens joined
+// by spaces and how many there are.
+func taxonomyKey(name string) (string, int) {
+	var tokens []string
+	for _, clause := range tokenize(name) {
+		tokens = append(tokens, clause...)
+	}
+	return strings.Join(tokens, " "), len(tokens)
+}
+
+// keyOf is taxonomyKey without the token count.
+func keyOf(name string) string {
+	key, _ := taxonomyKey(name)
+	return key
+}
@@ -0,0 +1,86 @@
+package scorer
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	pb "github.com/iprotoresume/shared/proto"
+)
+
+func TestParseTaxonomyErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		yaml string
+		want string // substring of the error
+	}{
+		{"not YAML", "skills: [", "parse taxonomy"},
+		{"no name", "skills:\n  - aliases: [x]", "has no name"},
+		{"punctuation only name", "skills:\n  - name: '--'", "has no name"},
+		{"defined twice", "skills:\n  - name: Rust\n  - name: rust", "defined twice"},
+		{"empty alias", "skills:\n  - name: Go\n    aliases: ['']", "empty alias"},
+		{"punctuation only alias", "skills:\n  - name: Go\n    aliases: ['/']", "empty alias"},
+		{"alias of another skill", "skills:\n  - name: Rust\n  - name: Cargo\n    aliases: [RUST]", `already names "Rust"`},
+		{"unknown parent", "skills:\n  - name: React\n    parents: [JavaScript]", "unknown parent"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ParseTaxonomy([]byte(tt.yaml))
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("ParseTaxonomy() error = %v, want it to contain %q", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestNormalizeSkills(t *testing.T) {
+	got := NormalizeSkills([]string{"postgres", " ReactJS ", "PostgreSQL", "Amazon Web Services", "Haskell", "haskell", ""})
+	want := []string{"PostgreSQL", "React", "AWS", "Haskell"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("NormalizeSkills() = %q, want %q", got, want)
+	}
+}
+
+func TestCalculateThroughTaxonomy(t *testing.T) {
+	tests := []struct {
+		name        string
+		skills      []string
+		jd          string
+		wantMissing []string
+	}{
+		{"alias matches the skill", []string{"Postgres"}, "PostgreSQL", nil},
+		{"skill matches an alias", []string{"PostgreSQL"}, "Postgres", nil},
+		{"multi-word alias", []string{"Amazon Web Services"}, "AWS", nil},
+		{"child implies parent", []string{"React"}, "JavaScript", nil},
+		{"grandchild implies grandparent", []string{"React Native"}, "JavaScript", nil},
+		{"parent does not imply child", []string{"JavaScript"}, "React", []string{"React"}},
+		{"longest alias wins", []string{"React"}, "React Native", []string{"React Native"}},
+		{"keyword shown by canonical name", nil, "Experience with k8s", []string{"experience", "Kubernetes"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Calculate(&pb.ResumeData{Skills: tt.skills}, tt.jd)
+			if !reflect.DeepEqual(got.MissingKeywords, tt.wantMissing) {
+				t.Errorf("MissingKeywords = %q, want %q", got.MissingKeywords, tt.wantMissing)
+			}
+		})
+	}
+}
+
+func TestAncestors(t *testing.T) {
+	taxonomy, err := ParseTaxonomy([]byte(`skills:
+  - name: A
+    parents: [B, C]
+  - name: B
+    parents: [C]
+  - name: C
+    parents: [A]
+`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Cycles and diamonds list each ancestor once
+	if got, want := taxonomy.ancestors("a"), []string{"b", "c"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("ancestors(a) = %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,157 @@
+package scorer
+
+import (
+	"strings"
+	"unicode"
+)
+
+// techTokens are terms whose punctuation is part of the name and must not
+// be split off.
+var techTokens = map[string]bool{
+	"c++": true, "c#": true, "f#": true, ".net": true, "asp.net": true, "vb.net": true,
+	"node.js": true, "next.js": true, "nuxt.js": true, "vue.js": true, "react.js": true,
+	"express.js": true, "d3.js": true, "three.js": true, "ci/cd": true, "tcp/ip": true,
+	"ui/ux": true, "a/b": true, "pl/sql": true, "t-sql": true, "objective-c": true,
+	"scikit-learn": true, "ember.js": true, "angular.js": true, "socket.io": true,
+}
+
+// shortTerms are keywords of three letters or fewer. Other words that short
+// are too often filler to count as keywords.
+var shortTerms = map[string]bool{
+	"ai": true, "api": true, "aks": true, "aws": true, "bi": true, "cli": true, "crm": true,
+	"css": true, "dns": true, "eks": true, "erp": true, "etl": true, "gcp": true, "gke": true,
+	"git": true, "gpu": true, "iam": true, "ios": true, "iot": true, "jvm": true, "jwt": true,
+	"llm": true, "ml": true, "nlp": true, "npm": true, "orm": true, "php": true, "qa": true,
+	"rds": true, "sdk": true, "seo": true, "sql": true, "sre": true, "tdd": true, "ui": true,
+	"ux": true, "vpc": true, "vue": true, "xml": true, "ci": true, "cd": true, "hr": true,
+	"sap": true, "ssh": true, "tls": true, "ssl": true, "vm": true, "vms": true, "lan": true,
+}
+
+// phrases are multi-word terms kept as one keyword wherever they occur in a
+// job description. Other phrases are only kept when repeated.
+var phrases = map[string]bool{
+	"machine learning": true, "deep learning": true, "computer vision": true,
+	"natural language processing": true, "data science": true, "data engineering": true,
+	"data analysis": true, "data structures": true, "distributed systems": true,
+	"big data": true, "cloud computing": true, "unit testing": true,
+	"integration testing": true, "continuous integration": true,
+	"continuous delivery": true, "continuous deployment": true, "version control": true,
+	"project management": true, "product management": true, "test driven development": true,
+	"object oriented": true, "event driven": true, "site reliability": true,
+	"infrastructure as code": true, "front end": true, "back end": true, "full stack": true,
+	"ruby on rails": true, "react native": true, "spring boot": true, "system design": true,
+	"user experience": true, "user research": true, "neural networks": true,
+	"reinforcement learning": true, "software architecture": true, "message queues": true,
+}
+
+// maxPhraseWords is the length of the longest phrases extracted.
+const maxPhraseWords = 3
+
+// tokenize splits text into clauses of lower-cased tokens. Phrases never
+// span clauses, which end at line breaks and punctuation such as commas or
+// a full stop. Technology tokens such as "C++", "Node.js" and "CI/CD" stay
+// intact, other tokens are split at punctuation ("front-end" becomes
+// "front" and "end"), and the language "Go" becomes "golang" so that it is
+// not confused with the verb.
+func tokenize(text string) [][]string {
+	var clauses [][]string
+	var clause []string
+	var raw strings.Builder
+
+	endToken := func() {
+		if raw.Len() > 0 {
+			clause = append(clause, splitToken(raw.String())...)
+			raw.Reset()
+		}
+	}
+	endClause := func() {
+		endToken()
+		if len(clause) > 0 {
+			clauses = append(clauses, clause)
+			clause = nil
+		}
+	}
+
+	runes := []rune(text)
+	for i, r := range runes {
+		switch {
+		case unicode.IsLetter(r) || unicode.IsNumber(r):
+			raw.WriteRune(r)
+		case r == '.':
+			// Part of a token only between letters or digits ("node.js")
+			// or before them at its start (".net"); otherwise a full stop.
+			if i+1 < len(runes) && isWordRune(runes[i+1]) && (raw.Len() == 0 || isWordRune(runes[i-1])) {
+				raw.WriteRune(r)
+			} else {
+				endClause()
+			}
+		case strings.ContainsRune("+#/-'’", r):
+			if raw.Len() > 0 || r != '\'' && r != '’' {
+				raw.WriteRune(r)
+			}
+		case r == '\n':
+			endClause()
+		case unicode.IsSpace(r):
+			endToken()
+		default:
+			endClause()
+		}
+	}
+	endClause()
+	return clauses
+}
+
+// splitToken turns one whitespace-delimited token into normalized tokens.
+func splitToken(raw string) []string {
+	lower := strings.ToLower(strings.TrimRight(raw, "-/'’"))
+	if techTokens[lower] || strings.HasSuffix(lower, ".js") && len(lower) > len(".js") {
+		return []string{lower}
+	}
+
+	var tokens []string
+	for _, part := range strings.FieldsFunc(raw, func(r rune) bool { return !isWordRune(r) && r != '+' && r != '#' }) {
+		// "C++" and "C#" are caught above; stray symbols elsewhere are noise
+		part = strings.TrimRight(part, "+#")
+		if part == "" {
+			continue
+		}
+		if part == "Go" || part == "GO" {
+			part = "golang"
+		}
+		tokens = append(tokens, strings.ToLower(part))
+	}
+	return tokens
+}
+
+func isWordRune(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsNumber(r)
+}
+
+// isTechToken reports whether a token is a technology name regardless of
+// its length: an allowlisted term or one mixing letters with digits ("s3",
+// "ec2", "k8s") or symbols ("c++", "node.js").
+func isTechToken(token string) bool {
+	if techTokens[token] || shortTerms[token] || strings.ContainsAny(token, "+#./") {
+		return true
+	}
+	hasLetter, hasDigit := false, false
+	for _, r := range token {
+		hasLetter = hasLetter || unicode.IsLetter(r)
+		hasDigit = hasDigit || unicode.IsDigit(r)
+	}
+	return hasLetter && hasDigit
+}
+
+// ngrams returns every phrase of 2 to maxPhraseWords tokens in the clauses,
+// joined with spaces.
+func ngrams(clauses [][]string) []string {
+	var grams []string
+	for _, clause := range clauses {
+		for n := 2; n <= maxPhraseWords; n++ {
+			for i := 0; i+n <= len(clause); i++ {
+				grams = append(grams, strings.Join(clause[i:i+n], " "))
+			}
+		}
+	}
+	return grams
+}
@@ -0,0 +1,61 @@
+package scorer
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestTokenize(t *testing.T) {
+	tests := []struct {
+		text string
+		want [][]string
+	}{
+		{"Experience with C++ and C#", [][]string{{"experience", "with", "c++", "and", "c#"}}},
+		{"Own our CI/CD pipelines", [][]string{{"own", "our", "ci/cd", "pipelines"}}},
+		{"Node.js, Vue.js and .NET", [][]string{{"node.js"}, {"vue.js", "and", ".net"}}},
+		{"Any Foo.js library", [][]string{{"any", "foo.js", "library"}}},
+		{"Write Go. We go fast", [][]string{{"write", "golang"}, {"we", "go", "fast"}}},
+		{"GO developers", [][]string{{"golang", "developers"}}},
+		{"Front-end and back-end work", [][]string{{"front", "end", "and", "back", "end", "work"}}},
+		{"Kubernetes (k8s) on EC2", [][]string{{"kubernetes"}, {"k8s"}, {"on", "ec2"}}},
+		{"The team's tools", [][]string{{"the", "team", "s", "tools"}}},
+		{"Python\nSQL;Spark", [][]string{{"python"}, {"sql"}, {"spark"}}},
+		{"UI/UX, A/B tests and client/server apps", [][]string{{"ui/ux"}, {"a/b", "tests", "and", "client", "server", "apps"}}},
+		{"Lisp+ and C++/CLI", [][]string{{"lisp", "and", "c", "cli"}}},
+		{"", nil},
+	}
+	for _, tt := range tests {
+		if got := tokenize(tt.text); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("tokenize(%q) = %q, want %q", tt.text, got, tt.want)
+		}
+	}
+}
+
+func TestIsTechToken(t *testing.T) {
+	tests := []struct {
+		token string
+		want  bool
+	}{
+		{"c++", true},
+		{"ci/cd", true},
+		{"node.js", true},
+		{"k8s", true},
+		{"aws", true},
+		{"golang", false},
+		{"the", false},
+		{"2020", false},
+	}
+	for _, tt := range tests {
+		if got := isTechToken(tt.token); got != tt.want {
+			t.Errorf("isTechToken(%q) = %v, want %v", tt.token, got, tt.want)
+		}
+	}
+}
+
+func TestKeywordsKeepTechTerms(t *testing.T) {
+	got := Keywords("We use C++, CI/CD, Node.js and Go.")
+	want := []string{"C++", "CI/CD", "Node.js", "Go"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Keywords() = %q, want %q", got, want)
+	}
+}
@@ -79,6 +79,9 @@ type ATSScore struct {
 	Feedback        []string               `protobuf:"bytes,2,rep,name=feedback,proto3" json:"feedback,omitempty"`
 	MissingKeywords []string               `protobuf:"bytes,3,rep,name=missing_keywords,json=missingKeywords,proto3" json:"missing_keywords,omitempty"`
 	Reasoning       string                 `protobuf:"bytes,4,opt,name=reasoning,proto3" json:"reasoning,omitempty"`
+	Sections        []*SectionScore        `protobuf:"bytes,5,rep,name=sections,proto3" json:"sections,omitempty"`                                   // set by the heuristic scorer only
+	Language        string                 `protobuf:"bytes,6,opt,name=language,proto3" json:"language,omitempty"`                                   // ISO 639-1 code of the job description's language, set by the heuristic scorer only
+	ResumeLanguage  string                 `protobuf:"bytes,7,opt,name=resume_language,json=resumeLanguage,proto3" json:"resume_language,omitempty"` // ISO 639-1 code of the resume's language, set by the heuristic scorer only
 	unknownFields   protoimpl.UnknownFields
 	sizeCache       protoimpl.SizeCache
 }
@@ -141,6 +144,88 @@ func (x *ATSScore) GetReasoning() string {
 	return ""
 }
 
+func (x *ATSScore) GetSections() []*SectionScore {
+	if x != nil {
+		return x.Sections
+	}
+	return nil
+}
+
+func (x *ATSScore) GetLanguage() string {
+	if x != nil {
+		return x.Language
+	}
+	return ""
+}
+
+func (x *ATSScore) GetResumeLanguage() string {
+	if x != nil {
+		return x.ResumeLanguage
+	}
+	return ""
+}
+
+// SectionScore is how much of the job description one resume section covers.
+type SectionScore struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Section         string                 `protobuf:"bytes,1,opt,name=section,proto3" json:"section,omitempty"` // skills, experience, summary, projects, certificates, education or other
+	Score           int32                  `protobuf:"varint,2,opt,name=score,proto3" json:"score,omitempty"`    // 0-100, weighted share of job description keywords found in the section
+	MatchedKeywords []string               `protobuf:"bytes,3,rep,name=matched_keywords,json=matchedKeywords,proto3" json:"matched_keywords,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *SectionScore) Reset() {
+	*x = SectionScore{}
+	mi := &file_shared_proto_ats_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SectionScore) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SectionScore) ProtoMessage() {}
+
+func (x *SectionScore) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_ats_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SectionScore.ProtoReflect.Descriptor instead.
+func (*SectionScore) Descriptor() ([]byte, []int) {
+	return file_shared_proto_ats_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *SectionScore) GetSection() string {
+	if x != nil {
+		return x.Section
+	}
+	return ""
+}
+
+func (x *SectionScore) GetScore() int32 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *SectionScore) GetMatchedKeywords() []string {
+	if x != nil {
+		return x.MatchedKeywords
+	}
+	return nil
+}
+
 var File_shared_proto_ats_proto protoreflect.FileDescriptor
 
 const file_shared_proto_ats_proto_rawDesc = "" +
@@ -148,12 +233,19 @@ const file_shared_proto_ats_proto_rawDesc = "" +
 	"\x16shared/proto/ats.proto\x12\x03ats\x1a\x19shared/proto/resume.proto\"h\n" +
 	"\x11ValidationRequest\x12*\n" +
 	"\x06resume\x18\x01 \x01(\v2\x12.resume.ResumeDataR\x06resume\x12'\n" +
-	"\x0fjob_description\x18\x02 \x01(\tR\x0ejobDescription\"\x85\x01\n" +
+	"\x0fjob_description\x18\x02 \x01(\tR\x0ejobDescription\"\xf9\x01\n" +
 	"\bATSScore\x12\x14\n" +
 	"\x05score\x18\x01 \x01(\x05R\x05score\x12\x1a\n" +
 	"\bfeedback\x18\x02 \x03(\tR\bfeedback\x12)\n" +
 	"\x10missing_keywords\x18\x03 \x03(\tR\x0fmissingKeywords\x12\x1c\n" +
-	"\treasoning\x18\x04 \x01(\tR\treasoning2E\n" +
+	"\treasoning\x18\x04 \x01(\tR\treasoning\x12-\n" +
+	"\bsections\x18\x05 \x03(\v2\x11.ats.SectionScoreR\bsections\x12\x1a\n" +
+	"\blanguage\x18\x06 \x01(\tR\blanguage\x12'\n" +
+	"\x0fresume_language\x18\a \x01(\tR\x0eresumeLanguage\"i\n" +
+	"\fSectionScore\x12\x18\n" +
+	"\asection\x18\x01 \x01(\tR\asection\x12\x14\n" +
+	"\x05score\x18\x02 \x01(\x05R\x05score\x12)\n" +
+	"\x10matched_keywords\x18\x03 \x03(\tR\x0fmatchedKeywords2E\n" +
 	"\n" +
 	"ATSService\x127\n" +
 	"\x0eValidateResume\x12\x16.ats.ValidationRequest\x1a\r.ats.ATSScoreB&Z$github.com/iprotoresume/shared/protob\x06proto3"
@@ -170,21 +262,23 @@ func file_shared_proto_ats_proto_rawDescGZIP() []byte {
 	return file_shared_proto_ats_proto_rawDescData
 }
 
-var file_shared_proto_ats_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_shared_proto_ats_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
 var file_shared_proto_ats_proto_goTypes = []any{
 	(*ValidationRequest)(nil), // 0: ats.ValidationRequest
 	(*ATSScore)(nil),          // 1: ats.ATSScore
-	(*ResumeData)(nil),        // 2: resume.ResumeData
+	(*SectionScore)(nil),      // 2: ats.SectionScore
+	(*ResumeData)(nil),        // 3: resume.ResumeData
 }
 var file_shared_proto_ats_proto_depIdxs = []int32{
-	2, // 0: ats.ValidationRequest.resume:type_name -> resume.ResumeData
-	0, // 1: ats.ATSService.ValidateResume:input_type -> ats.ValidationRequest
-	1, // 2: ats.ATSService.ValidateResume:output_type -> ats.ATSScore
-	2, // [2:3] is the sub-list for method output_type
-	1, // [1:2] is the sub-list for method input_type
-	1, // [1:1] is the sub-list for extension type_name
-	1, // [1:1] is the sub-list for extension extendee
-	0, // [0:1] is the sub-list for field type_name
+	3, // 0: ats.ValidationRequest.resume:type_name -> resume.ResumeData
+	2, // 1: ats.ATSScore.sections:type_name -> ats.SectionScore
+	0, // 2: ats.ATSService.ValidateResume:input_type -> ats.ValidationRequest
+	1, // 3: ats.ATSService.ValidateResume:output_type -> ats.ATSScore
+	3, // [3:4] is the sub-list for method output_type
+	2, // [2:3] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
 }
 
 func init() { file_shared_proto_ats_proto_init() }
@@ -199,7 +293,7 @@ func file_shared_proto_ats_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_shared_proto_ats_proto_rawDesc), len(file_shared_proto_ats_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   2,
+			NumMessages:   3,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
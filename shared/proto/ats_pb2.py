@@ -25,7 +25,7 @@ _sym_db = _symbol_database.Default()
 from shared.proto import resume_pb2 as shared_dot_proto_dot_resume__pb2
 
 
-DESCRIPTOR = _descriptor_pool.Default().AddSerializedFile(b'\n\x16shared/proto/ats.proto\x12\x03\x61ts\x1a\x19shared/proto/resume.proto\"P\n\x11ValidationRequest\x12\"\n\x06resume\x18\x01 \x01(\x0b\x32\x12.resume.ResumeData\x12\x17\n\x0fjob_description\x18\x02 \x01(\t\"X\n\x08\x41TSScore\x12\r\n\x05score\x18\x01 \x01(\x05\x12\x10\n\x08\x66\x65\x65\x64\x62\x61\x63k\x18\x02 \x03(\t\x12\x18\n\x10missing_keywords\x18\x03 \x03(\t\x12\x11\n\treasoning\x18\x04 \x01(\t2E\n\nATSService\x12\x37\n\x0eValidateResume\x12\x16.ats.ValidationRequest\x1a\r.ats.ATSScoreB&Z$github.com/iprotoresume/shared/protob\x06proto3')
+DESCRIPTOR = _descriptor_pool.Default().AddSerializedFile(b'\n\x16shared/proto/ats.proto\x12\x03\x61ts\x1a\x19shared/proto/resume.proto\"P\n\x11ValidationRequest\x12\"\n\x06resume\x18\x01 \x01(\x0b\x32\x12.resume.ResumeData\x12\x17\n\x0fjob_description\x18\x02 \x01(\t\"\xa8\x01\n\x08\x41TSScore\x12\r\n\x05score\x18\x01 \x01(\x05\x12\x10\n\x08\x66\x65\x65\x64\x62\x61\x63k\x18\x02 \x03(\t\x12\x18\n\x10missing_keywords\x18\x03 \x03(\t\x12\x11\n\treasoning\x18\x04 \x01(\t\x12#\n\x08sections\x18\x05 \x03(\x0b\x32\x11.ats.SectionScore\x12\x10\n\x08language\x18\x06 \x01(\t\x12\x17\n\x0fresume_language\x18\x07 \x01(\t\"H\n\x0cSectionScore\x12\x0f\n\x07section\x18\x01 \x01(\t\x12\r\n\x05score\x18\x02 \x01(\x05\x12\x18\n\x10matched_keywords\x18\x03 \x03(\t2E\n\nATSService\x12\x37\n\x0eValidateResume\x12\x16.ats.ValidationRequest\x1a\r.ats.ATSScoreB&Z$github.com/iprotoresume/shared/protob\x06proto3')
 
 _globals = globals()
 _builder.BuildMessageAndEnumDescriptors(DESCRIPTOR, _globals)
@@ -35,8 +35,10 @@ if not _descriptor._USE_C_DESCRIPTORS:
   _globals['DESCRIPTOR']._serialized_options = b'Z$github.com/iprotoresume/shared/proto'
   _globals['_VALIDATIONREQUEST']._serialized_start=58
   _globals['_VALIDATIONREQUEST']._serialized_end=138
-  _globals['_ATSSCORE']._serialized_start=140
-  _globals['_ATSSCORE']._serialized_end=228
-  _globals['_ATSSERVICE']._serialized_start=230
-  _globals['_ATSSERVICE']._serialized_end=299
+  _globals['_ATSSCORE']._serialized_start=141
+  _globals['_ATSSCORE']._serialized_end=309
+  _globals['_SECTIONSCORE']._serialized_start=311
+  _globals['_SECTIONSCORE']._serialized_end=383
+  _globals['_ATSSERVICE']._serialized_start=385
+  _globals['_ATSSERVICE']._serialized_end=454
 # @@protoc_insertion_point(module_scope)
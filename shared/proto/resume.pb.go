@@ -21,6 +21,333 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type ResumeSortField int32
+
+const (
+	ResumeSortField_RESUME_SORT_FIELD_UNSPECIFIED ResumeSortField = 0 // Defaults to created_at
+	ResumeSortField_RESUME_SORT_FIELD_CREATED_AT  ResumeSortField = 1
+	ResumeSortField_RESUME_SORT_FIELD_UPDATED_AT  ResumeSortField = 2
+	ResumeSortField_RESUME_SORT_FIELD_VERSION     ResumeSortField = 3
+)
+
+// Enum value maps for ResumeSortField.
+var (
+	ResumeSortField_name = map[int32]string{
+		0: "RESUME_SORT_FIELD_UNSPECIFIED",
+		1: "RESUME_SORT_FIELD_CREATED_AT",
+		2: "RESUME_SORT_FIELD_UPDATED_AT",
+		3: "RESUME_SORT_FIELD_VERSION",
+	}
+	ResumeSortField_value = map[string]int32{
+		"RESUME_SORT_FIELD_UNSPECIFIED": 0,
+		"RESUME_SORT_FIELD_CREATED_AT":  1,
+		"RESUME_SORT_FIELD_UPDATED_AT":  2,
+		"RESUME_SORT_FIELD_VERSION":     3,
+	}
+)
+
+func (x ResumeSortField) Enum() *ResumeSortField {
+	p := new(ResumeSortField)
+	*p = x
+	return p
+}
+
+func (x ResumeSortField) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ResumeSortField) Descriptor() protoreflect.EnumDescriptor {
+	return file_shared_proto_resume_proto_enumTypes[0].Descriptor()
+}
+
+func (ResumeSortField) Type() protoreflect.EnumType {
+	return &file_shared_proto_resume_proto_enumTypes[0]
+}
+
+func (x ResumeSortField) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ResumeSortField.Descriptor instead.
+func (ResumeSortField) EnumDescriptor() ([]byte, []int) {
+	return file_shared_proto_resume_proto_rawDescGZIP(), []int{0}
+}
+
+type ChangeType int32
+
+const (
+	ChangeType_CHANGE_TYPE_UNSPECIFIED ChangeType = 0
+	ChangeType_CHANGE_TYPE_ADDED       ChangeType = 1
+	ChangeType_CHANGE_TYPE_REMOVED     ChangeType = 2
+	ChangeType_CHANGE_TYPE_MODIFIED    ChangeType = 3
+)
+
+// Enum value maps for ChangeType.
+var (
+	ChangeType_name = map[int32]string{
+		0: "CHANGE_TYPE_UNSPECIFIED",
+		1: "CHANGE_TYPE_ADDED",
+		2: "CHANGE_TYPE_REMOVED",
+		3: "CHANGE_TYPE_MODIFIED",
+	}
+	ChangeType_value = map[string]int32{
+		"CHANGE_TYPE_UNSPECIFIED": 0,
+		"CHANGE_TYPE_ADDED":       1,
+		"CHANGE_TYPE_REMOVED":     2,
+		"CHANGE_TYPE_MODIFIED":    3,
+	}
+)
+
+func (x ChangeType) Enum() *ChangeType {
+	p := new(ChangeType)
+	*p = x
+	return p
+}
+
+func (x ChangeType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ChangeType) Descriptor() protoreflect.EnumDescriptor {
+	return file_shared_proto_resume_proto_enumTypes[1].Descriptor()
+}
+
+func (ChangeType) Type() protoreflect.EnumType {
+	return &file_shared_proto_resume_proto_enumTypes[1]
+}
+
+func (x ChangeType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ChangeType.Descriptor instead.
+func (ChangeType) EnumDescriptor() ([]byte, []int) {
+	return file_shared_proto_resume_proto_rawDescGZIP(), []int{1}
+}
+
+type TextEditOp int32
+
+const (
+	TextEditOp_TEXT_EDIT_OP_UNSPECIFIED TextEditOp = 0
+	TextEditOp_TEXT_EDIT_OP_EQUAL       TextEditOp = 1
+	TextEditOp_TEXT_EDIT_OP_INSERT      TextEditOp = 2
+	TextEditOp_TEXT_EDIT_OP_DELETE      TextEditOp = 3
+)
+
+// Enum value maps for TextEditOp.
+var (
+	TextEditOp_name = map[int32]string{
+		0: "TEXT_EDIT_OP_UNSPECIFIED",
+		1: "TEXT_EDIT_OP_EQUAL",
+		2: "TEXT_EDIT_OP_INSERT",
+		3: "TEXT_EDIT_OP_DELETE",
+	}
+	TextEditOp_value = map[string]int32{
+		"TEXT_EDIT_OP_UNSPECIFIED": 0,
+		"TEXT_EDIT_OP_EQUAL":       1,
+		"TEXT_EDIT_OP_INSERT":      2,
+		"TEXT_EDIT_OP_DELETE":      3,
+	}
+)
+
+func (x TextEditOp) Enum() *TextEditOp {
+	p := new(TextEditOp)
+	*p = x
+	return p
+}
+
+func (x TextEditOp) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TextEditOp) Descriptor() protoreflect.EnumDescriptor {
+	return file_shared_proto_resume_proto_enumTypes[2].Descriptor()
+}
+
+func (TextEditOp) Type() protoreflect.EnumType {
+	return &file_shared_proto_resume_proto_enumTypes[2]
+}
+
+func (x TextEditOp) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TextEditOp.Descriptor instead.
+func (TextEditOp) EnumDescriptor() ([]byte, []int) {
+	return file_shared_proto_resume_proto_rawDescGZIP(), []int{2}
+}
+
+type ResumeFormat int32
+
+const (
+	ResumeFormat_RESUME_FORMAT_UNSPECIFIED     ResumeFormat = 0
+	ResumeFormat_RESUME_FORMAT_JSON_RESUME     ResumeFormat = 1 // https://jsonresume.org/schema
+	ResumeFormat_RESUME_FORMAT_MARKDOWN        ResumeFormat = 2 // export only
+	ResumeFormat_RESUME_FORMAT_HTML            ResumeFormat = 3 // export only, standalone page
+	ResumeFormat_RESUME_FORMAT_LATEX           ResumeFormat = 4 // export only, moderncv document
+	ResumeFormat_RESUME_FORMAT_LINKEDIN_EXPORT ResumeFormat = 5 // import only, ZIP archive passed in ImportResumeRequest.file
+)
+
+// Enum value maps for ResumeFormat.
+var (
+	ResumeFormat_name = map[int32]string{
+		0: "RESUME_FORMAT_UNSPECIFIED",
+		1: "RESUME_FORMAT_JSON_RESUME",
+		2: "RESUME_FORMAT_MARKDOWN",
+		3: "RESUME_FORMAT_HTML",
+		4: "RESUME_FORMAT_LATEX",
+		5: "RESUME_FORMAT_LINKEDIN_EXPORT",
+	}
+	ResumeFormat_value = map[string]int32{
+		"RESUME_FORMAT_UNSPECIFIED":     0,
+		"RESUME_FORMAT_JSON_RESUME":     1,
+		"RESUME_FORMAT_MARKDOWN":        2,
+		"RESUME_FORMAT_HTML":            3,
+		"RESUME_FORMAT_LATEX":           4,
+		"RESUME_FORMAT_LINKEDIN_EXPORT": 5,
+	}
+)
+
+func (x ResumeFormat) Enum() *ResumeFormat {
+	p := new(ResumeFormat)
+	*p = x
+	return p
+}
+
+func (x ResumeFormat) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ResumeFormat) Descriptor() protoreflect.EnumDescriptor {
+	return file_shared_proto_resume_proto_enumTypes[3].Descriptor()
+}
+
+func (ResumeFormat) Type() protoreflect.EnumType {
+	return &file_shared_proto_resume_proto_enumTypes[3]
+}
+
+func (x ResumeFormat) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ResumeFormat.Descriptor instead.
+func (ResumeFormat) EnumDescriptor() ([]byte, []int) {
+	return file_shared_proto_resume_proto_rawDescGZIP(), []int{3}
+}
+
+type ApplicationStatus int32
+
+const (
+	ApplicationStatus_APPLICATION_STATUS_UNSPECIFIED ApplicationStatus = 0
+	ApplicationStatus_APPLICATION_STATUS_APPLIED     ApplicationStatus = 1
+	ApplicationStatus_APPLICATION_STATUS_SCREENING   ApplicationStatus = 2
+	ApplicationStatus_APPLICATION_STATUS_INTERVIEW   ApplicationStatus = 3
+	ApplicationStatus_APPLICATION_STATUS_OFFER       ApplicationStatus = 4
+	ApplicationStatus_APPLICATION_STATUS_ACCEPTED    ApplicationStatus = 5
+	ApplicationStatus_APPLICATION_STATUS_REJECTED    ApplicationStatus = 6
+	ApplicationStatus_APPLICATION_STATUS_WITHDRAWN   ApplicationStatus = 7
+)
+
+// Enum value maps for ApplicationStatus.
+var (
+	ApplicationStatus_name = map[int32]string{
+		0: "APPLICATION_STATUS_UNSPECIFIED",
+		1: "APPLICATION_STATUS_APPLIED",
+		2: "APPLICATION_STATUS_SCREENING",
+		3: "APPLICATION_STATUS_INTERVIEW",
+		4: "APPLICATION_STATUS_OFFER",
+		5: "APPLICATION_STATUS_ACCEPTED",
+		6: "APPLICATION_STATUS_REJECTED",
+		7: "APPLICATION_STATUS_WITHDRAWN",
+	}
+	ApplicationStatus_value = map[string]int32{
+		"APPLICATION_STATUS_UNSPECIFIED": 0,
+		"APPLICATION_STATUS_APPLIED":     1,
+		"APPLICATION_STATUS_SCREENING":   2,
+		"APPLICATION_STATUS_INTERVIEW":   3,
+		"APPLICATION_STATUS_OFFER":       4,
+		"APPLICATION_STATUS_ACCEPTED":    5,
+		"APPLICATION_STATUS_REJECTED":    6,
+		"APPLICATION_STATUS_WITHDRAWN":   7,
+	}
+)
+
+func (x ApplicationStatus) Enum() *ApplicationStatus {
+	p := new(ApplicationStatus)
+	*p = x
+	return p
+}
+
+func (x ApplicationStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ApplicationStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_shared_proto_resume_proto_enumTypes[4].Descriptor()
+}
+
+func (ApplicationStatus) Type() protoreflect.EnumType {
+	return &file_shared_proto_resume_proto_enumTypes[4]
+}
+
+func (x ApplicationStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ApplicationStatus.Descriptor instead.
+func (ApplicationStatus) EnumDescriptor() ([]byte, []int) {
+	return file_shared_proto_resume_proto_rawDescGZIP(), []int{4}
+}
+
+type AnalysisSource int32
+
+const (
+	AnalysisSource_ANALYSIS_SOURCE_UNSPECIFIED AnalysisSource = 0
+	AnalysisSource_ANALYSIS_SOURCE_LLM         AnalysisSource = 1
+	AnalysisSource_ANALYSIS_SOURCE_HEURISTIC   AnalysisSource = 2
+)
+
+// Enum value maps for AnalysisSource.
+var (
+	AnalysisSource_name = map[int32]string{
+		0: "ANALYSIS_SOURCE_UNSPECIFIED",
+		1: "ANALYSIS_SOURCE_LLM",
+		2: "ANALYSIS_SOURCE_HEURISTIC",
+	}
+	AnalysisSource_value = map[string]int32{
+		"ANALYSIS_SOURCE_UNSPECIFIED": 0,
+		"ANALYSIS_SOURCE_LLM":         1,
+		"ANALYSIS_SOURCE_HEURISTIC":   2,
+	}
+)
+
+func (x AnalysisSource) Enum() *AnalysisSource {
+	p := new(AnalysisSource)
+	*p = x
+	return p
+}
+
+func (x AnalysisSource) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AnalysisSource) Descriptor() protoreflect.EnumDescriptor {
+	return file_shared_proto_resume_proto_enumTypes[5].Descriptor()
+}
+
+func (AnalysisSource) Type() protoreflect.EnumType {
+	return &file_shared_proto_resume_proto_enumTypes[5]
+}
+
+func (x AnalysisSource) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AnalysisSource.Descriptor instead.
+func (AnalysisSource) EnumDescriptor() ([]byte, []int) {
+	return file_shared_proto_resume_proto_rawDescGZIP(), []int{5}
+}
+
 // ResumeData represents the structured data of a user's resume.
 type ResumeData struct {
 	state        protoimpl.MessageState `protogen:"open.v1"`
@@ -901,6 +1228,60 @@ func (x *InterviewPrepResponse) GetQuestions() []*InterviewQuestion {
 	return nil
 }
 
+// ParseResumeRequest asks the AI service to correct a heuristic parse of an
+// uploaded resume against its extracted text.
+type ParseResumeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Text          string                 `protobuf:"bytes,1,opt,name=text,proto3" json:"text,omitempty"`
+	Draft         *ResumeData            `protobuf:"bytes,2,opt,name=draft,proto3" json:"draft,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ParseResumeRequest) Reset() {
+	*x = ParseResumeRequest{}
+	mi := &file_shared_proto_resume_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ParseResumeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ParseResumeRequest) ProtoMessage() {}
+
+func (x *ParseResumeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_resume_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ParseResumeRequest.ProtoReflect.Descriptor instead.
+func (*ParseResumeRequest) Descriptor() ([]byte, []int) {
+	return file_shared_proto_resume_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ParseResumeRequest) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *ParseResumeRequest) GetDraft() *ResumeData {
+	if x != nil {
+		return x.Draft
+	}
+	return nil
+}
+
 type AnalyzeResumeRequest struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
 	Resume         *ResumeData            `protobuf:"bytes,1,opt,name=resume,proto3" json:"resume,omitempty"`
@@ -911,7 +1292,7 @@ type AnalyzeResumeRequest struct {
 
 func (x *AnalyzeResumeRequest) Reset() {
 	*x = AnalyzeResumeRequest{}
-	mi := &file_shared_proto_resume_proto_msgTypes[13]
+	mi := &file_shared_proto_resume_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -923,7 +1304,7 @@ func (x *AnalyzeResumeRequest) String() string {
 func (*AnalyzeResumeRequest) ProtoMessage() {}
 
 func (x *AnalyzeResumeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_shared_proto_resume_proto_msgTypes[13]
+	mi := &file_shared_proto_resume_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -936,7 +1317,7 @@ func (x *AnalyzeResumeRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AnalyzeResumeRequest.ProtoReflect.Descriptor instead.
 func (*AnalyzeResumeRequest) Descriptor() ([]byte, []int) {
-	return file_shared_proto_resume_proto_rawDescGZIP(), []int{13}
+	return file_shared_proto_resume_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *AnalyzeResumeRequest) GetResume() *ResumeData {
@@ -965,7 +1346,7 @@ type AnalyzeResumeResponse struct {
 
 func (x *AnalyzeResumeResponse) Reset() {
 	*x = AnalyzeResumeResponse{}
-	mi := &file_shared_proto_resume_proto_msgTypes[14]
+	mi := &file_shared_proto_resume_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -977,7 +1358,7 @@ func (x *AnalyzeResumeResponse) String() string {
 func (*AnalyzeResumeResponse) ProtoMessage() {}
 
 func (x *AnalyzeResumeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_shared_proto_resume_proto_msgTypes[14]
+	mi := &file_shared_proto_resume_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -990,7 +1371,7 @@ func (x *AnalyzeResumeResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AnalyzeResumeResponse.ProtoReflect.Descriptor instead.
 func (*AnalyzeResumeResponse) Descriptor() ([]byte, []int) {
-	return file_shared_proto_resume_proto_rawDescGZIP(), []int{14}
+	return file_shared_proto_resume_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *AnalyzeResumeResponse) GetScore() int32 {
@@ -1021,32 +1402,36 @@ func (x *AnalyzeResumeResponse) GetReasoning() string {
 	return ""
 }
 
-type SavedResume struct {
+// Persistence RPCs are scoped to the caller, identified by the gateway through
+// the x-user-id, x-user-email and x-user-name metadata keys. These are only
+// accepted together with the shared secret in x-service-token. Calls without
+// an identity act as the shared "anonymous" user.
+type User struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	ResumeData    *ResumeData            `protobuf:"bytes,2,opt,name=resume_data,json=resumeData,proto3" json:"resume_data,omitempty"`
-	Tags          []string               `protobuf:"bytes,3,rep,name=tags,proto3" json:"tags,omitempty"`
-	Version       string                 `protobuf:"bytes,4,opt,name=version,proto3" json:"version,omitempty"`
+	Subject       string                 `protobuf:"bytes,2,opt,name=subject,proto3" json:"subject,omitempty"`
+	Email         string                 `protobuf:"bytes,3,opt,name=email,proto3" json:"email,omitempty"`
+	Name          string                 `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
 	CreatedAt     string                 `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SavedResume) Reset() {
-	*x = SavedResume{}
-	mi := &file_shared_proto_resume_proto_msgTypes[15]
+func (x *User) Reset() {
+	*x = User{}
+	mi := &file_shared_proto_resume_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SavedResume) String() string {
+func (x *User) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SavedResume) ProtoMessage() {}
+func (*User) ProtoMessage() {}
 
-func (x *SavedResume) ProtoReflect() protoreflect.Message {
-	mi := &file_shared_proto_resume_proto_msgTypes[15]
+func (x *User) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_resume_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1057,70 +1442,67 @@ func (x *SavedResume) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SavedResume.ProtoReflect.Descriptor instead.
-func (*SavedResume) Descriptor() ([]byte, []int) {
-	return file_shared_proto_resume_proto_rawDescGZIP(), []int{15}
+// Deprecated: Use User.ProtoReflect.Descriptor instead.
+func (*User) Descriptor() ([]byte, []int) {
+	return file_shared_proto_resume_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *SavedResume) GetId() string {
+func (x *User) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-func (x *SavedResume) GetResumeData() *ResumeData {
+func (x *User) GetSubject() string {
 	if x != nil {
-		return x.ResumeData
+		return x.Subject
 	}
-	return nil
+	return ""
 }
 
-func (x *SavedResume) GetTags() []string {
+func (x *User) GetEmail() string {
 	if x != nil {
-		return x.Tags
+		return x.Email
 	}
-	return nil
+	return ""
 }
 
-func (x *SavedResume) GetVersion() string {
+func (x *User) GetName() string {
 	if x != nil {
-		return x.Version
+		return x.Name
 	}
 	return ""
 }
 
-func (x *SavedResume) GetCreatedAt() string {
+func (x *User) GetCreatedAt() string {
 	if x != nil {
 		return x.CreatedAt
 	}
 	return ""
 }
 
-type SaveResumeRequest struct {
+type GetCurrentUserRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Resume        *ResumeData            `protobuf:"bytes,1,opt,name=resume,proto3" json:"resume,omitempty"`
-	Tags          []string               `protobuf:"bytes,2,rep,name=tags,proto3" json:"tags,omitempty"`
-	Version       string                 `protobuf:"bytes,3,opt,name=version,proto3" json:"version,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SaveResumeRequest) Reset() {
-	*x = SaveResumeRequest{}
-	mi := &file_shared_proto_resume_proto_msgTypes[16]
+func (x *GetCurrentUserRequest) Reset() {
+	*x = GetCurrentUserRequest{}
+	mi := &file_shared_proto_resume_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SaveResumeRequest) String() string {
+func (x *GetCurrentUserRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SaveResumeRequest) ProtoMessage() {}
+func (*GetCurrentUserRequest) ProtoMessage() {}
 
-func (x *SaveResumeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_shared_proto_resume_proto_msgTypes[16]
+func (x *GetCurrentUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_resume_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1131,54 +1513,3339 @@ func (x *SaveResumeRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SaveResumeRequest.ProtoReflect.Descriptor instead.
-func (*SaveResumeRequest) Descriptor() ([]byte, []int) {
-	return file_shared_proto_resume_proto_rawDescGZIP(), []int{16}
+// Deprecated: Use GetCurrentUserRequest.ProtoReflect.Descriptor instead.
+func (*GetCurrentUserRequest) Descriptor() ([]byte, []int) {
+	return file_shared_proto_resume_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *SaveResumeRequest) GetResume() *ResumeData {
-	if x != nil {
-		return x.Resume
-	}
-	return nil
+type SavedResume struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ResumeData    *ResumeData            `protobuf:"bytes,2,opt,name=resume_data,json=resumeData,proto3" json:"resume_data,omitempty"`
+	Tags          []string               `protobuf:"bytes,3,rep,name=tags,proto3" json:"tags,omitempty"`
+	Version       string                 `protobuf:"bytes,4,opt,name=version,proto3" json:"version,omitempty"`
+	CreatedAt     string                 `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Revision      int32                  `protobuf:"varint,6,opt,name=revision,proto3" json:"revision,omitempty"` // Latest revision number
+	UpdatedAt     string                 `protobuf:"bytes,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	DeletedAt     string                 `protobuf:"bytes,8,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at,omitempty"` // Set only for resumes in the trash
+	OwnerId       string                 `protobuf:"bytes,9,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SaveResumeRequest) GetTags() []string {
-	if x != nil {
-		return x.Tags
-	}
-	return nil
+func (x *SavedResume) Reset() {
+	*x = SavedResume{}
+	mi := &file_shared_proto_resume_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *SaveResumeRequest) GetVersion() string {
-	if x != nil {
-		return x.Version
+func (x *SavedResume) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SavedResume) ProtoMessage() {}
+
+func (x *SavedResume) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_resume_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SavedResume.ProtoReflect.Descriptor instead.
+func (*SavedResume) Descriptor() ([]byte, []int) {
+	return file_shared_proto_resume_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *SavedResume) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SavedResume) GetResumeData() *ResumeData {
+	if x != nil {
+		return x.ResumeData
+	}
+	return nil
+}
+
+func (x *SavedResume) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *SavedResume) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *SavedResume) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *SavedResume) GetRevision() int32 {
+	if x != nil {
+		return x.Revision
+	}
+	return 0
+}
+
+func (x *SavedResume) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+func (x *SavedResume) GetDeletedAt() string {
+	if x != nil {
+		return x.DeletedAt
+	}
+	return ""
+}
+
+func (x *SavedResume) GetOwnerId() string {
+	if x != nil {
+		return x.OwnerId
+	}
+	return ""
+}
+
+// SaveResumeRequest updates the resume with id, or else the caller's resume
+// with the same version (compared case- and whitespace-insensitively),
+// creating one if there is none. Versions are unique per owner.
+type SaveResumeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Resume        *ResumeData            `protobuf:"bytes,1,opt,name=resume,proto3" json:"resume,omitempty"`
+	Tags          []string               `protobuf:"bytes,2,rep,name=tags,proto3" json:"tags,omitempty"` // order-insensitive; stored sorted and deduplicated
+	Version       string                 `protobuf:"bytes,3,opt,name=version,proto3" json:"version,omitempty"`
+	Id            string                 `protobuf:"bytes,4,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SaveResumeRequest) Reset() {
+	*x = SaveResumeRequest{}
+	mi := &file_shared_proto_resume_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SaveResumeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SaveResumeRequest) ProtoMessage() {}
+
+func (x *SaveResumeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_resume_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SaveResumeRequest.ProtoReflect.Descriptor instead.
+func (*SaveResumeRequest) Descriptor() ([]byte, []int) {
+	return file_shared_proto_resume_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *SaveResumeRequest) GetResume() *ResumeData {
+	if x != nil {
+		return x.Resume
+	}
+	return nil
+}
+
+func (x *SaveResumeRequest) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *SaveResumeRequest) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *SaveResumeRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type GetResumeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetResumeRequest) Reset() {
+	*x = GetResumeRequest{}
+	mi := &file_shared_proto_resume_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetResumeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetResumeRequest) ProtoMessage() {}
+
+func (x *GetResumeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_resume_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetResumeRequest.ProtoReflect.Descriptor instead.
+func (*GetResumeRequest) Descriptor() ([]byte, []int) {
+	return file_shared_proto_resume_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *GetResumeRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// UpdateResumeRequest replaces the data of a saved resume if its latest
+// revision is still expected_revision. Otherwise the call fails with
+// ABORTED and the current SavedResume attached as an error detail.
+type UpdateResumeRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Id               string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ExpectedRevision int32                  `protobuf:"varint,2,opt,name=expected_revision,json=expectedRevision,proto3" json:"expected_revision,omitempty"`
+	Resume           *ResumeData            `protobuf:"bytes,3,opt,name=resume,proto3" json:"resume,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *UpdateResumeRequest) Reset() {
+	*x = UpdateResumeRequest{}
+	mi := &file_shared_proto_resume_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateResumeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateResumeRequest) ProtoMessage() {}
+
+func (x *UpdateResumeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_resume_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateResumeRequest.ProtoReflect.Descriptor instead.
+func (*UpdateResumeRequest) Descriptor() ([]byte, []int) {
+	return file_shared_proto_resume_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *UpdateResumeRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateResumeRequest) GetExpectedRevision() int32 {
+	if x != nil {
+		return x.ExpectedRevision
+	}
+	return 0
+}
+
+func (x *UpdateResumeRequest) GetResume() *ResumeData {
+	if x != nil {
+		return x.Resume
+	}
+	return nil
+}
+
+type ListResumesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tags          []string               `protobuf:"bytes,1,rep,name=tags,proto3" json:"tags,omitempty"`
+	PageSize      int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`   // 0 returns every matching resume
+	PageToken     string                 `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"` // next_page_token of the previous page
+	SortBy        ResumeSortField        `protobuf:"varint,4,opt,name=sort_by,json=sortBy,proto3,enum=resume.ResumeSortField" json:"sort_by,omitempty"`
+	Ascending     bool                   `protobuf:"varint,5,opt,name=ascending,proto3" json:"ascending,omitempty"` // Newest/highest first unless set
+	Query         string                 `protobuf:"bytes,6,opt,name=query,proto3" json:"query,omitempty"`          // Full-text search over name, job title and summary
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListResumesRequest) Reset() {
+	*x = ListResumesRequest{}
+	mi := &file_shared_proto_resume_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListResumesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListResumesRequest) ProtoMessage() {}
+
+func (x *ListResumesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_resume_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListResumesRequest.ProtoReflect.Descriptor instead.
+func (*ListResumesRequest) Descriptor() ([]byte, []int) {
+	return file_shared_proto_resume_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *ListResumesRequest) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *ListResumesRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListResumesRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListResumesRequest) GetSortBy() ResumeSortField {
+	if x != nil {
+		return x.SortBy
+	}
+	return ResumeSortField_RESUME_SORT_FIELD_UNSPECIFIED
+}
+
+func (x *ListResumesRequest) GetAscending() bool {
+	if x != nil {
+		return x.Ascending
+	}
+	return false
+}
+
+func (x *ListResumesRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+type ListResumesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Resumes       []*SavedResume         `protobuf:"bytes,1,rep,name=resumes,proto3" json:"resumes,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"` // Empty on the last page
+	TotalCount    int32                  `protobuf:"varint,3,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	Cursors       []string               `protobuf:"bytes,4,rep,name=cursors,proto3" json:"cursors,omitempty"` // Cursor of each resume, parallel to resumes
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListResumesResponse) Reset() {
+	*x = ListResumesResponse{}
+	mi := &file_shared_proto_resume_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListResumesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListResumesResponse) ProtoMessage() {}
+
+func (x *ListResumesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_resume_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListResumesResponse.ProtoReflect.Descriptor instead.
+func (*ListResumesResponse) Descriptor() ([]byte, []int) {
+	return file_shared_proto_resume_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *ListResumesResponse) GetResumes() []*SavedResume {
+	if x != nil {
+		return x.Resumes
+	}
+	return nil
+}
+
+func (x *ListResumesResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *ListResumesResponse) GetTotalCount() int32 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+func (x *ListResumesResponse) GetCursors() []string {
+	if x != nil {
+		return x.Cursors
+	}
+	return nil
+}
+
+type DeleteResumeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteResumeRequest) Reset() {
+	*x = DeleteResumeRequest{}
+	mi := &file_shared_proto_resume_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteResumeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteResumeRequest) ProtoMessage() {}
+
+func (x *DeleteResumeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_resume_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteResumeRequest.ProtoReflect.Descriptor instead.
+func (*DeleteResumeRequest) Descriptor() ([]byte, []int) {
+	return file_shared_proto_resume_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *DeleteResumeRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type DeleteResumeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteResumeResponse) Reset() {
+	*x = DeleteResumeResponse{}
+	mi := &file_shared_proto_resume_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteResumeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteResumeResponse) ProtoMessage() {}
+
+func (x *DeleteResumeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_resume_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteResumeResponse.ProtoReflect.Descriptor instead.
+func (*DeleteResumeResponse) Descriptor() ([]byte, []int) {
+	return file_shared_proto_resume_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *DeleteResumeResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type ListDeletedResumesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDeletedResumesRequest) Reset() {
+	*x = ListDeletedResumesRequest{}
+	mi := &file_shared_proto_resume_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDeletedResumesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDeletedResumesRequest) ProtoMessage() {}
+
+func (x *ListDeletedResumesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_resume_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDeletedResumesRequest.ProtoReflect.Descriptor instead.
+func (*ListDeletedResumesRequest) Descriptor() ([]byte, []int) {
+	return file_shared_proto_resume_proto_rawDescGZIP(), []int{26}
+}
+
+// RestoreResumeRequest moves a resume out of the trash.
+type RestoreResumeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreResumeRequest) Reset() {
+	*x = RestoreResumeRequest{}
+	mi := &file_shared_proto_resume_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreResumeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreResumeRequest) ProtoMessage() {}
+
+func (x *RestoreResumeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_resume_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreResumeRequest.ProtoReflect.Descriptor instead.
+func (*RestoreResumeRequest) Descriptor() ([]byte, []int) {
+	return file_shared_proto_resume_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *RestoreResumeRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// PurgeResumeRequest permanently deletes a resume that is in the trash.
+type PurgeResumeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PurgeResumeRequest) Reset() {
+	*x = PurgeResumeRequest{}
+	mi := &file_shared_proto_resume_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PurgeResumeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PurgeResumeRequest) ProtoMessage() {}
+
+func (x *PurgeResumeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_resume_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PurgeResumeRequest.ProtoReflect.Descriptor instead.
+func (*PurgeResumeRequest) Descriptor() ([]byte, []int) {
+	return file_shared_proto_resume_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *PurgeResumeRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type PurgeResumeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PurgeResumeResponse) Reset() {
+	*x = PurgeResumeResponse{}
+	mi := &file_shared_proto_resume_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PurgeResumeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PurgeResumeResponse) ProtoMessage() {}
+
+func (x *PurgeResumeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_resume_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PurgeResumeResponse.ProtoReflect.Descriptor instead.
+func (*PurgeResumeResponse) Descriptor() ([]byte, []int) {
+	return file_shared_proto_resume_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *PurgeResumeResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+// ResumeRevision is an immutable snapshot of a saved resume taken on every save.
+type ResumeRevision struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ResumeId      string                 `protobuf:"bytes,2,opt,name=resume_id,json=resumeId,proto3" json:"resume_id,omitempty"`
+	Revision      int32                  `protobuf:"varint,3,opt,name=revision,proto3" json:"revision,omitempty"`
+	ResumeData    *ResumeData            `protobuf:"bytes,4,opt,name=resume_data,json=resumeData,proto3" json:"resume_data,omitempty"`
+	CreatedAt     string                 `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResumeRevision) Reset() {
+	*x = ResumeRevision{}
+	mi := &file_shared_proto_resume_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResumeRevision) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResumeRevision) ProtoMessage() {}
+
+func (x *ResumeRevision) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_resume_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResumeRevision.ProtoReflect.Descriptor instead.
+func (*ResumeRevision) Descriptor() ([]byte, []int) {
+	return file_shared_proto_resume_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *ResumeRevision) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ResumeRevision) GetResumeId() string {
+	if x != nil {
+		return x.ResumeId
+	}
+	return ""
+}
+
+func (x *ResumeRevision) GetRevision() int32 {
+	if x != nil {
+		return x.Revision
+	}
+	return 0
+}
+
+func (x *ResumeRevision) GetResumeData() *ResumeData {
+	if x != nil {
+		return x.ResumeData
+	}
+	return nil
+}
+
+func (x *ResumeRevision) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+type ListResumeRevisionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ResumeId      string                 `protobuf:"bytes,1,opt,name=resume_id,json=resumeId,proto3" json:"resume_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListResumeRevisionsRequest) Reset() {
+	*x = ListResumeRevisionsRequest{}
+	mi := &file_shared_proto_resume_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListResumeRevisionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListResumeRevisionsRequest) ProtoMessage() {}
+
+func (x *ListResumeRevisionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_resume_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListResumeRevisionsRequest.ProtoReflect.Descriptor instead.
+func (*ListResumeRevisionsRequest) Descriptor() ([]byte, []int) {
+	return file_shared_proto_resume_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *ListResumeRevisionsRequest) GetResumeId() string {
+	if x != nil {
+		return x.ResumeId
+	}
+	return ""
+}
+
+type ListResumeRevisionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Revisions     []*ResumeRevision      `protobuf:"bytes,1,rep,name=revisions,proto3" json:"revisions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListResumeRevisionsResponse) Reset() {
+	*x = ListResumeRevisionsResponse{}
+	mi := &file_shared_proto_resume_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListResumeRevisionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListResumeRevisionsResponse) ProtoMessage() {}
+
+func (x *ListResumeRevisionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_resume_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListResumeRevisionsResponse.ProtoReflect.Descriptor instead.
+func (*ListResumeRevisionsResponse) Descriptor() ([]byte, []int) {
+	return file_shared_proto_resume_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *ListResumeRevisionsResponse) GetRevisions() []*ResumeRevision {
+	if x != nil {
+		return x.Revisions
+	}
+	return nil
+}
+
+type GetResumeRevisionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ResumeId      string                 `protobuf:"bytes,1,opt,name=resume_id,json=resumeId,proto3" json:"resume_id,omitempty"`
+	Revision      int32                  `protobuf:"varint,2,opt,name=revision,proto3" json:"revision,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetResumeRevisionRequest) Reset() {
+	*x = GetResumeRevisionRequest{}
+	mi := &file_shared_proto_resume_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetResumeRevisionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetResumeRevisionRequest) ProtoMessage() {}
+
+func (x *GetResumeRevisionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_resume_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetResumeRevisionRequest.ProtoReflect.Descriptor instead.
+func (*GetResumeRevisionRequest) Descriptor() ([]byte, []int) {
+	return file_shared_proto_resume_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *GetResumeRevisionRequest) GetResumeId() string {
+	if x != nil {
+		return x.ResumeId
+	}
+	return ""
+}
+
+func (x *GetResumeRevisionRequest) GetRevision() int32 {
+	if x != nil {
+		return x.Revision
+	}
+	return 0
+}
+
+// RestoreResumeRevisionRequest copies an earlier revision forward as the newest one.
+type RestoreResumeRevisionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ResumeId      string                 `protobuf:"bytes,1,opt,name=resume_id,json=resumeId,proto3" json:"resume_id,omitempty"`
+	Revision      int32                  `protobuf:"varint,2,opt,name=revision,proto3" json:"revision,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreResumeRevisionRequest) Reset() {
+	*x = RestoreResumeRevisionRequest{}
+	mi := &file_shared_proto_resume_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreResumeRevisionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreResumeRevisionRequest) ProtoMessage() {}
+
+func (x *RestoreResumeRevisionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_resume_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreResumeRevisionRequest.ProtoReflect.Descriptor instead.
+func (*RestoreResumeRevisionRequest) Descriptor() ([]byte, []int) {
+	return file_shared_proto_resume_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *RestoreResumeRevisionRequest) GetResumeId() string {
+	if x != nil {
+		return x.ResumeId
+	}
+	return ""
+}
+
+func (x *RestoreResumeRevisionRequest) GetRevision() int32 {
+	if x != nil {
+		return x.Revision
+	}
+	return 0
+}
+
+// ResumeRef points at a resume to diff: either a saved resume (optionally at a
+// specific revision, 0 meaning the latest) or an inline, unsaved resume.
+type ResumeRef struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ResumeId      string                 `protobuf:"bytes,1,opt,name=resume_id,json=resumeId,proto3" json:"resume_id,omitempty"`
+	Revision      int32                  `protobuf:"varint,2,opt,name=revision,proto3" json:"revision,omitempty"`
+	Resume        *ResumeData            `protobuf:"bytes,3,opt,name=resume,proto3" json:"resume,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResumeRef) Reset() {
+	*x = ResumeRef{}
+	mi := &file_shared_proto_resume_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResumeRef) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResumeRef) ProtoMessage() {}
+
+func (x *ResumeRef) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_resume_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResumeRef.ProtoReflect.Descriptor instead.
+func (*ResumeRef) Descriptor() ([]byte, []int) {
+	return file_shared_proto_resume_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *ResumeRef) GetResumeId() string {
+	if x != nil {
+		return x.ResumeId
+	}
+	return ""
+}
+
+func (x *ResumeRef) GetRevision() int32 {
+	if x != nil {
+		return x.Revision
+	}
+	return 0
+}
+
+func (x *ResumeRef) GetResume() *ResumeData {
+	if x != nil {
+		return x.Resume
+	}
+	return nil
+}
+
+type DiffResumesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *ResumeRef             `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Target        *ResumeRef             `protobuf:"bytes,2,opt,name=target,proto3" json:"target,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DiffResumesRequest) Reset() {
+	*x = DiffResumesRequest{}
+	mi := &file_shared_proto_resume_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DiffResumesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiffResumesRequest) ProtoMessage() {}
+
+func (x *DiffResumesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_resume_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiffResumesRequest.ProtoReflect.Descriptor instead.
+func (*DiffResumesRequest) Descriptor() ([]byte, []int) {
+	return file_shared_proto_resume_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *DiffResumesRequest) GetBase() *ResumeRef {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *DiffResumesRequest) GetTarget() *ResumeRef {
+	if x != nil {
+		return x.Target
+	}
+	return nil
+}
+
+// TextEdit is one run of a word-level diff.
+type TextEdit struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Op            TextEditOp             `protobuf:"varint,1,opt,name=op,proto3,enum=resume.TextEditOp" json:"op,omitempty"`
+	Text          string                 `protobuf:"bytes,2,opt,name=text,proto3" json:"text,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TextEdit) Reset() {
+	*x = TextEdit{}
+	mi := &file_shared_proto_resume_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TextEdit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TextEdit) ProtoMessage() {}
+
+func (x *TextEdit) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_resume_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TextEdit.ProtoReflect.Descriptor instead.
+func (*TextEdit) Descriptor() ([]byte, []int) {
+	return file_shared_proto_resume_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *TextEdit) GetOp() TextEditOp {
+	if x != nil {
+		return x.Op
+	}
+	return TextEditOp_TEXT_EDIT_OP_UNSPECIFIED
+}
+
+func (x *TextEdit) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+// FieldChange describes a changed field. Text fields carry old/new values and
+// word-level edits; list fields carry the added and removed values.
+type FieldChange struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Field         string                 `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"`
+	OldValue      string                 `protobuf:"bytes,2,opt,name=old_value,json=oldValue,proto3" json:"old_value,omitempty"`
+	NewValue      string                 `protobuf:"bytes,3,opt,name=new_value,json=newValue,proto3" json:"new_value,omitempty"`
+	Edits         []*TextEdit            `protobuf:"bytes,4,rep,name=edits,proto3" json:"edits,omitempty"`
+	AddedValues   []string               `protobuf:"bytes,5,rep,name=added_values,json=addedValues,proto3" json:"added_values,omitempty"`
+	RemovedValues []string               `protobuf:"bytes,6,rep,name=removed_values,json=removedValues,proto3" json:"removed_values,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FieldChange) Reset() {
+	*x = FieldChange{}
+	mi := &file_shared_proto_resume_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FieldChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FieldChange) ProtoMessage() {}
+
+func (x *FieldChange) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_resume_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FieldChange.ProtoReflect.Descriptor instead.
+func (*FieldChange) Descriptor() ([]byte, []int) {
+	return file_shared_proto_resume_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *FieldChange) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+func (x *FieldChange) GetOldValue() string {
+	if x != nil {
+		return x.OldValue
+	}
+	return ""
+}
+
+func (x *FieldChange) GetNewValue() string {
+	if x != nil {
+		return x.NewValue
+	}
+	return ""
+}
+
+func (x *FieldChange) GetEdits() []*TextEdit {
+	if x != nil {
+		return x.Edits
+	}
+	return nil
+}
+
+func (x *FieldChange) GetAddedValues() []string {
+	if x != nil {
+		return x.AddedValues
+	}
+	return nil
+}
+
+func (x *FieldChange) GetRemovedValues() []string {
+	if x != nil {
+		return x.RemovedValues
+	}
+	return nil
+}
+
+// ItemChange describes an added, removed or modified entry of a repeated section.
+type ItemChange struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ChangeType    ChangeType             `protobuf:"varint,1,opt,name=change_type,json=changeType,proto3,enum=resume.ChangeType" json:"change_type,omitempty"`
+	Key           string                 `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	Fields        []*FieldChange         `protobuf:"bytes,3,rep,name=fields,proto3" json:"fields,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ItemChange) Reset() {
+	*x = ItemChange{}
+	mi := &file_shared_proto_resume_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ItemChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ItemChange) ProtoMessage() {}
+
+func (x *ItemChange) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_resume_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ItemChange.ProtoReflect.Descriptor instead.
+func (*ItemChange) Descriptor() ([]byte, []int) {
+	return file_shared_proto_resume_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *ItemChange) GetChangeType() ChangeType {
+	if x != nil {
+		return x.ChangeType
+	}
+	return ChangeType_CHANGE_TYPE_UNSPECIFIED
+}
+
+func (x *ItemChange) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *ItemChange) GetFields() []*FieldChange {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+type ResumeDiff struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Fields        []*FieldChange         `protobuf:"bytes,1,rep,name=fields,proto3" json:"fields,omitempty"`
+	Experience    []*ItemChange          `protobuf:"bytes,2,rep,name=experience,proto3" json:"experience,omitempty"`
+	Skills        []*ItemChange          `protobuf:"bytes,3,rep,name=skills,proto3" json:"skills,omitempty"`
+	SkillGroups   []*ItemChange          `protobuf:"bytes,4,rep,name=skill_groups,json=skillGroups,proto3" json:"skill_groups,omitempty"`
+	Projects      []*ItemChange          `protobuf:"bytes,5,rep,name=projects,proto3" json:"projects,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResumeDiff) Reset() {
+	*x = ResumeDiff{}
+	mi := &file_shared_proto_resume_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResumeDiff) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResumeDiff) ProtoMessage() {}
+
+func (x *ResumeDiff) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_resume_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResumeDiff.ProtoReflect.Descriptor instead.
+func (*ResumeDiff) Descriptor() ([]byte, []int) {
+	return file_shared_proto_resume_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *ResumeDiff) GetFields() []*FieldChange {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+func (x *ResumeDiff) GetExperience() []*ItemChange {
+	if x != nil {
+		return x.Experience
+	}
+	return nil
+}
+
+func (x *ResumeDiff) GetSkills() []*ItemChange {
+	if x != nil {
+		return x.Skills
+	}
+	return nil
+}
+
+func (x *ResumeDiff) GetSkillGroups() []*ItemChange {
+	if x != nil {
+		return x.SkillGroups
+	}
+	return nil
+}
+
+func (x *ResumeDiff) GetProjects() []*ItemChange {
+	if x != nil {
+		return x.Projects
+	}
+	return nil
+}
+
+type ExportResumeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Format        ResumeFormat           `protobuf:"varint,2,opt,name=format,proto3,enum=resume.ResumeFormat" json:"format,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportResumeRequest) Reset() {
+	*x = ExportResumeRequest{}
+	mi := &file_shared_proto_resume_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportResumeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportResumeRequest) ProtoMessage() {}
+
+func (x *ExportResumeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_resume_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportResumeRequest.ProtoReflect.Descriptor instead.
+func (*ExportResumeRequest) Descriptor() ([]byte, []int) {
+	return file_shared_proto_resume_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *ExportResumeRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ExportResumeRequest) GetFormat() ResumeFormat {
+	if x != nil {
+		return x.Format
+	}
+	return ResumeFormat_RESUME_FORMAT_UNSPECIFIED
+}
+
+type ExportResumeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Content       string                 `protobuf:"bytes,1,opt,name=content,proto3" json:"content,omitempty"`
+	ContentType   string                 `protobuf:"bytes,2,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	Filename      string                 `protobuf:"bytes,3,opt,name=filename,proto3" json:"filename,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportResumeResponse) Reset() {
+	*x = ExportResumeResponse{}
+	mi := &file_shared_proto_resume_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportResumeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportResumeResponse) ProtoMessage() {}
+
+func (x *ExportResumeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_resume_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportResumeResponse.ProtoReflect.Descriptor instead.
+func (*ExportResumeResponse) Descriptor() ([]byte, []int) {
+	return file_shared_proto_resume_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *ExportResumeResponse) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *ExportResumeResponse) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *ExportResumeResponse) GetFilename() string {
+	if x != nil {
+		return x.Filename
+	}
+	return ""
+}
+
+// ImportResumeRequest converts a document into an unsaved ResumeData draft.
+type ImportResumeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Format        ResumeFormat           `protobuf:"varint,1,opt,name=format,proto3,enum=resume.ResumeFormat" json:"format,omitempty"`
+	Content       string                 `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+	File          []byte                 `protobuf:"bytes,3,opt,name=file,proto3" json:"file,omitempty"` // binary formats
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportResumeRequest) Reset() {
+	*x = ImportResumeRequest{}
+	mi := &file_shared_proto_resume_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportResumeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportResumeRequest) ProtoMessage() {}
+
+func (x *ImportResumeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_resume_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportResumeRequest.ProtoReflect.Descriptor instead.
+func (*ImportResumeRequest) Descriptor() ([]byte, []int) {
+	return file_shared_proto_resume_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *ImportResumeRequest) GetFormat() ResumeFormat {
+	if x != nil {
+		return x.Format
+	}
+	return ResumeFormat_RESUME_FORMAT_UNSPECIFIED
+}
+
+func (x *ImportResumeRequest) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *ImportResumeRequest) GetFile() []byte {
+	if x != nil {
+		return x.File
+	}
+	return nil
+}
+
+// RenderResumeRequest lays out a saved resume, one of its revisions or an
+// inline draft as a PDF.
+type RenderResumeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Resume        *ResumeRef             `protobuf:"bytes,1,opt,name=resume,proto3" json:"resume,omitempty"`
+	Template      string                 `protobuf:"bytes,2,opt,name=template,proto3" json:"template,omitempty"` // e.g. "classic" or "sidebar"; defaults to "classic"
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RenderResumeRequest) Reset() {
+	*x = RenderResumeRequest{}
+	mi := &file_shared_proto_resume_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RenderResumeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RenderResumeRequest) ProtoMessage() {}
+
+func (x *RenderResumeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_resume_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RenderResumeRequest.ProtoReflect.Descriptor instead.
+func (*RenderResumeRequest) Descriptor() ([]byte, []int) {
+	return file_shared_proto_resume_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *RenderResumeRequest) GetResume() *ResumeRef {
+	if x != nil {
+		return x.Resume
+	}
+	return nil
+}
+
+func (x *RenderResumeRequest) GetTemplate() string {
+	if x != nil {
+		return x.Template
+	}
+	return ""
+}
+
+type RenderResumeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Content       []byte                 `protobuf:"bytes,1,opt,name=content,proto3" json:"content,omitempty"`
+	ContentType   string                 `protobuf:"bytes,2,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	Filename      string                 `protobuf:"bytes,3,opt,name=filename,proto3" json:"filename,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RenderResumeResponse) Reset() {
+	*x = RenderResumeResponse{}
+	mi := &file_shared_proto_resume_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RenderResumeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RenderResumeResponse) ProtoMessage() {}
+
+func (x *RenderResumeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_resume_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RenderResumeResponse.ProtoReflect.Descriptor instead.
+func (*RenderResumeResponse) Descriptor() ([]byte, []int) {
+	return file_shared_proto_resume_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *RenderResumeResponse) GetContent() []byte {
+	if x != nil {
+		return x.Content
+	}
+	return nil
+}
+
+func (x *RenderResumeResponse) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *RenderResumeResponse) GetFilename() string {
+	if x != nil {
+		return x.Filename
+	}
+	return ""
+}
+
+// ParseResumeFileRequest extracts the text of an uploaded PDF or DOCX resume
+// and parses it into an unsaved ResumeData draft.
+type ParseResumeFileRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Filename      string                 `protobuf:"bytes,1,opt,name=filename,proto3" json:"filename,omitempty"`
+	Content       []byte                 `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ParseResumeFileRequest) Reset() {
+	*x = ParseResumeFileRequest{}
+	mi := &file_shared_proto_resume_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ParseResumeFileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ParseResumeFileRequest) ProtoMessage() {}
+
+func (x *ParseResumeFileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_resume_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ParseResumeFileRequest.ProtoReflect.Descriptor instead.
+func (*ParseResumeFileRequest) Descriptor() ([]byte, []int) {
+	return file_shared_proto_resume_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *ParseResumeFileRequest) GetFilename() string {
+	if x != nil {
+		return x.Filename
+	}
+	return ""
+}
+
+func (x *ParseResumeFileRequest) GetContent() []byte {
+	if x != nil {
+		return x.Content
+	}
+	return nil
+}
+
+type ParseResumeFileResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Draft         *ResumeData            `protobuf:"bytes,1,opt,name=draft,proto3" json:"draft,omitempty"`
+	Text          string                 `protobuf:"bytes,2,opt,name=text,proto3" json:"text,omitempty"` // extracted plain text, for further refinement
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ParseResumeFileResponse) Reset() {
+	*x = ParseResumeFileResponse{}
+	mi := &file_shared_proto_resume_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ParseResumeFileResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ParseResumeFileResponse) ProtoMessage() {}
+
+func (x *ParseResumeFileResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_resume_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ParseResumeFileResponse.ProtoReflect.Descriptor instead.
+func (*ParseResumeFileResponse) Descriptor() ([]byte, []int) {
+	return file_shared_proto_resume_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *ParseResumeFileResponse) GetDraft() *ResumeData {
+	if x != nil {
+		return x.Draft
+	}
+	return nil
+}
+
+func (x *ParseResumeFileResponse) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+type ApplicationStatusChange struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        ApplicationStatus      `protobuf:"varint,1,opt,name=status,proto3,enum=resume.ApplicationStatus" json:"status,omitempty"`
+	Note          string                 `protobuf:"bytes,2,opt,name=note,proto3" json:"note,omitempty"`
+	ChangedAt     string                 `protobuf:"bytes,3,opt,name=changed_at,json=changedAt,proto3" json:"changed_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApplicationStatusChange) Reset() {
+	*x = ApplicationStatusChange{}
+	mi := &file_shared_proto_resume_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApplicationStatusChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApplicationStatusChange) ProtoMessage() {}
+
+func (x *ApplicationStatusChange) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_resume_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApplicationStatusChange.ProtoReflect.Descriptor instead.
+func (*ApplicationStatusChange) Descriptor() ([]byte, []int) {
+	return file_shared_proto_resume_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *ApplicationStatusChange) GetStatus() ApplicationStatus {
+	if x != nil {
+		return x.Status
+	}
+	return ApplicationStatus_APPLICATION_STATUS_UNSPECIFIED
+}
+
+func (x *ApplicationStatusChange) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+func (x *ApplicationStatusChange) GetChangedAt() string {
+	if x != nil {
+		return x.ChangedAt
+	}
+	return ""
+}
+
+// Application tracks a job applied to with a particular saved resume revision.
+type Application struct {
+	state          protoimpl.MessageState     `protogen:"open.v1"`
+	Id             string                     `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ResumeId       string                     `protobuf:"bytes,2,opt,name=resume_id,json=resumeId,proto3" json:"resume_id,omitempty"`                    // empty once the resume is purged
+	ResumeRevision int32                      `protobuf:"varint,3,opt,name=resume_revision,json=resumeRevision,proto3" json:"resume_revision,omitempty"` // revision that was sent; defaults to the latest on create
+	Company        string                     `protobuf:"bytes,4,opt,name=company,proto3" json:"company,omitempty"`
+	Role           string                     `protobuf:"bytes,5,opt,name=role,proto3" json:"role,omitempty"`
+	JobDescription string                     `protobuf:"bytes,6,opt,name=job_description,json=jobDescription,proto3" json:"job_description,omitempty"`
+	Url            string                     `protobuf:"bytes,7,opt,name=url,proto3" json:"url,omitempty"`
+	Status         ApplicationStatus          `protobuf:"varint,8,opt,name=status,proto3,enum=resume.ApplicationStatus" json:"status,omitempty"` // defaults to APPLIED on create
+	AppliedAt      string                     `protobuf:"bytes,9,opt,name=applied_at,json=appliedAt,proto3" json:"applied_at,omitempty"`         // RFC 3339; defaults to creation time
+	Notes          string                     `protobuf:"bytes,10,opt,name=notes,proto3" json:"notes,omitempty"`
+	History        []*ApplicationStatusChange `protobuf:"bytes,11,rep,name=history,proto3" json:"history,omitempty"` // oldest first
+	CreatedAt      string                     `protobuf:"bytes,12,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt      string                     `protobuf:"bytes,13,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *Application) Reset() {
+	*x = Application{}
+	mi := &file_shared_proto_resume_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Application) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Application) ProtoMessage() {}
+
+func (x *Application) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_resume_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Application.ProtoReflect.Descriptor instead.
+func (*Application) Descriptor() ([]byte, []int) {
+	return file_shared_proto_resume_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *Application) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Application) GetResumeId() string {
+	if x != nil {
+		return x.ResumeId
+	}
+	return ""
+}
+
+func (x *Application) GetResumeRevision() int32 {
+	if x != nil {
+		return x.ResumeRevision
+	}
+	return 0
+}
+
+func (x *Application) GetCompany() string {
+	if x != nil {
+		return x.Company
+	}
+	return ""
+}
+
+func (x *Application) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *Application) GetJobDescription() string {
+	if x != nil {
+		return x.JobDescription
+	}
+	return ""
+}
+
+func (x *Application) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *Application) GetStatus() ApplicationStatus {
+	if x != nil {
+		return x.Status
+	}
+	return ApplicationStatus_APPLICATION_STATUS_UNSPECIFIED
+}
+
+func (x *Application) GetAppliedAt() string {
+	if x != nil {
+		return x.AppliedAt
+	}
+	return ""
+}
+
+func (x *Application) GetNotes() string {
+	if x != nil {
+		return x.Notes
+	}
+	return ""
+}
+
+func (x *Application) GetHistory() []*ApplicationStatusChange {
+	if x != nil {
+		return x.History
+	}
+	return nil
+}
+
+func (x *Application) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *Application) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+type CreateApplicationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Application   *Application           `protobuf:"bytes,1,opt,name=application,proto3" json:"application,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateApplicationRequest) Reset() {
+	*x = CreateApplicationRequest{}
+	mi := &file_shared_proto_resume_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateApplicationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateApplicationRequest) ProtoMessage() {}
+
+func (x *CreateApplicationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_resume_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateApplicationRequest.ProtoReflect.Descriptor instead.
+func (*CreateApplicationRequest) Descriptor() ([]byte, []int) {
+	return file_shared_proto_resume_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *CreateApplicationRequest) GetApplication() *Application {
+	if x != nil {
+		return x.Application
+	}
+	return nil
+}
+
+type GetApplicationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetApplicationRequest) Reset() {
+	*x = GetApplicationRequest{}
+	mi := &file_shared_proto_resume_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetApplicationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetApplicationRequest) ProtoMessage() {}
+
+func (x *GetApplicationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_resume_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetApplicationRequest.ProtoReflect.Descriptor instead.
+func (*GetApplicationRequest) Descriptor() ([]byte, []int) {
+	return file_shared_proto_resume_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *GetApplicationRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type ListApplicationsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Statuses      []ApplicationStatus    `protobuf:"varint,1,rep,packed,name=statuses,proto3,enum=resume.ApplicationStatus" json:"statuses,omitempty"` // all statuses when empty
+	ResumeId      string                 `protobuf:"bytes,2,opt,name=resume_id,json=resumeId,proto3" json:"resume_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListApplicationsRequest) Reset() {
+	*x = ListApplicationsRequest{}
+	mi := &file_shared_proto_resume_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListApplicationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListApplicationsRequest) ProtoMessage() {}
+
+func (x *ListApplicationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_resume_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListApplicationsRequest.ProtoReflect.Descriptor instead.
+func (*ListApplicationsRequest) Descriptor() ([]byte, []int) {
+	return file_shared_proto_resume_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *ListApplicationsRequest) GetStatuses() []ApplicationStatus {
+	if x != nil {
+		return x.Statuses
+	}
+	return nil
+}
+
+func (x *ListApplicationsRequest) GetResumeId() string {
+	if x != nil {
+		return x.ResumeId
+	}
+	return ""
+}
+
+type ListApplicationsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Applications  []*Application         `protobuf:"bytes,1,rep,name=applications,proto3" json:"applications,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListApplicationsResponse) Reset() {
+	*x = ListApplicationsResponse{}
+	mi := &file_shared_proto_resume_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListApplicationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListApplicationsResponse) ProtoMessage() {}
+
+func (x *ListApplicationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_resume_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListApplicationsResponse.ProtoReflect.Descriptor instead.
+func (*ListApplicationsResponse) Descriptor() ([]byte, []int) {
+	return file_shared_proto_resume_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *ListApplicationsResponse) GetApplications() []*Application {
+	if x != nil {
+		return x.Applications
+	}
+	return nil
+}
+
+// UpdateApplicationRequest replaces the editable fields of an application.
+// A status change is appended to its history with status_note.
+type UpdateApplicationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Application   *Application           `protobuf:"bytes,1,opt,name=application,proto3" json:"application,omitempty"`
+	StatusNote    string                 `protobuf:"bytes,2,opt,name=status_note,json=statusNote,proto3" json:"status_note,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateApplicationRequest) Reset() {
+	*x = UpdateApplicationRequest{}
+	mi := &file_shared_proto_resume_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateApplicationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateApplicationRequest) ProtoMessage() {}
+
+func (x *UpdateApplicationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_resume_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateApplicationRequest.ProtoReflect.Descriptor instead.
+func (*UpdateApplicationRequest) Descriptor() ([]byte, []int) {
+	return file_shared_proto_resume_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *UpdateApplicationRequest) GetApplication() *Application {
+	if x != nil {
+		return x.Application
+	}
+	return nil
+}
+
+func (x *UpdateApplicationRequest) GetStatusNote() string {
+	if x != nil {
+		return x.StatusNote
+	}
+	return ""
+}
+
+type DeleteApplicationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteApplicationRequest) Reset() {
+	*x = DeleteApplicationRequest{}
+	mi := &file_shared_proto_resume_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteApplicationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteApplicationRequest) ProtoMessage() {}
+
+func (x *DeleteApplicationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_resume_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteApplicationRequest.ProtoReflect.Descriptor instead.
+func (*DeleteApplicationRequest) Descriptor() ([]byte, []int) {
+	return file_shared_proto_resume_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *DeleteApplicationRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type DeleteApplicationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteApplicationResponse) Reset() {
+	*x = DeleteApplicationResponse{}
+	mi := &file_shared_proto_resume_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteApplicationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteApplicationResponse) ProtoMessage() {}
+
+func (x *DeleteApplicationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_resume_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteApplicationResponse.ProtoReflect.Descriptor instead.
+func (*DeleteApplicationResponse) Descriptor() ([]byte, []int) {
+	return file_shared_proto_resume_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *DeleteApplicationResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+// JobDescription is a saved job posting. Keywords are extracted by the
+// ATS scorer whenever it is read, so they follow changes to extraction.
+type JobDescription struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Company       string                 `protobuf:"bytes,3,opt,name=company,proto3" json:"company,omitempty"`
+	SourceUrl     string                 `protobuf:"bytes,4,opt,name=source_url,json=sourceUrl,proto3" json:"source_url,omitempty"`
+	Text          string                 `protobuf:"bytes,5,opt,name=text,proto3" json:"text,omitempty"`
+	Keywords      []string               `protobuf:"bytes,6,rep,name=keywords,proto3" json:"keywords,omitempty"`
+	CreatedAt     string                 `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *JobDescription) Reset() {
+	*x = JobDescription{}
+	mi := &file_shared_proto_resume_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *JobDescription) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JobDescription) ProtoMessage() {}
+
+func (x *JobDescription) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_resume_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JobDescription.ProtoReflect.Descriptor instead.
+func (*JobDescription) Descriptor() ([]byte, []int) {
+	return file_shared_proto_resume_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *JobDescription) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *JobDescription) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *JobDescription) GetCompany() string {
+	if x != nil {
+		return x.Company
+	}
+	return ""
+}
+
+func (x *JobDescription) GetSourceUrl() string {
+	if x != nil {
+		return x.SourceUrl
+	}
+	return ""
+}
+
+func (x *JobDescription) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *JobDescription) GetKeywords() []string {
+	if x != nil {
+		return x.Keywords
+	}
+	return nil
+}
+
+func (x *JobDescription) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+type SaveJobDescriptionRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	JobDescription *JobDescription        `protobuf:"bytes,1,opt,name=job_description,json=jobDescription,proto3" json:"job_description,omitempty"` // id and keywords are ignored
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *SaveJobDescriptionRequest) Reset() {
+	*x = SaveJobDescriptionRequest{}
+	mi := &file_shared_proto_resume_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SaveJobDescriptionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SaveJobDescriptionRequest) ProtoMessage() {}
+
+func (x *SaveJobDescriptionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_resume_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SaveJobDescriptionRequest.ProtoReflect.Descriptor instead.
+func (*SaveJobDescriptionRequest) Descriptor() ([]byte, []int) {
+	return file_shared_proto_resume_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *SaveJobDescriptionRequest) GetJobDescription() *JobDescription {
+	if x != nil {
+		return x.JobDescription
+	}
+	return nil
+}
+
+type GetJobDescriptionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetJobDescriptionRequest) Reset() {
+	*x = GetJobDescriptionRequest{}
+	mi := &file_shared_proto_resume_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetJobDescriptionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetJobDescriptionRequest) ProtoMessage() {}
+
+func (x *GetJobDescriptionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_resume_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetJobDescriptionRequest.ProtoReflect.Descriptor instead.
+func (*GetJobDescriptionRequest) Descriptor() ([]byte, []int) {
+	return file_shared_proto_resume_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *GetJobDescriptionRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type ListJobDescriptionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Company       string                 `protobuf:"bytes,1,opt,name=company,proto3" json:"company,omitempty"` // case-insensitive exact match when set
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListJobDescriptionsRequest) Reset() {
+	*x = ListJobDescriptionsRequest{}
+	mi := &file_shared_proto_resume_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListJobDescriptionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListJobDescriptionsRequest) ProtoMessage() {}
+
+func (x *ListJobDescriptionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_resume_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListJobDescriptionsRequest.ProtoReflect.Descriptor instead.
+func (*ListJobDescriptionsRequest) Descriptor() ([]byte, []int) {
+	return file_shared_proto_resume_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *ListJobDescriptionsRequest) GetCompany() string {
+	if x != nil {
+		return x.Company
+	}
+	return ""
+}
+
+type ListJobDescriptionsResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	JobDescriptions []*JobDescription      `protobuf:"bytes,1,rep,name=job_descriptions,json=jobDescriptions,proto3" json:"job_descriptions,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ListJobDescriptionsResponse) Reset() {
+	*x = ListJobDescriptionsResponse{}
+	mi := &file_shared_proto_resume_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListJobDescriptionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListJobDescriptionsResponse) ProtoMessage() {}
+
+func (x *ListJobDescriptionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_resume_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListJobDescriptionsResponse.ProtoReflect.Descriptor instead.
+func (*ListJobDescriptionsResponse) Descriptor() ([]byte, []int) {
+	return file_shared_proto_resume_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *ListJobDescriptionsResponse) GetJobDescriptions() []*JobDescription {
+	if x != nil {
+		return x.JobDescriptions
+	}
+	return nil
+}
+
+// Analysis is a stored ATS result for a resume revision against a job description.
+type Analysis struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Id               string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ResumeId         string                 `protobuf:"bytes,2,opt,name=resume_id,json=resumeId,proto3" json:"resume_id,omitempty"`
+	ResumeRevision   int32                  `protobuf:"varint,3,opt,name=resume_revision,json=resumeRevision,proto3" json:"resume_revision,omitempty"`
+	JobDescriptionId string                 `protobuf:"bytes,4,opt,name=job_description_id,json=jobDescriptionId,proto3" json:"job_description_id,omitempty"`
+	Score            int32                  `protobuf:"varint,5,opt,name=score,proto3" json:"score,omitempty"`
+	Feedback         []string               `protobuf:"bytes,6,rep,name=feedback,proto3" json:"feedback,omitempty"`
+	MissingKeywords  []string               `protobuf:"bytes,7,rep,name=missing_keywords,json=missingKeywords,proto3" json:"missing_keywords,omitempty"`
+	Reasoning        string                 `protobuf:"bytes,8,opt,name=reasoning,proto3" json:"reasoning,omitempty"`
+	Source           AnalysisSource         `protobuf:"varint,9,opt,name=source,proto3,enum=resume.AnalysisSource" json:"source,omitempty"`
+	CreatedAt        string                 `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *Analysis) Reset() {
+	*x = Analysis{}
+	mi := &file_shared_proto_resume_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Analysis) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Analysis) ProtoMessage() {}
+
+func (x *Analysis) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_resume_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Analysis.ProtoReflect.Descriptor instead.
+func (*Analysis) Descriptor() ([]byte, []int) {
+	return file_shared_proto_resume_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *Analysis) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Analysis) GetResumeId() string {
+	if x != nil {
+		return x.ResumeId
+	}
+	return ""
+}
+
+func (x *Analysis) GetResumeRevision() int32 {
+	if x != nil {
+		return x.ResumeRevision
+	}
+	return 0
+}
+
+func (x *Analysis) GetJobDescriptionId() string {
+	if x != nil {
+		return x.JobDescriptionId
+	}
+	return ""
+}
+
+func (x *Analysis) GetScore() int32 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *Analysis) GetFeedback() []string {
+	if x != nil {
+		return x.Feedback
+	}
+	return nil
+}
+
+func (x *Analysis) GetMissingKeywords() []string {
+	if x != nil {
+		return x.MissingKeywords
+	}
+	return nil
+}
+
+func (x *Analysis) GetReasoning() string {
+	if x != nil {
+		return x.Reasoning
+	}
+	return ""
+}
+
+func (x *Analysis) GetSource() AnalysisSource {
+	if x != nil {
+		return x.Source
+	}
+	return AnalysisSource_ANALYSIS_SOURCE_UNSPECIFIED
+}
+
+func (x *Analysis) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+type SaveAnalysisRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Analysis      *Analysis              `protobuf:"bytes,1,opt,name=analysis,proto3" json:"analysis,omitempty"` // id and created_at are ignored
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SaveAnalysisRequest) Reset() {
+	*x = SaveAnalysisRequest{}
+	mi := &file_shared_proto_resume_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SaveAnalysisRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SaveAnalysisRequest) ProtoMessage() {}
+
+func (x *SaveAnalysisRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_resume_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SaveAnalysisRequest.ProtoReflect.Descriptor instead.
+func (*SaveAnalysisRequest) Descriptor() ([]byte, []int) {
+	return file_shared_proto_resume_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *SaveAnalysisRequest) GetAnalysis() *Analysis {
+	if x != nil {
+		return x.Analysis
+	}
+	return nil
+}
+
+type ListAnalysesRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	ResumeId         string                 `protobuf:"bytes,1,opt,name=resume_id,json=resumeId,proto3" json:"resume_id,omitempty"`
+	JobDescriptionId string                 `protobuf:"bytes,2,opt,name=job_description_id,json=jobDescriptionId,proto3" json:"job_description_id,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *ListAnalysesRequest) Reset() {
+	*x = ListAnalysesRequest{}
+	mi := &file_shared_proto_resume_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAnalysesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAnalysesRequest) ProtoMessage() {}
+
+func (x *ListAnalysesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_resume_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAnalysesRequest.ProtoReflect.Descriptor instead.
+func (*ListAnalysesRequest) Descriptor() ([]byte, []int) {
+	return file_shared_proto_resume_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *ListAnalysesRequest) GetResumeId() string {
+	if x != nil {
+		return x.ResumeId
+	}
+	return ""
+}
+
+func (x *ListAnalysesRequest) GetJobDescriptionId() string {
+	if x != nil {
+		return x.JobDescriptionId
+	}
+	return ""
+}
+
+// ListAnalysesResponse lists analyses oldest first.
+type ListAnalysesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Analyses      []*Analysis            `protobuf:"bytes,1,rep,name=analyses,proto3" json:"analyses,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAnalysesResponse) Reset() {
+	*x = ListAnalysesResponse{}
+	mi := &file_shared_proto_resume_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAnalysesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAnalysesResponse) ProtoMessage() {}
+
+func (x *ListAnalysesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_resume_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAnalysesResponse.ProtoReflect.Descriptor instead.
+func (*ListAnalysesResponse) Descriptor() ([]byte, []int) {
+	return file_shared_proto_resume_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *ListAnalysesResponse) GetAnalyses() []*Analysis {
+	if x != nil {
+		return x.Analyses
+	}
+	return nil
+}
+
+// DocumentLinks ties a generated document to what it was written for.
+// Every link is optional; a linked application, resume or job description
+// must belong to the caller.
+type DocumentLinks struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	ApplicationId    string                 `protobuf:"bytes,1,opt,name=application_id,json=applicationId,proto3" json:"application_id,omitempty"`
+	ResumeId         string                 `protobuf:"bytes,2,opt,name=resume_id,json=resumeId,proto3" json:"resume_id,omitempty"`
+	JobDescriptionId string                 `protobuf:"bytes,3,opt,name=job_description_id,json=jobDescriptionId,proto3" json:"job_description_id,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *DocumentLinks) Reset() {
+	*x = DocumentLinks{}
+	mi := &file_shared_proto_resume_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DocumentLinks) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DocumentLinks) ProtoMessage() {}
+
+func (x *DocumentLinks) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_resume_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DocumentLinks.ProtoReflect.Descriptor instead.
+func (*DocumentLinks) Descriptor() ([]byte, []int) {
+	return file_shared_proto_resume_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *DocumentLinks) GetApplicationId() string {
+	if x != nil {
+		return x.ApplicationId
+	}
+	return ""
+}
+
+func (x *DocumentLinks) GetResumeId() string {
+	if x != nil {
+		return x.ResumeId
+	}
+	return ""
+}
+
+func (x *DocumentLinks) GetJobDescriptionId() string {
+	if x != nil {
+		return x.JobDescriptionId
+	}
+	return ""
+}
+
+type CoverLetter struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Links         *DocumentLinks         `protobuf:"bytes,2,opt,name=links,proto3" json:"links,omitempty"`
+	Content       string                 `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`
+	CreatedAt     string                 `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     string                 `protobuf:"bytes,5,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CoverLetter) Reset() {
+	*x = CoverLetter{}
+	mi := &file_shared_proto_resume_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CoverLetter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CoverLetter) ProtoMessage() {}
+
+func (x *CoverLetter) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_resume_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CoverLetter.ProtoReflect.Descriptor instead.
+func (*CoverLetter) Descriptor() ([]byte, []int) {
+	return file_shared_proto_resume_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *CoverLetter) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *CoverLetter) GetLinks() *DocumentLinks {
+	if x != nil {
+		return x.Links
+	}
+	return nil
+}
+
+func (x *CoverLetter) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *CoverLetter) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *CoverLetter) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+type SaveCoverLetterRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CoverLetter   *CoverLetter           `protobuf:"bytes,1,opt,name=cover_letter,json=coverLetter,proto3" json:"cover_letter,omitempty"` // id and timestamps are ignored
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SaveCoverLetterRequest) Reset() {
+	*x = SaveCoverLetterRequest{}
+	mi := &file_shared_proto_resume_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SaveCoverLetterRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SaveCoverLetterRequest) ProtoMessage() {}
+
+func (x *SaveCoverLetterRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_resume_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SaveCoverLetterRequest.ProtoReflect.Descriptor instead.
+func (*SaveCoverLetterRequest) Descriptor() ([]byte, []int) {
+	return file_shared_proto_resume_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *SaveCoverLetterRequest) GetCoverLetter() *CoverLetter {
+	if x != nil {
+		return x.CoverLetter
+	}
+	return nil
+}
+
+type UpdateCoverLetterRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Content       string                 `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateCoverLetterRequest) Reset() {
+	*x = UpdateCoverLetterRequest{}
+	mi := &file_shared_proto_resume_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateCoverLetterRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateCoverLetterRequest) ProtoMessage() {}
+
+func (x *UpdateCoverLetterRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_resume_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateCoverLetterRequest.ProtoReflect.Descriptor instead.
+func (*UpdateCoverLetterRequest) Descriptor() ([]byte, []int) {
+	return file_shared_proto_resume_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *UpdateCoverLetterRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateCoverLetterRequest) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+// ListCoverLettersRequest filters by every link that is set, newest first.
+type ListCoverLettersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Links         *DocumentLinks         `protobuf:"bytes,1,opt,name=links,proto3" json:"links,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCoverLettersRequest) Reset() {
+	*x = ListCoverLettersRequest{}
+	mi := &file_shared_proto_resume_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCoverLettersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCoverLettersRequest) ProtoMessage() {}
+
+func (x *ListCoverLettersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_resume_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCoverLettersRequest.ProtoReflect.Descriptor instead.
+func (*ListCoverLettersRequest) Descriptor() ([]byte, []int) {
+	return file_shared_proto_resume_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *ListCoverLettersRequest) GetLinks() *DocumentLinks {
+	if x != nil {
+		return x.Links
+	}
+	return nil
+}
+
+type ListCoverLettersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CoverLetters  []*CoverLetter         `protobuf:"bytes,1,rep,name=cover_letters,json=coverLetters,proto3" json:"cover_letters,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCoverLettersResponse) Reset() {
+	*x = ListCoverLettersResponse{}
+	mi := &file_shared_proto_resume_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCoverLettersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCoverLettersResponse) ProtoMessage() {}
+
+func (x *ListCoverLettersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_resume_proto_msgTypes[71]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCoverLettersResponse.ProtoReflect.Descriptor instead.
+func (*ListCoverLettersResponse) Descriptor() ([]byte, []int) {
+	return file_shared_proto_resume_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *ListCoverLettersResponse) GetCoverLetters() []*CoverLetter {
+	if x != nil {
+		return x.CoverLetters
+	}
+	return nil
+}
+
+type DeleteCoverLetterRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteCoverLetterRequest) Reset() {
+	*x = DeleteCoverLetterRequest{}
+	mi := &file_shared_proto_resume_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteCoverLetterRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCoverLetterRequest) ProtoMessage() {}
+
+func (x *DeleteCoverLetterRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_resume_proto_msgTypes[72]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCoverLetterRequest.ProtoReflect.Descriptor instead.
+func (*DeleteCoverLetterRequest) Descriptor() ([]byte, []int) {
+	return file_shared_proto_resume_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *DeleteCoverLetterRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type DeleteCoverLetterResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteCoverLetterResponse) Reset() {
+	*x = DeleteCoverLetterResponse{}
+	mi := &file_shared_proto_resume_proto_msgTypes[73]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteCoverLetterResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCoverLetterResponse) ProtoMessage() {}
+
+func (x *DeleteCoverLetterResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_resume_proto_msgTypes[73]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCoverLetterResponse.ProtoReflect.Descriptor instead.
+func (*DeleteCoverLetterResponse) Descriptor() ([]byte, []int) {
+	return file_shared_proto_resume_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *DeleteCoverLetterResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+// PracticeQuestion is an interview question saved for practice.
+type PracticeQuestion struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Question      string                 `protobuf:"bytes,2,opt,name=question,proto3" json:"question,omitempty"`
+	Type          string                 `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
+	AnswerGuide   string                 `protobuf:"bytes,4,opt,name=answer_guide,json=answerGuide,proto3" json:"answer_guide,omitempty"`
+	Practiced     bool                   `protobuf:"varint,5,opt,name=practiced,proto3" json:"practiced,omitempty"`
+	UserAnswer    string                 `protobuf:"bytes,6,opt,name=user_answer,json=userAnswer,proto3" json:"user_answer,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PracticeQuestion) Reset() {
+	*x = PracticeQuestion{}
+	mi := &file_shared_proto_resume_proto_msgTypes[74]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PracticeQuestion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PracticeQuestion) ProtoMessage() {}
+
+func (x *PracticeQuestion) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_resume_proto_msgTypes[74]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PracticeQuestion.ProtoReflect.Descriptor instead.
+func (*PracticeQuestion) Descriptor() ([]byte, []int) {
+	return file_shared_proto_resume_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *PracticeQuestion) GetId() string {
+	if x != nil {
+		return x.Id
 	}
 	return ""
 }
 
-type ListResumesRequest struct {
+func (x *PracticeQuestion) GetQuestion() string {
+	if x != nil {
+		return x.Question
+	}
+	return ""
+}
+
+func (x *PracticeQuestion) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *PracticeQuestion) GetAnswerGuide() string {
+	if x != nil {
+		return x.AnswerGuide
+	}
+	return ""
+}
+
+func (x *PracticeQuestion) GetPracticed() bool {
+	if x != nil {
+		return x.Practiced
+	}
+	return false
+}
+
+func (x *PracticeQuestion) GetUserAnswer() string {
+	if x != nil {
+		return x.UserAnswer
+	}
+	return ""
+}
+
+// QuestionSet is a saved set of interview questions, in generated order.
+type QuestionSet struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Tags          []string               `protobuf:"bytes,1,rep,name=tags,proto3" json:"tags,omitempty"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Links         *DocumentLinks         `protobuf:"bytes,2,opt,name=links,proto3" json:"links,omitempty"`
+	Questions     []*PracticeQuestion    `protobuf:"bytes,3,rep,name=questions,proto3" json:"questions,omitempty"`
+	CreatedAt     string                 `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     string                 `protobuf:"bytes,5,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListResumesRequest) Reset() {
-	*x = ListResumesRequest{}
-	mi := &file_shared_proto_resume_proto_msgTypes[17]
+func (x *QuestionSet) Reset() {
+	*x = QuestionSet{}
+	mi := &file_shared_proto_resume_proto_msgTypes[75]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListResumesRequest) String() string {
+func (x *QuestionSet) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListResumesRequest) ProtoMessage() {}
+func (*QuestionSet) ProtoMessage() {}
 
-func (x *ListResumesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_shared_proto_resume_proto_msgTypes[17]
+func (x *QuestionSet) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_resume_proto_msgTypes[75]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1189,40 +4856,68 @@ func (x *ListResumesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListResumesRequest.ProtoReflect.Descriptor instead.
-func (*ListResumesRequest) Descriptor() ([]byte, []int) {
-	return file_shared_proto_resume_proto_rawDescGZIP(), []int{17}
+// Deprecated: Use QuestionSet.ProtoReflect.Descriptor instead.
+func (*QuestionSet) Descriptor() ([]byte, []int) {
+	return file_shared_proto_resume_proto_rawDescGZIP(), []int{75}
 }
 
-func (x *ListResumesRequest) GetTags() []string {
+func (x *QuestionSet) GetId() string {
 	if x != nil {
-		return x.Tags
+		return x.Id
+	}
+	return ""
+}
+
+func (x *QuestionSet) GetLinks() *DocumentLinks {
+	if x != nil {
+		return x.Links
 	}
 	return nil
 }
 
-type ListResumesResponse struct {
+func (x *QuestionSet) GetQuestions() []*PracticeQuestion {
+	if x != nil {
+		return x.Questions
+	}
+	return nil
+}
+
+func (x *QuestionSet) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *QuestionSet) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+type SaveQuestionSetRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Resumes       []*SavedResume         `protobuf:"bytes,1,rep,name=resumes,proto3" json:"resumes,omitempty"`
+	QuestionSet   *QuestionSet           `protobuf:"bytes,1,opt,name=question_set,json=questionSet,proto3" json:"question_set,omitempty"` // ids and timestamps are ignored
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListResumesResponse) Reset() {
-	*x = ListResumesResponse{}
-	mi := &file_shared_proto_resume_proto_msgTypes[18]
+func (x *SaveQuestionSetRequest) Reset() {
+	*x = SaveQuestionSetRequest{}
+	mi := &file_shared_proto_resume_proto_msgTypes[76]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListResumesResponse) String() string {
+func (x *SaveQuestionSetRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListResumesResponse) ProtoMessage() {}
+func (*SaveQuestionSetRequest) ProtoMessage() {}
 
-func (x *ListResumesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_shared_proto_resume_proto_msgTypes[18]
+func (x *SaveQuestionSetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_resume_proto_msgTypes[76]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1233,40 +4928,134 @@ func (x *ListResumesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListResumesResponse.ProtoReflect.Descriptor instead.
-func (*ListResumesResponse) Descriptor() ([]byte, []int) {
-	return file_shared_proto_resume_proto_rawDescGZIP(), []int{18}
+// Deprecated: Use SaveQuestionSetRequest.ProtoReflect.Descriptor instead.
+func (*SaveQuestionSetRequest) Descriptor() ([]byte, []int) {
+	return file_shared_proto_resume_proto_rawDescGZIP(), []int{76}
 }
 
-func (x *ListResumesResponse) GetResumes() []*SavedResume {
+func (x *SaveQuestionSetRequest) GetQuestionSet() *QuestionSet {
 	if x != nil {
-		return x.Resumes
+		return x.QuestionSet
 	}
 	return nil
 }
 
-type DeleteResumeRequest struct {
+// ListQuestionSetsRequest filters by every link that is set, newest first.
+type ListQuestionSetsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Links         *DocumentLinks         `protobuf:"bytes,1,opt,name=links,proto3" json:"links,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListQuestionSetsRequest) Reset() {
+	*x = ListQuestionSetsRequest{}
+	mi := &file_shared_proto_resume_proto_msgTypes[77]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListQuestionSetsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListQuestionSetsRequest) ProtoMessage() {}
+
+func (x *ListQuestionSetsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_resume_proto_msgTypes[77]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListQuestionSetsRequest.ProtoReflect.Descriptor instead.
+func (*ListQuestionSetsRequest) Descriptor() ([]byte, []int) {
+	return file_shared_proto_resume_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *ListQuestionSetsRequest) GetLinks() *DocumentLinks {
+	if x != nil {
+		return x.Links
+	}
+	return nil
+}
+
+type ListQuestionSetsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	QuestionSets  []*QuestionSet         `protobuf:"bytes,1,rep,name=question_sets,json=questionSets,proto3" json:"question_sets,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListQuestionSetsResponse) Reset() {
+	*x = ListQuestionSetsResponse{}
+	mi := &file_shared_proto_resume_proto_msgTypes[78]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListQuestionSetsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListQuestionSetsResponse) ProtoMessage() {}
+
+func (x *ListQuestionSetsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_resume_proto_msgTypes[78]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListQuestionSetsResponse.ProtoReflect.Descriptor instead.
+func (*ListQuestionSetsResponse) Descriptor() ([]byte, []int) {
+	return file_shared_proto_resume_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *ListQuestionSetsResponse) GetQuestionSets() []*QuestionSet {
+	if x != nil {
+		return x.QuestionSets
+	}
+	return nil
+}
+
+// UpdatePracticeQuestionRequest replaces the editable fields of a question.
+type UpdatePracticeQuestionRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Question      string                 `protobuf:"bytes,2,opt,name=question,proto3" json:"question,omitempty"`
+	AnswerGuide   string                 `protobuf:"bytes,3,opt,name=answer_guide,json=answerGuide,proto3" json:"answer_guide,omitempty"`
+	Practiced     bool                   `protobuf:"varint,4,opt,name=practiced,proto3" json:"practiced,omitempty"`
+	UserAnswer    string                 `protobuf:"bytes,5,opt,name=user_answer,json=userAnswer,proto3" json:"user_answer,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteResumeRequest) Reset() {
-	*x = DeleteResumeRequest{}
-	mi := &file_shared_proto_resume_proto_msgTypes[19]
+func (x *UpdatePracticeQuestionRequest) Reset() {
+	*x = UpdatePracticeQuestionRequest{}
+	mi := &file_shared_proto_resume_proto_msgTypes[79]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteResumeRequest) String() string {
+func (x *UpdatePracticeQuestionRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteResumeRequest) ProtoMessage() {}
+func (*UpdatePracticeQuestionRequest) ProtoMessage() {}
 
-func (x *DeleteResumeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_shared_proto_resume_proto_msgTypes[19]
+func (x *UpdatePracticeQuestionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_resume_proto_msgTypes[79]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1277,40 +5066,112 @@ func (x *DeleteResumeRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteResumeRequest.ProtoReflect.Descriptor instead.
-func (*DeleteResumeRequest) Descriptor() ([]byte, []int) {
-	return file_shared_proto_resume_proto_rawDescGZIP(), []int{19}
+// Deprecated: Use UpdatePracticeQuestionRequest.ProtoReflect.Descriptor instead.
+func (*UpdatePracticeQuestionRequest) Descriptor() ([]byte, []int) {
+	return file_shared_proto_resume_proto_rawDescGZIP(), []int{79}
 }
 
-func (x *DeleteResumeRequest) GetId() string {
+func (x *UpdatePracticeQuestionRequest) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-type DeleteResumeResponse struct {
+func (x *UpdatePracticeQuestionRequest) GetQuestion() string {
+	if x != nil {
+		return x.Question
+	}
+	return ""
+}
+
+func (x *UpdatePracticeQuestionRequest) GetAnswerGuide() string {
+	if x != nil {
+		return x.AnswerGuide
+	}
+	return ""
+}
+
+func (x *UpdatePracticeQuestionRequest) GetPracticed() bool {
+	if x != nil {
+		return x.Practiced
+	}
+	return false
+}
+
+func (x *UpdatePracticeQuestionRequest) GetUserAnswer() string {
+	if x != nil {
+		return x.UserAnswer
+	}
+	return ""
+}
+
+type DeleteQuestionSetRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteQuestionSetRequest) Reset() {
+	*x = DeleteQuestionSetRequest{}
+	mi := &file_shared_proto_resume_proto_msgTypes[80]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteQuestionSetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteQuestionSetRequest) ProtoMessage() {}
+
+func (x *DeleteQuestionSetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_resume_proto_msgTypes[80]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteQuestionSetRequest.ProtoReflect.Descriptor instead.
+func (*DeleteQuestionSetRequest) Descriptor() ([]byte, []int) {
+	return file_shared_proto_resume_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *DeleteQuestionSetRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type DeleteQuestionSetResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteResumeResponse) Reset() {
-	*x = DeleteResumeResponse{}
-	mi := &file_shared_proto_resume_proto_msgTypes[20]
+func (x *DeleteQuestionSetResponse) Reset() {
+	*x = DeleteQuestionSetResponse{}
+	mi := &file_shared_proto_resume_proto_msgTypes[81]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteResumeResponse) String() string {
+func (x *DeleteQuestionSetResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteResumeResponse) ProtoMessage() {}
+func (*DeleteQuestionSetResponse) ProtoMessage() {}
 
-func (x *DeleteResumeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_shared_proto_resume_proto_msgTypes[20]
+func (x *DeleteQuestionSetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_resume_proto_msgTypes[81]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1321,12 +5182,12 @@ func (x *DeleteResumeResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteResumeResponse.ProtoReflect.Descriptor instead.
-func (*DeleteResumeResponse) Descriptor() ([]byte, []int) {
-	return file_shared_proto_resume_proto_rawDescGZIP(), []int{20}
+// Deprecated: Use DeleteQuestionSetResponse.ProtoReflect.Descriptor instead.
+func (*DeleteQuestionSetResponse) Descriptor() ([]byte, []int) {
+	return file_shared_proto_resume_proto_rawDescGZIP(), []int{81}
 }
 
-func (x *DeleteResumeResponse) GetSuccess() bool {
+func (x *DeleteQuestionSetResponse) GetSuccess() bool {
 	if x != nil {
 		return x.Success
 	}
@@ -1409,7 +5270,10 @@ const file_shared_proto_resume_proto_rawDesc = "" +
 	"\x04type\x18\x02 \x01(\tR\x04type\x12!\n" +
 	"\fanswer_guide\x18\x03 \x01(\tR\vanswerGuide\"P\n" +
 	"\x15InterviewPrepResponse\x127\n" +
-	"\tquestions\x18\x01 \x03(\v2\x19.resume.InterviewQuestionR\tquestions\"k\n" +
+	"\tquestions\x18\x01 \x03(\v2\x19.resume.InterviewQuestionR\tquestions\"R\n" +
+	"\x12ParseResumeRequest\x12\x12\n" +
+	"\x04text\x18\x01 \x01(\tR\x04text\x12(\n" +
+	"\x05draft\x18\x02 \x01(\v2\x12.resume.ResumeDataR\x05draft\"k\n" +
 	"\x14AnalyzeResumeRequest\x12*\n" +
 	"\x06resume\x18\x01 \x01(\v2\x12.resume.ResumeDataR\x06resume\x12'\n" +
 	"\x0fjob_description\x18\x02 \x01(\tR\x0ejobDescription\"\x92\x01\n" +
@@ -1417,7 +5281,15 @@ const file_shared_proto_resume_proto_rawDesc = "" +
 	"\x05score\x18\x01 \x01(\x05R\x05score\x12\x1a\n" +
 	"\bfeedback\x18\x02 \x03(\tR\bfeedback\x12)\n" +
 	"\x10missing_keywords\x18\x03 \x03(\tR\x0fmissingKeywords\x12\x1c\n" +
-	"\treasoning\x18\x04 \x01(\tR\treasoning\"\x9f\x01\n" +
+	"\treasoning\x18\x04 \x01(\tR\treasoning\"y\n" +
+	"\x04User\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x18\n" +
+	"\asubject\x18\x02 \x01(\tR\asubject\x12\x14\n" +
+	"\x05email\x18\x03 \x01(\tR\x05email\x12\x12\n" +
+	"\x04name\x18\x04 \x01(\tR\x04name\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\tR\tcreatedAt\"\x17\n" +
+	"\x15GetCurrentUserRequest\"\x94\x02\n" +
 	"\vSavedResume\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x123\n" +
 	"\vresume_data\x18\x02 \x01(\v2\x12.resume.ResumeDataR\n" +
@@ -1425,28 +5297,338 @@ const file_shared_proto_resume_proto_rawDesc = "" +
 	"\x04tags\x18\x03 \x03(\tR\x04tags\x12\x18\n" +
 	"\aversion\x18\x04 \x01(\tR\aversion\x12\x1d\n" +
 	"\n" +
-	"created_at\x18\x05 \x01(\tR\tcreatedAt\"m\n" +
+	"created_at\x18\x05 \x01(\tR\tcreatedAt\x12\x1a\n" +
+	"\brevision\x18\x06 \x01(\x05R\brevision\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\a \x01(\tR\tupdatedAt\x12\x1d\n" +
+	"\n" +
+	"deleted_at\x18\b \x01(\tR\tdeletedAt\x12\x19\n" +
+	"\bowner_id\x18\t \x01(\tR\aownerId\"}\n" +
 	"\x11SaveResumeRequest\x12*\n" +
 	"\x06resume\x18\x01 \x01(\v2\x12.resume.ResumeDataR\x06resume\x12\x12\n" +
 	"\x04tags\x18\x02 \x03(\tR\x04tags\x12\x18\n" +
-	"\aversion\x18\x03 \x01(\tR\aversion\"(\n" +
+	"\aversion\x18\x03 \x01(\tR\aversion\x12\x0e\n" +
+	"\x02id\x18\x04 \x01(\tR\x02id\"\"\n" +
+	"\x10GetResumeRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"~\n" +
+	"\x13UpdateResumeRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12+\n" +
+	"\x11expected_revision\x18\x02 \x01(\x05R\x10expectedRevision\x12*\n" +
+	"\x06resume\x18\x03 \x01(\v2\x12.resume.ResumeDataR\x06resume\"\xca\x01\n" +
 	"\x12ListResumesRequest\x12\x12\n" +
-	"\x04tags\x18\x01 \x03(\tR\x04tags\"D\n" +
+	"\x04tags\x18\x01 \x03(\tR\x04tags\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tR\tpageToken\x120\n" +
+	"\asort_by\x18\x04 \x01(\x0e2\x17.resume.ResumeSortFieldR\x06sortBy\x12\x1c\n" +
+	"\tascending\x18\x05 \x01(\bR\tascending\x12\x14\n" +
+	"\x05query\x18\x06 \x01(\tR\x05query\"\xa7\x01\n" +
 	"\x13ListResumesResponse\x12-\n" +
-	"\aresumes\x18\x01 \x03(\v2\x13.resume.SavedResumeR\aresumes\"%\n" +
+	"\aresumes\x18\x01 \x03(\v2\x13.resume.SavedResumeR\aresumes\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\x12\x1f\n" +
+	"\vtotal_count\x18\x03 \x01(\x05R\n" +
+	"totalCount\x12\x18\n" +
+	"\acursors\x18\x04 \x03(\tR\acursors\"%\n" +
 	"\x13DeleteResumeRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\"0\n" +
 	"\x14DeleteResumeResponse\x12\x18\n" +
-	"\asuccess\x18\x01 \x01(\bR\asuccess2\xf3\x01\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"\x1b\n" +
+	"\x19ListDeletedResumesRequest\"&\n" +
+	"\x14RestoreResumeRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"$\n" +
+	"\x12PurgeResumeRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"/\n" +
+	"\x13PurgeResumeResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"\xad\x01\n" +
+	"\x0eResumeRevision\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1b\n" +
+	"\tresume_id\x18\x02 \x01(\tR\bresumeId\x12\x1a\n" +
+	"\brevision\x18\x03 \x01(\x05R\brevision\x123\n" +
+	"\vresume_data\x18\x04 \x01(\v2\x12.resume.ResumeDataR\n" +
+	"resumeData\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\tR\tcreatedAt\"9\n" +
+	"\x1aListResumeRevisionsRequest\x12\x1b\n" +
+	"\tresume_id\x18\x01 \x01(\tR\bresumeId\"S\n" +
+	"\x1bListResumeRevisionsResponse\x124\n" +
+	"\trevisions\x18\x01 \x03(\v2\x16.resume.ResumeRevisionR\trevisions\"S\n" +
+	"\x18GetResumeRevisionRequest\x12\x1b\n" +
+	"\tresume_id\x18\x01 \x01(\tR\bresumeId\x12\x1a\n" +
+	"\brevision\x18\x02 \x01(\x05R\brevision\"W\n" +
+	"\x1cRestoreResumeRevisionRequest\x12\x1b\n" +
+	"\tresume_id\x18\x01 \x01(\tR\bresumeId\x12\x1a\n" +
+	"\brevision\x18\x02 \x01(\x05R\brevision\"p\n" +
+	"\tResumeRef\x12\x1b\n" +
+	"\tresume_id\x18\x01 \x01(\tR\bresumeId\x12\x1a\n" +
+	"\brevision\x18\x02 \x01(\x05R\brevision\x12*\n" +
+	"\x06resume\x18\x03 \x01(\v2\x12.resume.ResumeDataR\x06resume\"f\n" +
+	"\x12DiffResumesRequest\x12%\n" +
+	"\x04base\x18\x01 \x01(\v2\x11.resume.ResumeRefR\x04base\x12)\n" +
+	"\x06target\x18\x02 \x01(\v2\x11.resume.ResumeRefR\x06target\"B\n" +
+	"\bTextEdit\x12\"\n" +
+	"\x02op\x18\x01 \x01(\x0e2\x12.resume.TextEditOpR\x02op\x12\x12\n" +
+	"\x04text\x18\x02 \x01(\tR\x04text\"\xcf\x01\n" +
+	"\vFieldChange\x12\x14\n" +
+	"\x05field\x18\x01 \x01(\tR\x05field\x12\x1b\n" +
+	"\told_value\x18\x02 \x01(\tR\boldValue\x12\x1b\n" +
+	"\tnew_value\x18\x03 \x01(\tR\bnewValue\x12&\n" +
+	"\x05edits\x18\x04 \x03(\v2\x10.resume.TextEditR\x05edits\x12!\n" +
+	"\fadded_values\x18\x05 \x03(\tR\vaddedValues\x12%\n" +
+	"\x0eremoved_values\x18\x06 \x03(\tR\rremovedValues\"\x80\x01\n" +
+	"\n" +
+	"ItemChange\x123\n" +
+	"\vchange_type\x18\x01 \x01(\x0e2\x12.resume.ChangeTypeR\n" +
+	"changeType\x12\x10\n" +
+	"\x03key\x18\x02 \x01(\tR\x03key\x12+\n" +
+	"\x06fields\x18\x03 \x03(\v2\x13.resume.FieldChangeR\x06fields\"\x80\x02\n" +
+	"\n" +
+	"ResumeDiff\x12+\n" +
+	"\x06fields\x18\x01 \x03(\v2\x13.resume.FieldChangeR\x06fields\x122\n" +
+	"\n" +
+	"experience\x18\x02 \x03(\v2\x12.resume.ItemChangeR\n" +
+	"experience\x12*\n" +
+	"\x06skills\x18\x03 \x03(\v2\x12.resume.ItemChangeR\x06skills\x125\n" +
+	"\fskill_groups\x18\x04 \x03(\v2\x12.resume.ItemChangeR\vskillGroups\x12.\n" +
+	"\bprojects\x18\x05 \x03(\v2\x12.resume.ItemChangeR\bprojects\"S\n" +
+	"\x13ExportResumeRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12,\n" +
+	"\x06format\x18\x02 \x01(\x0e2\x14.resume.ResumeFormatR\x06format\"o\n" +
+	"\x14ExportResumeResponse\x12\x18\n" +
+	"\acontent\x18\x01 \x01(\tR\acontent\x12!\n" +
+	"\fcontent_type\x18\x02 \x01(\tR\vcontentType\x12\x1a\n" +
+	"\bfilename\x18\x03 \x01(\tR\bfilename\"q\n" +
+	"\x13ImportResumeRequest\x12,\n" +
+	"\x06format\x18\x01 \x01(\x0e2\x14.resume.ResumeFormatR\x06format\x12\x18\n" +
+	"\acontent\x18\x02 \x01(\tR\acontent\x12\x12\n" +
+	"\x04file\x18\x03 \x01(\fR\x04file\"\\\n" +
+	"\x13RenderResumeRequest\x12)\n" +
+	"\x06resume\x18\x01 \x01(\v2\x11.resume.ResumeRefR\x06resume\x12\x1a\n" +
+	"\btemplate\x18\x02 \x01(\tR\btemplate\"o\n" +
+	"\x14RenderResumeResponse\x12\x18\n" +
+	"\acontent\x18\x01 \x01(\fR\acontent\x12!\n" +
+	"\fcontent_type\x18\x02 \x01(\tR\vcontentType\x12\x1a\n" +
+	"\bfilename\x18\x03 \x01(\tR\bfilename\"N\n" +
+	"\x16ParseResumeFileRequest\x12\x1a\n" +
+	"\bfilename\x18\x01 \x01(\tR\bfilename\x12\x18\n" +
+	"\acontent\x18\x02 \x01(\fR\acontent\"W\n" +
+	"\x17ParseResumeFileResponse\x12(\n" +
+	"\x05draft\x18\x01 \x01(\v2\x12.resume.ResumeDataR\x05draft\x12\x12\n" +
+	"\x04text\x18\x02 \x01(\tR\x04text\"\x7f\n" +
+	"\x17ApplicationStatusChange\x121\n" +
+	"\x06status\x18\x01 \x01(\x0e2\x19.resume.ApplicationStatusR\x06status\x12\x12\n" +
+	"\x04note\x18\x02 \x01(\tR\x04note\x12\x1d\n" +
+	"\n" +
+	"changed_at\x18\x03 \x01(\tR\tchangedAt\"\xad\x03\n" +
+	"\vApplication\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1b\n" +
+	"\tresume_id\x18\x02 \x01(\tR\bresumeId\x12'\n" +
+	"\x0fresume_revision\x18\x03 \x01(\x05R\x0eresumeRevision\x12\x18\n" +
+	"\acompany\x18\x04 \x01(\tR\acompany\x12\x12\n" +
+	"\x04role\x18\x05 \x01(\tR\x04role\x12'\n" +
+	"\x0fjob_description\x18\x06 \x01(\tR\x0ejobDescription\x12\x10\n" +
+	"\x03url\x18\a \x01(\tR\x03url\x121\n" +
+	"\x06status\x18\b \x01(\x0e2\x19.resume.ApplicationStatusR\x06status\x12\x1d\n" +
+	"\n" +
+	"applied_at\x18\t \x01(\tR\tappliedAt\x12\x14\n" +
+	"\x05notes\x18\n" +
+	" \x01(\tR\x05notes\x129\n" +
+	"\ahistory\x18\v \x03(\v2\x1f.resume.ApplicationStatusChangeR\ahistory\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\f \x01(\tR\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\r \x01(\tR\tupdatedAt\"Q\n" +
+	"\x18CreateApplicationRequest\x125\n" +
+	"\vapplication\x18\x01 \x01(\v2\x13.resume.ApplicationR\vapplication\"'\n" +
+	"\x15GetApplicationRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"m\n" +
+	"\x17ListApplicationsRequest\x125\n" +
+	"\bstatuses\x18\x01 \x03(\x0e2\x19.resume.ApplicationStatusR\bstatuses\x12\x1b\n" +
+	"\tresume_id\x18\x02 \x01(\tR\bresumeId\"S\n" +
+	"\x18ListApplicationsResponse\x127\n" +
+	"\fapplications\x18\x01 \x03(\v2\x13.resume.ApplicationR\fapplications\"r\n" +
+	"\x18UpdateApplicationRequest\x125\n" +
+	"\vapplication\x18\x01 \x01(\v2\x13.resume.ApplicationR\vapplication\x12\x1f\n" +
+	"\vstatus_note\x18\x02 \x01(\tR\n" +
+	"statusNote\"*\n" +
+	"\x18DeleteApplicationRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"5\n" +
+	"\x19DeleteApplicationResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"\xbe\x01\n" +
+	"\x0eJobDescription\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x12\x18\n" +
+	"\acompany\x18\x03 \x01(\tR\acompany\x12\x1d\n" +
+	"\n" +
+	"source_url\x18\x04 \x01(\tR\tsourceUrl\x12\x12\n" +
+	"\x04text\x18\x05 \x01(\tR\x04text\x12\x1a\n" +
+	"\bkeywords\x18\x06 \x03(\tR\bkeywords\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\a \x01(\tR\tcreatedAt\"\\\n" +
+	"\x19SaveJobDescriptionRequest\x12?\n" +
+	"\x0fjob_description\x18\x01 \x01(\v2\x16.resume.JobDescriptionR\x0ejobDescription\"*\n" +
+	"\x18GetJobDescriptionRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"6\n" +
+	"\x1aListJobDescriptionsRequest\x12\x18\n" +
+	"\acompany\x18\x01 \x01(\tR\acompany\"`\n" +
+	"\x1bListJobDescriptionsResponse\x12A\n" +
+	"\x10job_descriptions\x18\x01 \x03(\v2\x16.resume.JobDescriptionR\x0fjobDescriptions\"\xd8\x02\n" +
+	"\bAnalysis\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1b\n" +
+	"\tresume_id\x18\x02 \x01(\tR\bresumeId\x12'\n" +
+	"\x0fresume_revision\x18\x03 \x01(\x05R\x0eresumeRevision\x12,\n" +
+	"\x12job_description_id\x18\x04 \x01(\tR\x10jobDescriptionId\x12\x14\n" +
+	"\x05score\x18\x05 \x01(\x05R\x05score\x12\x1a\n" +
+	"\bfeedback\x18\x06 \x03(\tR\bfeedback\x12)\n" +
+	"\x10missing_keywords\x18\a \x03(\tR\x0fmissingKeywords\x12\x1c\n" +
+	"\treasoning\x18\b \x01(\tR\treasoning\x12.\n" +
+	"\x06source\x18\t \x01(\x0e2\x16.resume.AnalysisSourceR\x06source\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\n" +
+	" \x01(\tR\tcreatedAt\"C\n" +
+	"\x13SaveAnalysisRequest\x12,\n" +
+	"\banalysis\x18\x01 \x01(\v2\x10.resume.AnalysisR\banalysis\"`\n" +
+	"\x13ListAnalysesRequest\x12\x1b\n" +
+	"\tresume_id\x18\x01 \x01(\tR\bresumeId\x12,\n" +
+	"\x12job_description_id\x18\x02 \x01(\tR\x10jobDescriptionId\"D\n" +
+	"\x14ListAnalysesResponse\x12,\n" +
+	"\banalyses\x18\x01 \x03(\v2\x10.resume.AnalysisR\banalyses\"\x81\x01\n" +
+	"\rDocumentLinks\x12%\n" +
+	"\x0eapplication_id\x18\x01 \x01(\tR\rapplicationId\x12\x1b\n" +
+	"\tresume_id\x18\x02 \x01(\tR\bresumeId\x12,\n" +
+	"\x12job_description_id\x18\x03 \x01(\tR\x10jobDescriptionId\"\xa2\x01\n" +
+	"\vCoverLetter\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12+\n" +
+	"\x05links\x18\x02 \x01(\v2\x15.resume.DocumentLinksR\x05links\x12\x18\n" +
+	"\acontent\x18\x03 \x01(\tR\acontent\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x04 \x01(\tR\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\x05 \x01(\tR\tupdatedAt\"P\n" +
+	"\x16SaveCoverLetterRequest\x126\n" +
+	"\fcover_letter\x18\x01 \x01(\v2\x13.resume.CoverLetterR\vcoverLetter\"D\n" +
+	"\x18UpdateCoverLetterRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x18\n" +
+	"\acontent\x18\x02 \x01(\tR\acontent\"F\n" +
+	"\x17ListCoverLettersRequest\x12+\n" +
+	"\x05links\x18\x01 \x01(\v2\x15.resume.DocumentLinksR\x05links\"T\n" +
+	"\x18ListCoverLettersResponse\x128\n" +
+	"\rcover_letters\x18\x01 \x03(\v2\x13.resume.CoverLetterR\fcoverLetters\"*\n" +
+	"\x18DeleteCoverLetterRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"5\n" +
+	"\x19DeleteCoverLetterResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"\xb4\x01\n" +
+	"\x10PracticeQuestion\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1a\n" +
+	"\bquestion\x18\x02 \x01(\tR\bquestion\x12\x12\n" +
+	"\x04type\x18\x03 \x01(\tR\x04type\x12!\n" +
+	"\fanswer_guide\x18\x04 \x01(\tR\vanswerGuide\x12\x1c\n" +
+	"\tpracticed\x18\x05 \x01(\bR\tpracticed\x12\x1f\n" +
+	"\vuser_answer\x18\x06 \x01(\tR\n" +
+	"userAnswer\"\xc0\x01\n" +
+	"\vQuestionSet\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12+\n" +
+	"\x05links\x18\x02 \x01(\v2\x15.resume.DocumentLinksR\x05links\x126\n" +
+	"\tquestions\x18\x03 \x03(\v2\x18.resume.PracticeQuestionR\tquestions\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x04 \x01(\tR\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\x05 \x01(\tR\tupdatedAt\"P\n" +
+	"\x16SaveQuestionSetRequest\x126\n" +
+	"\fquestion_set\x18\x01 \x01(\v2\x13.resume.QuestionSetR\vquestionSet\"F\n" +
+	"\x17ListQuestionSetsRequest\x12+\n" +
+	"\x05links\x18\x01 \x01(\v2\x15.resume.DocumentLinksR\x05links\"T\n" +
+	"\x18ListQuestionSetsResponse\x128\n" +
+	"\rquestion_sets\x18\x01 \x03(\v2\x13.resume.QuestionSetR\fquestionSets\"\xad\x01\n" +
+	"\x1dUpdatePracticeQuestionRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1a\n" +
+	"\bquestion\x18\x02 \x01(\tR\bquestion\x12!\n" +
+	"\fanswer_guide\x18\x03 \x01(\tR\vanswerGuide\x12\x1c\n" +
+	"\tpracticed\x18\x04 \x01(\bR\tpracticed\x12\x1f\n" +
+	"\vuser_answer\x18\x05 \x01(\tR\n" +
+	"userAnswer\"*\n" +
+	"\x18DeleteQuestionSetRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"5\n" +
+	"\x19DeleteQuestionSetResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess*\x97\x01\n" +
+	"\x0fResumeSortField\x12!\n" +
+	"\x1dRESUME_SORT_FIELD_UNSPECIFIED\x10\x00\x12 \n" +
+	"\x1cRESUME_SORT_FIELD_CREATED_AT\x10\x01\x12 \n" +
+	"\x1cRESUME_SORT_FIELD_UPDATED_AT\x10\x02\x12\x1d\n" +
+	"\x19RESUME_SORT_FIELD_VERSION\x10\x03*s\n" +
+	"\n" +
+	"ChangeType\x12\x1b\n" +
+	"\x17CHANGE_TYPE_UNSPECIFIED\x10\x00\x12\x15\n" +
+	"\x11CHANGE_TYPE_ADDED\x10\x01\x12\x17\n" +
+	"\x13CHANGE_TYPE_REMOVED\x10\x02\x12\x18\n" +
+	"\x14CHANGE_TYPE_MODIFIED\x10\x03*t\n" +
+	"\n" +
+	"TextEditOp\x12\x1c\n" +
+	"\x18TEXT_EDIT_OP_UNSPECIFIED\x10\x00\x12\x16\n" +
+	"\x12TEXT_EDIT_OP_EQUAL\x10\x01\x12\x17\n" +
+	"\x13TEXT_EDIT_OP_INSERT\x10\x02\x12\x17\n" +
+	"\x13TEXT_EDIT_OP_DELETE\x10\x03*\xbc\x01\n" +
+	"\fResumeFormat\x12\x1d\n" +
+	"\x19RESUME_FORMAT_UNSPECIFIED\x10\x00\x12\x1d\n" +
+	"\x19RESUME_FORMAT_JSON_RESUME\x10\x01\x12\x1a\n" +
+	"\x16RESUME_FORMAT_MARKDOWN\x10\x02\x12\x16\n" +
+	"\x12RESUME_FORMAT_HTML\x10\x03\x12\x17\n" +
+	"\x13RESUME_FORMAT_LATEX\x10\x04\x12!\n" +
+	"\x1dRESUME_FORMAT_LINKEDIN_EXPORT\x10\x05*\x9d\x02\n" +
+	"\x11ApplicationStatus\x12\"\n" +
+	"\x1eAPPLICATION_STATUS_UNSPECIFIED\x10\x00\x12\x1e\n" +
+	"\x1aAPPLICATION_STATUS_APPLIED\x10\x01\x12 \n" +
+	"\x1cAPPLICATION_STATUS_SCREENING\x10\x02\x12 \n" +
+	"\x1cAPPLICATION_STATUS_INTERVIEW\x10\x03\x12\x1c\n" +
+	"\x18APPLICATION_STATUS_OFFER\x10\x04\x12\x1f\n" +
+	"\x1bAPPLICATION_STATUS_ACCEPTED\x10\x05\x12\x1f\n" +
+	"\x1bAPPLICATION_STATUS_REJECTED\x10\x06\x12 \n" +
+	"\x1cAPPLICATION_STATUS_WITHDRAWN\x10\a*i\n" +
+	"\x0eAnalysisSource\x12\x1f\n" +
+	"\x1bANALYSIS_SOURCE_UNSPECIFIED\x10\x00\x12\x17\n" +
+	"\x13ANALYSIS_SOURCE_LLM\x10\x01\x12\x1d\n" +
+	"\x19ANALYSIS_SOURCE_HEURISTIC\x10\x022\xb2\x02\n" +
 	"\tAIService\x12=\n" +
 	"\fTailorResume\x12\x15.resume.TailorRequest\x1a\x16.resume.TailorResponse\x12L\n" +
 	"\rAnalyzeResume\x12\x1c.resume.AnalyzeResumeRequest\x1a\x1d.resume.AnalyzeResumeResponse\x12Y\n" +
-	"\x1aGenerateInterviewQuestions\x12\x1c.resume.InterviewPrepRequest\x1a\x1d.resume.InterviewPrepResponse2\xeb\x01\n" +
+	"\x1aGenerateInterviewQuestions\x12\x1c.resume.InterviewPrepRequest\x1a\x1d.resume.InterviewPrepResponse\x12=\n" +
+	"\vParseResume\x12\x1a.resume.ParseResumeRequest\x1a\x12.resume.ResumeData2\xa9\x15\n" +
 	"\x18ResumePersistenceService\x12<\n" +
 	"\n" +
-	"SaveResume\x12\x19.resume.SaveResumeRequest\x1a\x13.resume.SavedResume\x12F\n" +
+	"SaveResume\x12\x19.resume.SaveResumeRequest\x1a\x13.resume.SavedResume\x12:\n" +
+	"\tGetResume\x12\x18.resume.GetResumeRequest\x1a\x13.resume.SavedResume\x12@\n" +
+	"\fUpdateResume\x12\x1b.resume.UpdateResumeRequest\x1a\x13.resume.SavedResume\x12F\n" +
 	"\vListResumes\x12\x1a.resume.ListResumesRequest\x1a\x1b.resume.ListResumesResponse\x12I\n" +
-	"\fDeleteResume\x12\x1b.resume.DeleteResumeRequest\x1a\x1c.resume.DeleteResumeResponseB&Z$github.com/iprotoresume/shared/protob\x06proto3"
+	"\fDeleteResume\x12\x1b.resume.DeleteResumeRequest\x1a\x1c.resume.DeleteResumeResponse\x12^\n" +
+	"\x13ListResumeRevisions\x12\".resume.ListResumeRevisionsRequest\x1a#.resume.ListResumeRevisionsResponse\x12M\n" +
+	"\x11GetResumeRevision\x12 .resume.GetResumeRevisionRequest\x1a\x16.resume.ResumeRevision\x12R\n" +
+	"\x15RestoreResumeRevision\x12$.resume.RestoreResumeRevisionRequest\x1a\x13.resume.SavedResume\x12=\n" +
+	"\vDiffResumes\x12\x1a.resume.DiffResumesRequest\x1a\x12.resume.ResumeDiff\x12T\n" +
+	"\x12ListDeletedResumes\x12!.resume.ListDeletedResumesRequest\x1a\x1b.resume.ListResumesResponse\x12B\n" +
+	"\rRestoreResume\x12\x1c.resume.RestoreResumeRequest\x1a\x13.resume.SavedResume\x12F\n" +
+	"\vPurgeResume\x12\x1a.resume.PurgeResumeRequest\x1a\x1b.resume.PurgeResumeResponse\x12=\n" +
+	"\x0eGetCurrentUser\x12\x1d.resume.GetCurrentUserRequest\x1a\f.resume.User\x12I\n" +
+	"\fExportResume\x12\x1b.resume.ExportResumeRequest\x1a\x1c.resume.ExportResumeResponse\x12?\n" +
+	"\fImportResume\x12\x1b.resume.ImportResumeRequest\x1a\x12.resume.ResumeData\x12I\n" +
+	"\fRenderResume\x12\x1b.resume.RenderResumeRequest\x1a\x1c.resume.RenderResumeResponse\x12R\n" +
+	"\x0fParseResumeFile\x12\x1e.resume.ParseResumeFileRequest\x1a\x1f.resume.ParseResumeFileResponse\x12J\n" +
+	"\x11CreateApplication\x12 .resume.CreateApplicationRequest\x1a\x13.resume.Application\x12D\n" +
+	"\x0eGetApplication\x12\x1d.resume.GetApplicationRequest\x1a\x13.resume.Application\x12U\n" +
+	"\x10ListApplications\x12\x1f.resume.ListApplicationsRequest\x1a .resume.ListApplicationsResponse\x12J\n" +
+	"\x11UpdateApplication\x12 .resume.UpdateApplicationRequest\x1a\x13.resume.Application\x12X\n" +
+	"\x11DeleteApplication\x12 .resume.DeleteApplicationRequest\x1a!.resume.DeleteApplicationResponse\x12O\n" +
+	"\x12SaveJobDescription\x12!.resume.SaveJobDescriptionRequest\x1a\x16.resume.JobDescription\x12M\n" +
+	"\x11GetJobDescription\x12 .resume.GetJobDescriptionRequest\x1a\x16.resume.JobDescription\x12^\n" +
+	"\x13ListJobDescriptions\x12\".resume.ListJobDescriptionsRequest\x1a#.resume.ListJobDescriptionsResponse\x12=\n" +
+	"\fSaveAnalysis\x12\x1b.resume.SaveAnalysisRequest\x1a\x10.resume.Analysis\x12I\n" +
+	"\fListAnalyses\x12\x1b.resume.ListAnalysesRequest\x1a\x1c.resume.ListAnalysesResponse\x12F\n" +
+	"\x0fSaveCoverLetter\x12\x1e.resume.SaveCoverLetterRequest\x1a\x13.resume.CoverLetter\x12J\n" +
+	"\x11UpdateCoverLetter\x12 .resume.UpdateCoverLetterRequest\x1a\x13.resume.CoverLetter\x12U\n" +
+	"\x10ListCoverLetters\x12\x1f.resume.ListCoverLettersRequest\x1a .resume.ListCoverLettersResponse\x12X\n" +
+	"\x11DeleteCoverLetter\x12 .resume.DeleteCoverLetterRequest\x1a!.resume.DeleteCoverLetterResponse\x12F\n" +
+	"\x0fSaveQuestionSet\x12\x1e.resume.SaveQuestionSetRequest\x1a\x13.resume.QuestionSet\x12U\n" +
+	"\x10ListQuestionSets\x12\x1f.resume.ListQuestionSetsRequest\x1a .resume.ListQuestionSetsResponse\x12Y\n" +
+	"\x16UpdatePracticeQuestion\x12%.resume.UpdatePracticeQuestionRequest\x1a\x18.resume.PracticeQuestion\x12X\n" +
+	"\x11DeleteQuestionSet\x12 .resume.DeleteQuestionSetRequest\x1a!.resume.DeleteQuestionSetResponseB&Z$github.com/iprotoresume/shared/protob\x06proto3"
 
 var (
 	file_shared_proto_resume_proto_rawDescOnce sync.Once
@@ -1460,63 +5642,239 @@ func file_shared_proto_resume_proto_rawDescGZIP() []byte {
 	return file_shared_proto_resume_proto_rawDescData
 }
 
-var file_shared_proto_resume_proto_msgTypes = make([]protoimpl.MessageInfo, 21)
+var file_shared_proto_resume_proto_enumTypes = make([]protoimpl.EnumInfo, 6)
+var file_shared_proto_resume_proto_msgTypes = make([]protoimpl.MessageInfo, 82)
 var file_shared_proto_resume_proto_goTypes = []any{
-	(*ResumeData)(nil),            // 0: resume.ResumeData
-	(*Experience)(nil),            // 1: resume.Experience
-	(*Education)(nil),             // 2: resume.Education
-	(*Project)(nil),               // 3: resume.Project
-	(*Certificate)(nil),           // 4: resume.Certificate
-	(*SkillGroup)(nil),            // 5: resume.SkillGroup
-	(*Language)(nil),              // 6: resume.Language
-	(*Achievement)(nil),           // 7: resume.Achievement
-	(*TailorRequest)(nil),         // 8: resume.TailorRequest
-	(*TailorResponse)(nil),        // 9: resume.TailorResponse
-	(*InterviewPrepRequest)(nil),  // 10: resume.InterviewPrepRequest
-	(*InterviewQuestion)(nil),     // 11: resume.InterviewQuestion
-	(*InterviewPrepResponse)(nil), // 12: resume.InterviewPrepResponse
-	(*AnalyzeResumeRequest)(nil),  // 13: resume.AnalyzeResumeRequest
-	(*AnalyzeResumeResponse)(nil), // 14: resume.AnalyzeResumeResponse
-	(*SavedResume)(nil),           // 15: resume.SavedResume
-	(*SaveResumeRequest)(nil),     // 16: resume.SaveResumeRequest
-	(*ListResumesRequest)(nil),    // 17: resume.ListResumesRequest
-	(*ListResumesResponse)(nil),   // 18: resume.ListResumesResponse
-	(*DeleteResumeRequest)(nil),   // 19: resume.DeleteResumeRequest
-	(*DeleteResumeResponse)(nil),  // 20: resume.DeleteResumeResponse
+	(ResumeSortField)(0),                  // 0: resume.ResumeSortField
+	(ChangeType)(0),                       // 1: resume.ChangeType
+	(TextEditOp)(0),                       // 2: resume.TextEditOp
+	(ResumeFormat)(0),                     // 3: resume.ResumeFormat
+	(ApplicationStatus)(0),                // 4: resume.ApplicationStatus
+	(AnalysisSource)(0),                   // 5: resume.AnalysisSource
+	(*ResumeData)(nil),                    // 6: resume.ResumeData
+	(*Experience)(nil),                    // 7: resume.Experience
+	(*Education)(nil),                     // 8: resume.Education
+	(*Project)(nil),                       // 9: resume.Project
+	(*Certificate)(nil),                   // 10: resume.Certificate
+	(*SkillGroup)(nil),                    // 11: resume.SkillGroup
+	(*Language)(nil),                      // 12: resume.Language
+	(*Achievement)(nil),                   // 13: resume.Achievement
+	(*TailorRequest)(nil),                 // 14: resume.TailorRequest
+	(*TailorResponse)(nil),                // 15: resume.TailorResponse
+	(*InterviewPrepRequest)(nil),          // 16: resume.InterviewPrepRequest
+	(*InterviewQuestion)(nil),             // 17: resume.InterviewQuestion
+	(*InterviewPrepResponse)(nil),         // 18: resume.InterviewPrepResponse
+	(*ParseResumeRequest)(nil),            // 19: resume.ParseResumeRequest
+	(*AnalyzeResumeRequest)(nil),          // 20: resume.AnalyzeResumeRequest
+	(*AnalyzeResumeResponse)(nil),         // 21: resume.AnalyzeResumeResponse
+	(*User)(nil),                          // 22: resume.User
+	(*GetCurrentUserRequest)(nil),         // 23: resume.GetCurrentUserRequest
+	(*SavedResume)(nil),                   // 24: resume.SavedResume
+	(*SaveResumeRequest)(nil),             // 25: resume.SaveResumeRequest
+	(*GetResumeRequest)(nil),              // 26: resume.GetResumeRequest
+	(*UpdateResumeRequest)(nil),           // 27: resume.UpdateResumeRequest
+	(*ListResumesRequest)(nil),            // 28: resume.ListResumesRequest
+	(*ListResumesResponse)(nil),           // 29: resume.ListResumesResponse
+	(*DeleteResumeRequest)(nil),           // 30: resume.DeleteResumeRequest
+	(*DeleteResumeResponse)(nil),          // 31: resume.DeleteResumeResponse
+	(*ListDeletedResumesRequest)(nil),     // 32: resume.ListDeletedResumesRequest
+	(*RestoreResumeRequest)(nil),          // 33: resume.RestoreResumeRequest
+	(*PurgeResumeRequest)(nil),            // 34: resume.PurgeResumeRequest
+	(*PurgeResumeResponse)(nil),           // 35: resume.PurgeResumeResponse
+	(*ResumeRevision)(nil),                // 36: resume.ResumeRevision
+	(*ListResumeRevisionsRequest)(nil),    // 37: resume.ListResumeRevisionsRequest
+	(*ListResumeRevisionsResponse)(nil),   // 38: resume.ListResumeRevisionsResponse
+	(*GetResumeRevisionRequest)(nil),      // 39: resume.GetResumeRevisionRequest
+	(*RestoreResumeRevisionRequest)(nil),  // 40: resume.RestoreResumeRevisionRequest
+	(*ResumeRef)(nil),                     // 41: resume.ResumeRef
+	(*DiffResumesRequest)(nil),            // 42: resume.DiffResumesRequest
+	(*TextEdit)(nil),                      // 43: resume.TextEdit
+	(*FieldChange)(nil),                   // 44: resume.FieldChange
+	(*ItemChange)(nil),                    // 45: resume.ItemChange
+	(*ResumeDiff)(nil),                    // 46: resume.ResumeDiff
+	(*ExportResumeRequest)(nil),           // 47: resume.ExportResumeRequest
+	(*ExportResumeResponse)(nil),          // 48: resume.ExportResumeResponse
+	(*ImportResumeRequest)(nil),           // 49: resume.ImportResumeRequest
+	(*RenderResumeRequest)(nil),           // 50: resume.RenderResumeRequest
+	(*RenderResumeResponse)(nil),          // 51: resume.RenderResumeResponse
+	(*ParseResumeFileRequest)(nil),        // 52: resume.ParseResumeFileRequest
+	(*ParseResumeFileResponse)(nil),       // 53: resume.ParseResumeFileResponse
+	(*ApplicationStatusChange)(nil),       // 54: resume.ApplicationStatusChange
+	(*Application)(nil),                   // 55: resume.Application
+	(*CreateApplicationRequest)(nil),      // 56: resume.CreateApplicationRequest
+	(*GetApplicationRequest)(nil),         // 57: resume.GetApplicationRequest
+	(*ListApplicationsRequest)(nil),       // 58: resume.ListApplicationsRequest
+	(*ListApplicationsResponse)(nil),      // 59: resume.ListApplicationsResponse
+	(*UpdateApplicationRequest)(nil),      // 60: resume.UpdateApplicationRequest
+	(*DeleteApplicationRequest)(nil),      // 61: resume.DeleteApplicationRequest
+	(*DeleteApplicationResponse)(nil),     // 62: resume.DeleteApplicationResponse
+	(*JobDescription)(nil),                // 63: resume.JobDescription
+	(*SaveJobDescriptionRequest)(nil),     // 64: resume.SaveJobDescriptionRequest
+	(*GetJobDescriptionRequest)(nil),      // 65: resume.GetJobDescriptionRequest
+	(*ListJobDescriptionsRequest)(nil),    // 66: resume.ListJobDescriptionsRequest
+	(*ListJobDescriptionsResponse)(nil),   // 67: resume.ListJobDescriptionsResponse
+	(*Analysis)(nil),                      // 68: resume.Analysis
+	(*SaveAnalysisRequest)(nil),           // 69: resume.SaveAnalysisRequest
+	(*ListAnalysesRequest)(nil),           // 70: resume.ListAnalysesRequest
+	(*ListAnalysesResponse)(nil),          // 71: resume.ListAnalysesResponse
+	(*DocumentLinks)(nil),                 // 72: resume.DocumentLinks
+	(*CoverLetter)(nil),                   // 73: resume.CoverLetter
+	(*SaveCoverLetterRequest)(nil),        // 74: resume.SaveCoverLetterRequest
+	(*UpdateCoverLetterRequest)(nil),      // 75: resume.UpdateCoverLetterRequest
+	(*ListCoverLettersRequest)(nil),       // 76: resume.ListCoverLettersRequest
+	(*ListCoverLettersResponse)(nil),      // 77: resume.ListCoverLettersResponse
+	(*DeleteCoverLetterRequest)(nil),      // 78: resume.DeleteCoverLetterRequest
+	(*DeleteCoverLetterResponse)(nil),     // 79: resume.DeleteCoverLetterResponse
+	(*PracticeQuestion)(nil),              // 80: resume.PracticeQuestion
+	(*QuestionSet)(nil),                   // 81: resume.QuestionSet
+	(*SaveQuestionSetRequest)(nil),        // 82: resume.SaveQuestionSetRequest
+	(*ListQuestionSetsRequest)(nil),       // 83: resume.ListQuestionSetsRequest
+	(*ListQuestionSetsResponse)(nil),      // 84: resume.ListQuestionSetsResponse
+	(*UpdatePracticeQuestionRequest)(nil), // 85: resume.UpdatePracticeQuestionRequest
+	(*DeleteQuestionSetRequest)(nil),      // 86: resume.DeleteQuestionSetRequest
+	(*DeleteQuestionSetResponse)(nil),     // 87: resume.DeleteQuestionSetResponse
 }
 var file_shared_proto_resume_proto_depIdxs = []int32{
-	1,  // 0: resume.ResumeData.experience:type_name -> resume.Experience
-	2,  // 1: resume.ResumeData.education:type_name -> resume.Education
-	3,  // 2: resume.ResumeData.projects:type_name -> resume.Project
-	4,  // 3: resume.ResumeData.certificates:type_name -> resume.Certificate
-	5,  // 4: resume.ResumeData.skill_groups:type_name -> resume.SkillGroup
-	6,  // 5: resume.ResumeData.languages:type_name -> resume.Language
-	7,  // 6: resume.ResumeData.achievements:type_name -> resume.Achievement
-	0,  // 7: resume.TailorRequest.original_resume:type_name -> resume.ResumeData
-	0,  // 8: resume.TailorResponse.tailored_resume:type_name -> resume.ResumeData
-	0,  // 9: resume.InterviewPrepRequest.resume:type_name -> resume.ResumeData
-	11, // 10: resume.InterviewPrepResponse.questions:type_name -> resume.InterviewQuestion
-	0,  // 11: resume.AnalyzeResumeRequest.resume:type_name -> resume.ResumeData
-	0,  // 12: resume.SavedResume.resume_data:type_name -> resume.ResumeData
-	0,  // 13: resume.SaveResumeRequest.resume:type_name -> resume.ResumeData
-	15, // 14: resume.ListResumesResponse.resumes:type_name -> resume.SavedResume
-	8,  // 15: resume.AIService.TailorResume:input_type -> resume.TailorRequest
-	13, // 16: resume.AIService.AnalyzeResume:input_type -> resume.AnalyzeResumeRequest
-	10, // 17: resume.AIService.GenerateInterviewQuestions:input_type -> resume.InterviewPrepRequest
-	16, // 18: resume.ResumePersistenceService.SaveResume:input_type -> resume.SaveResumeRequest
-	17, // 19: resume.ResumePersistenceService.ListResumes:input_type -> resume.ListResumesRequest
-	19, // 20: resume.ResumePersistenceService.DeleteResume:input_type -> resume.DeleteResumeRequest
-	9,  // 21: resume.AIService.TailorResume:output_type -> resume.TailorResponse
-	14, // 22: resume.AIService.AnalyzeResume:output_type -> resume.AnalyzeResumeResponse
-	12, // 23: resume.AIService.GenerateInterviewQuestions:output_type -> resume.InterviewPrepResponse
-	15, // 24: resume.ResumePersistenceService.SaveResume:output_type -> resume.SavedResume
-	18, // 25: resume.ResumePersistenceService.ListResumes:output_type -> resume.ListResumesResponse
-	20, // 26: resume.ResumePersistenceService.DeleteResume:output_type -> resume.DeleteResumeResponse
-	21, // [21:27] is the sub-list for method output_type
-	15, // [15:21] is the sub-list for method input_type
-	15, // [15:15] is the sub-list for extension type_name
-	15, // [15:15] is the sub-list for extension extendee
-	0,  // [0:15] is the sub-list for field type_name
+	7,  // 0: resume.ResumeData.experience:type_name -> resume.Experience
+	8,  // 1: resume.ResumeData.education:type_name -> resume.Education
+	9,  // 2: resume.ResumeData.projects:type_name -> resume.Project
+	10, // 3: resume.ResumeData.certificates:type_name -> resume.Certificate
+	11, // 4: resume.ResumeData.skill_groups:type_name -> resume.SkillGroup
+	12, // 5: resume.ResumeData.languages:type_name -> resume.Language
+	13, // 6: resume.ResumeData.achievements:type_name -> resume.Achievement
+	6,  // 7: resume.TailorRequest.original_resume:type_name -> resume.ResumeData
+	6,  // 8: resume.TailorResponse.tailored_resume:type_name -> resume.ResumeData
+	6,  // 9: resume.InterviewPrepRequest.resume:type_name -> resume.ResumeData
+	17, // 10: resume.InterviewPrepResponse.questions:type_name -> resume.InterviewQuestion
+	6,  // 11: resume.ParseResumeRequest.draft:type_name -> resume.ResumeData
+	6,  // 12: resume.AnalyzeResumeRequest.resume:type_name -> resume.ResumeData
+	6,  // 13: resume.SavedResume.resume_data:type_name -> resume.ResumeData
+	6,  // 14: resume.SaveResumeRequest.resume:type_name -> resume.ResumeData
+	6,  // 15: resume.UpdateResumeRequest.resume:type_name -> resume.ResumeData
+	0,  // 16: resume.ListResumesRequest.sort_by:type_name -> resume.ResumeSortField
+	24, // 17: resume.ListResumesResponse.resumes:type_name -> resume.SavedResume
+	6,  // 18: resume.ResumeRevision.resume_data:type_name -> resume.ResumeData
+	36, // 19: resume.ListResumeRevisionsResponse.revisions:type_name -> resume.ResumeRevision
+	6,  // 20: resume.ResumeRef.resume:type_name -> resume.ResumeData
+	41, // 21: resume.DiffResumesRequest.base:type_name -> resume.ResumeRef
+	41, // 22: resume.DiffResumesRequest.target:type_name -> resume.ResumeRef
+	2,  // 23: resume.TextEdit.op:type_name -> resume.TextEditOp
+	43, // 24: resume.FieldChange.edits:type_name -> resume.TextEdit
+	1,  // 25: resume.ItemChange.change_type:type_name -> resume.ChangeType
+	44, // 26: resume.ItemChange.fields:type_name -> resume.FieldChange
+	44, // 27: resume.ResumeDiff.fields:type_name -> resume.FieldChange
+	45, // 28: resume.ResumeDiff.experience:type_name -> resume.ItemChange
+	45, // 29: resume.ResumeDiff.skills:type_name -> resume.ItemChange
+	45, // 30: resume.ResumeDiff.skill_groups:type_name -> resume.ItemChange
+	45, // 31: resume.ResumeDiff.projects:type_name -> resume.ItemChange
+	3,  // 32: resume.ExportResumeRequest.format:type_name -> resume.ResumeFormat
+	3,  // 33: resume.ImportResumeRequest.format:type_name -> resume.ResumeFormat
+	41, // 34: resume.RenderResumeRequest.resume:type_name -> resume.ResumeRef
+	6,  // 35: resume.ParseResumeFileResponse.draft:type_name -> resume.ResumeData
+	4,  // 36: resume.ApplicationStatusChange.status:type_name -> resume.ApplicationStatus
+	4,  // 37: resume.Application.status:type_name -> resume.ApplicationStatus
+	54, // 38: resume.Application.history:type_name -> resume.ApplicationStatusChange
+	55, // 39: resume.CreateApplicationRequest.application:type_name -> resume.Application
+	4,  // 40: resume.ListApplicationsRequest.statuses:type_name -> resume.ApplicationStatus
+	55, // 41: resume.ListApplicationsResponse.applications:type_name -> resume.Application
+	55, // 42: resume.UpdateApplicationRequest.application:type_name -> resume.Application
+	63, // 43: resume.SaveJobDescriptionRequest.job_description:type_name -> resume.JobDescription
+	63, // 44: resume.ListJobDescriptionsResponse.job_descriptions:type_name -> resume.JobDescription
+	5,  // 45: resume.Analysis.source:type_name -> resume.AnalysisSource
+	68, // 46: resume.SaveAnalysisRequest.analysis:type_name -> resume.Analysis
+	68, // 47: resume.ListAnalysesResponse.analyses:type_name -> resume.Analysis
+	72, // 48: resume.CoverLetter.links:type_name -> resume.DocumentLinks
+	73, // 49: resume.SaveCoverLetterRequest.cover_letter:type_name -> resume.CoverLetter
+	72, // 50: resume.ListCoverLettersRequest.links:type_name -> resume.DocumentLinks
+	73, // 51: resume.ListCoverLettersResponse.cover_letters:type_name -> resume.CoverLetter
+	72, // 52: resume.QuestionSet.links:type_name -> resume.DocumentLinks
+	80, // 53: resume.QuestionSet.questions:type_name -> resume.PracticeQuestion
+	81, // 54: resume.SaveQuestionSetRequest.question_set:type_name -> resume.QuestionSet
+	72, // 55: resume.ListQuestionSetsRequest.links:type_name -> resume.DocumentLinks
+	81, // 56: resume.ListQuestionSetsResponse.question_sets:type_name -> resume.QuestionSet
+	14, // 57: resume.AIService.TailorResume:input_type -> resume.TailorRequest
+	20, // 58: resume.AIService.AnalyzeResume:input_type -> resume.AnalyzeResumeRequest
+	16, // 59: resume.AIService.GenerateInterviewQuestions:input_type -> resume.InterviewPrepRequest
+	19, // 60: resume.AIService.ParseResume:input_type -> resume.ParseResumeRequest
+	25, // 61: resume.ResumePersistenceService.SaveResume:input_type -> resume.SaveResumeRequest
+	26, // 62: resume.ResumePersistenceService.GetResume:input_type -> resume.GetResumeRequest
+	27, // 63: resume.ResumePersistenceService.UpdateResume:input_type -> resume.UpdateResumeRequest
+	28, // 64: resume.ResumePersistenceService.ListResumes:input_type -> resume.ListResumesRequest
+	30, // 65: resume.ResumePersistenceService.DeleteResume:input_type -> resume.DeleteResumeRequest
+	37, // 66: resume.ResumePersistenceService.ListResumeRevisions:input_type -> resume.ListResumeRevisionsRequest
+	39, // 67: resume.ResumePersistenceService.GetResumeRevision:input_type -> resume.GetResumeRevisionRequest
+	40, // 68: resume.ResumePersistenceService.RestoreResumeRevision:input_type -> resume.RestoreResumeRevisionRequest
+	42, // 69: resume.ResumePersistenceService.DiffResumes:input_type -> resume.DiffResumesRequest
+	32, // 70: resume.ResumePersistenceService.ListDeletedResumes:input_type -> resume.ListDeletedResumesRequest
+	33, // 71: resume.ResumePersistenceService.RestoreResume:input_type -> resume.RestoreResumeRequest
+	34, // 72: resume.ResumePersistenceService.PurgeResume:input_type -> resume.PurgeResumeRequest
+	23, // 73: resume.ResumePersistenceService.GetCurrentUser:input_type -> resume.GetCurrentUserRequest
+	47, // 74: resume.ResumePersistenceService.ExportResume:input_type -> resume.ExportResumeRequest
+	49, // 75: resume.ResumePersistenceService.ImportResume:input_type -> resume.ImportResumeRequest
+	50, // 76: resume.ResumePersistenceService.RenderResume:input_type -> resume.RenderResumeRequest
+	52, // 77: resume.ResumePersistenceService.ParseResumeFile:input_type -> resume.ParseResumeFileRequest
+	56, // 78: resume.ResumePersistenceService.CreateApplication:input_type -> resume.CreateApplicationRequest
+	57, // 79: resume.ResumePersistenceService.GetApplication:input_type -> resume.GetApplicationRequest
+	58, // 80: resume.ResumePersistenceService.ListApplications:input_type -> resume.ListApplicationsRequest
+	60, // 81: resume.ResumePersistenceService.UpdateApplication:input_type -> resume.UpdateApplicationRequest
+	61, // 82: resume.ResumePersistenceService.DeleteApplication:input_type -> resume.DeleteApplicationRequest
+	64, // 83: resume.ResumePersistenceService.SaveJobDescription:input_type -> resume.SaveJobDescriptionRequest
+	65, // 84: resume.ResumePersistenceService.GetJobDescription:input_type -> resume.GetJobDescriptionRequest
+	66, // 85: resume.ResumePersistenceService.ListJobDescriptions:input_type -> resume.ListJobDescriptionsRequest
+	69, // 86: resume.ResumePersistenceService.SaveAnalysis:input_type -> resume.SaveAnalysisRequest
+	70, // 87: resume.ResumePersistenceService.ListAnalyses:input_type -> resume.ListAnalysesRequest
+	74, // 88: resume.ResumePersistenceService.SaveCoverLetter:input_type -> resume.SaveCoverLetterRequest
+	75, // 89: resume.ResumePersistenceService.UpdateCoverLetter:input_type -> resume.UpdateCoverLetterRequest
+	76, // 90: resume.ResumePersistenceService.ListCoverLetters:input_type -> resume.ListCoverLettersRequest
+	78, // 91: resume.ResumePersistenceService.DeleteCoverLetter:input_type -> resume.DeleteCoverLetterRequest
+	82, // 92: resume.ResumePersistenceService.SaveQuestionSet:input_type -> resume.SaveQuestionSetRequest
+	83, // 93: resume.ResumePersistenceService.ListQuestionSets:input_type -> resume.ListQuestionSetsRequest
+	85, // 94: resume.ResumePersistenceService.UpdatePracticeQuestion:input_type -> resume.UpdatePracticeQuestionRequest
+	86, // 95: resume.ResumePersistenceService.DeleteQuestionSet:input_type -> resume.DeleteQuestionSetRequest
+	15, // 96: resume.AIService.TailorResume:output_type -> resume.TailorResponse
+	21, // 97: resume.AIService.AnalyzeResume:output_type -> resume.AnalyzeResumeResponse
+	18, // 98: resume.AIService.GenerateInterviewQuestions:output_type -> resume.InterviewPrepResponse
+	6,  // 99: resume.AIService.ParseResume:output_type -> resume.ResumeData
+	24, // 100: resume.ResumePersistenceService.SaveResume:output_type -> resume.SavedResume
+	24, // 101: resume.ResumePersistenceService.GetResume:output_type -> resume.SavedResume
+	24, // 102: resume.ResumePersistenceService.UpdateResume:output_type -> resume.SavedResume
+	29, // 103: resume.ResumePersistenceService.ListResumes:output_type -> resume.ListResumesResponse
+	31, // 104: resume.ResumePersistenceService.DeleteResume:output_type -> resume.DeleteResumeResponse
+	38, // 105: resume.ResumePersistenceService.ListResumeRevisions:output_type -> resume.ListResumeRevisionsResponse
+	36, // 106: resume.ResumePersistenceService.GetResumeRevision:output_type -> resume.ResumeRevision
+	24, // 107: resume.ResumePersistenceService.RestoreResumeRevision:output_type -> resume.SavedResume
+	46, // 108: resume.ResumePersistenceService.DiffResumes:output_type -> resume.ResumeDiff
+	29, // 109: resume.ResumePersistenceService.ListDeletedResumes:output_type -> resume.ListResumesResponse
+	24, // 110: resume.ResumePersistenceService.RestoreResume:output_type -> resume.SavedResume
+	35, // 111: resume.ResumePersistenceService.PurgeResume:output_type -> resume.PurgeResumeResponse
+	22, // 112: resume.ResumePersistenceService.GetCurrentUser:output_type -> resume.User
+	48, // 113: resume.ResumePersistenceService.ExportResume:output_type -> resume.ExportResumeResponse
+	6,  // 114: resume.ResumePersistenceService.ImportResume:output_type -> resume.ResumeData
+	51, // 115: resume.ResumePersistenceService.RenderResume:output_type -> resume.RenderResumeResponse
+	53, // 116: resume.ResumePersistenceService.ParseResumeFile:output_type -> resume.ParseResumeFileResponse
+	55, // 117: resume.ResumePersistenceService.CreateApplication:output_type -> resume.Application
+	55, // 118: resume.ResumePersistenceService.GetApplication:output_type -> resume.Application
+	59, // 119: resume.ResumePersistenceService.ListApplications:output_type -> resume.ListApplicationsResponse
+	55, // 120: resume.ResumePersistenceService.UpdateApplication:output_type -> resume.Application
+	62, // 121: resume.ResumePersistenceService.DeleteApplication:output_type -> resume.DeleteApplicationResponse
+	63, // 122: resume.ResumePersistenceService.SaveJobDescription:output_type -> resume.JobDescription
+	63, // 123: resume.ResumePersistenceService.GetJobDescription:output_type -> resume.JobDescription
+	67, // 124: resume.ResumePersistenceService.ListJobDescriptions:output_type -> resume.ListJobDescriptionsResponse
+	68, // 125: resume.ResumePersistenceService.SaveAnalysis:output_type -> resume.Analysis
+	71, // 126: resume.ResumePersistenceService.ListAnalyses:output_type -> resume.ListAnalysesResponse
+	73, // 127: resume.ResumePersistenceService.SaveCoverLetter:output_type -> resume.CoverLetter
+	73, // 128: resume.ResumePersistenceService.UpdateCoverLetter:output_type -> resume.CoverLetter
+	77, // 129: resume.ResumePersistenceService.ListCoverLetters:output_type -> resume.ListCoverLettersResponse
+	79, // 130: resume.ResumePersistenceService.DeleteCoverLetter:output_type -> resume.DeleteCoverLetterResponse
+	81, // 131: resume.ResumePersistenceService.SaveQuestionSet:output_type -> resume.QuestionSet
+	84, // 132: resume.ResumePersistenceService.ListQuestionSets:output_type -> resume.ListQuestionSetsResponse
+	80, // 133: resume.ResumePersistenceService.UpdatePracticeQuestion:output_type -> resume.PracticeQuestion
+	87, // 134: resume.ResumePersistenceService.DeleteQuestionSet:output_type -> resume.DeleteQuestionSetResponse
+	96, // [96:135] is the sub-list for method output_type
+	57, // [57:96] is the sub-list for method input_type
+	57, // [57:57] is the sub-list for extension type_name
+	57, // [57:57] is the sub-list for extension extendee
+	0,  // [0:57] is the sub-list for field type_name
 }
 
 func init() { file_shared_proto_resume_proto_init() }
@@ -1529,13 +5887,14 @@ func file_shared_proto_resume_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_shared_proto_resume_proto_rawDesc), len(file_shared_proto_resume_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   21,
+			NumEnums:      6,
+			NumMessages:   82,
 			NumExtensions: 0,
 			NumServices:   2,
 		},
 		GoTypes:           file_shared_proto_resume_proto_goTypes,
 		DependencyIndexes: file_shared_proto_resume_proto_depIdxs,
+		EnumInfos:         file_shared_proto_resume_proto_enumTypes,
 		MessageInfos:      file_shared_proto_resume_proto_msgTypes,
 	}.Build()
 	File_shared_proto_resume_proto = out.File
@@ -22,6 +22,7 @@ const (
 	AIService_TailorResume_FullMethodName               = "/resume.AIService/TailorResume"
 	AIService_AnalyzeResume_FullMethodName              = "/resume.AIService/AnalyzeResume"
 	AIService_GenerateInterviewQuestions_FullMethodName = "/resume.AIService/GenerateInterviewQuestions"
+	AIService_ParseResume_FullMethodName                = "/resume.AIService/ParseResume"
 )
 
 // AIServiceClient is the client API for AIService service.
@@ -31,6 +32,7 @@ type AIServiceClient interface {
 	TailorResume(ctx context.Context, in *TailorRequest, opts ...grpc.CallOption) (*TailorResponse, error)
 	AnalyzeResume(ctx context.Context, in *AnalyzeResumeRequest, opts ...grpc.CallOption) (*AnalyzeResumeResponse, error)
 	GenerateInterviewQuestions(ctx context.Context, in *InterviewPrepRequest, opts ...grpc.CallOption) (*InterviewPrepResponse, error)
+	ParseResume(ctx context.Context, in *ParseResumeRequest, opts ...grpc.CallOption) (*ResumeData, error)
 }
 
 type aIServiceClient struct {
@@ -71,6 +73,16 @@ func (c *aIServiceClient) GenerateInterviewQuestions(ctx context.Context, in *In
 	return out, nil
 }
 
+func (c *aIServiceClient) ParseResume(ctx context.Context, in *ParseResumeRequest, opts ...grpc.CallOption) (*ResumeData, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ResumeData)
+	err := c.cc.Invoke(ctx, AIService_ParseResume_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // AIServiceServer is the server API for AIService service.
 // All implementations must embed UnimplementedAIServiceServer
 // for forward compatibility.
@@ -78,6 +90,7 @@ type AIServiceServer interface {
 	TailorResume(context.Context, *TailorRequest) (*TailorResponse, error)
 	AnalyzeResume(context.Context, *AnalyzeResumeRequest) (*AnalyzeResumeResponse, error)
 	GenerateInterviewQuestions(context.Context, *InterviewPrepRequest) (*InterviewPrepResponse, error)
+	ParseResume(context.Context, *ParseResumeRequest) (*ResumeData, error)
 	mustEmbedUnimplementedAIServiceServer()
 }
 
@@ -97,6 +110,9 @@ func (UnimplementedAIServiceServer) AnalyzeResume(context.Context, *AnalyzeResum
 func (UnimplementedAIServiceServer) GenerateInterviewQuestions(context.Context, *InterviewPrepRequest) (*InterviewPrepResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method GenerateInterviewQuestions not implemented")
 }
+func (UnimplementedAIServiceServer) ParseResume(context.Context, *ParseResumeRequest) (*ResumeData, error) {
+	return nil, status.Error(codes.Unimplemented, "method ParseResume not implemented")
+}
 func (UnimplementedAIServiceServer) mustEmbedUnimplementedAIServiceServer() {}
 func (UnimplementedAIServiceServer) testEmbeddedByValue()                   {}
 
@@ -172,6 +188,24 @@ func _AIService_GenerateInterviewQuestions_Handler(srv interface{}, ctx context.
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AIService_ParseResume_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ParseResumeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AIServiceServer).ParseResume(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AIService_ParseResume_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AIServiceServer).ParseResume(ctx, req.(*ParseResumeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // AIService_ServiceDesc is the grpc.ServiceDesc for AIService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -191,15 +225,51 @@ var AIService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GenerateInterviewQuestions",
 			Handler:    _AIService_GenerateInterviewQuestions_Handler,
 		},
+		{
+			MethodName: "ParseResume",
+			Handler:    _AIService_ParseResume_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "shared/proto/resume.proto",
 }
 
 const (
-	ResumePersistenceService_SaveResume_FullMethodName   = "/resume.ResumePersistenceService/SaveResume"
-	ResumePersistenceService_ListResumes_FullMethodName  = "/resume.ResumePersistenceService/ListResumes"
-	ResumePersistenceService_DeleteResume_FullMethodName = "/resume.ResumePersistenceService/DeleteResume"
+	ResumePersistenceService_SaveResume_FullMethodName             = "/resume.ResumePersistenceService/SaveResume"
+	ResumePersistenceService_GetResume_FullMethodName              = "/resume.ResumePersistenceService/GetResume"
+	ResumePersistenceService_UpdateResume_FullMethodName           = "/resume.ResumePersistenceService/UpdateResume"
+	ResumePersistenceService_ListResumes_FullMethodName            = "/resume.ResumePersistenceService/ListResumes"
+	ResumePersistenceService_DeleteResume_FullMethodName           = "/resume.ResumePersistenceService/DeleteResume"
+	ResumePersistenceService_ListResumeRevisions_FullMethodName    = "/resume.ResumePersistenceService/ListResumeRevisions"
+	ResumePersistenceService_GetResumeRevision_FullMethodName      = "/resume.ResumePersistenceService/GetResumeRevision"
+	ResumePersistenceService_RestoreResumeRevision_FullMethodName  = "/resume.ResumePersistenceService/RestoreResumeRevision"
+	ResumePersistenceService_DiffResumes_FullMethodName            = "/resume.ResumePersistenceService/DiffResumes"
+	ResumePersistenceService_ListDeletedResumes_FullMethodName     = "/resume.ResumePersistenceService/ListDeletedResumes"
+	ResumePersistenceService_RestoreResume_FullMethodName          = "/resume.ResumePersistenceService/RestoreResume"
+	ResumePersistenceService_PurgeResume_FullMethodName            = "/resume.ResumePersistenceService/PurgeResume"
+	ResumePersistenceService_GetCurrentUser_FullMethodName         = "/resume.ResumePersistenceService/GetCurrentUser"
+	ResumePersistenceService_ExportResume_FullMethodName           = "/resume.ResumePersistenceService/ExportResume"
+	ResumePersistenceService_ImportResume_FullMethodName           = "/resume.ResumePersistenceService/ImportResume"
+	ResumePersistenceService_RenderResume_FullMethodName           = "/resume.ResumePersistenceService/RenderResume"
+	ResumePersistenceService_ParseResumeFile_FullMethodName        = "/resume.ResumePersistenceService/ParseResumeFile"
+	ResumePersistenceService_CreateApplication_FullMethodName      = "/resume.ResumePersistenceService/CreateApplication"
+	ResumePersistenceService_GetApplication_FullMethodName         = "/resume.ResumePersistenceService/GetApplication"
+	ResumePersistenceService_ListApplications_FullMethodName       = "/resume.ResumePersistenceService/ListApplications"
+	ResumePersistenceService_UpdateApplication_FullMethodName      = "/resume.ResumePersistenceService/UpdateApplication"
+	ResumePersistenceService_DeleteApplication_FullMethodName      = "/resume.ResumePersistenceService/DeleteApplication"
+	ResumePersistenceService_SaveJobDescription_FullMethodName     = "/resume.ResumePersistenceService/SaveJobDescription"
+	ResumePersistenceService_GetJobDescription_FullMethodName      = "/resume.ResumePersistenceService/GetJobDescription"
+	ResumePersistenceService_ListJobDescriptions_FullMethodName    = "/resume.ResumePersistenceService/ListJobDescriptions"
+	ResumePersistenceService_SaveAnalysis_FullMethodName           = "/resume.ResumePersistenceService/SaveAnalysis"
+	ResumePersistenceService_ListAnalyses_FullMethodName           = "/resume.ResumePersistenceService/ListAnalyses"
+	ResumePersistenceService_SaveCoverLetter_FullMethodName        = "/resume.ResumePersistenceService/SaveCoverLetter"
+	ResumePersistenceService_UpdateCoverLetter_FullMethodName      = "/resume.ResumePersistenceService/UpdateCoverLetter"
+	ResumePersistenceService_ListCoverLetters_FullMethodName       = "/resume.ResumePersistenceService/ListCoverLetters"
+	ResumePersistenceService_DeleteCoverLetter_FullMethodName      = "/resume.ResumePersistenceService/DeleteCoverLetter"
+	ResumePersistenceService_SaveQuestionSet_FullMethodName        = "/resume.ResumePersistenceService/SaveQuestionSet"
+	ResumePersistenceService_ListQuestionSets_FullMethodName       = "/resume.ResumePersistenceService/ListQuestionSets"
+	ResumePersistenceService_UpdatePracticeQuestion_FullMethodName = "/resume.ResumePersistenceService/UpdatePracticeQuestion"
+	ResumePersistenceService_DeleteQuestionSet_FullMethodName      = "/resume.ResumePersistenceService/DeleteQuestionSet"
 )
 
 // ResumePersistenceServiceClient is the client API for ResumePersistenceService service.
@@ -207,8 +277,40 @@ const (
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type ResumePersistenceServiceClient interface {
 	SaveResume(ctx context.Context, in *SaveResumeRequest, opts ...grpc.CallOption) (*SavedResume, error)
+	GetResume(ctx context.Context, in *GetResumeRequest, opts ...grpc.CallOption) (*SavedResume, error)
+	UpdateResume(ctx context.Context, in *UpdateResumeRequest, opts ...grpc.CallOption) (*SavedResume, error)
 	ListResumes(ctx context.Context, in *ListResumesRequest, opts ...grpc.CallOption) (*ListResumesResponse, error)
 	DeleteResume(ctx context.Context, in *DeleteResumeRequest, opts ...grpc.CallOption) (*DeleteResumeResponse, error)
+	ListResumeRevisions(ctx context.Context, in *ListResumeRevisionsRequest, opts ...grpc.CallOption) (*ListResumeRevisionsResponse, error)
+	GetResumeRevision(ctx context.Context, in *GetResumeRevisionRequest, opts ...grpc.CallOption) (*ResumeRevision, error)
+	RestoreResumeRevision(ctx context.Context, in *RestoreResumeRevisionRequest, opts ...grpc.CallOption) (*SavedResume, error)
+	DiffResumes(ctx context.Context, in *DiffResumesRequest, opts ...grpc.CallOption) (*ResumeDiff, error)
+	ListDeletedResumes(ctx context.Context, in *ListDeletedResumesRequest, opts ...grpc.CallOption) (*ListResumesResponse, error)
+	RestoreResume(ctx context.Context, in *RestoreResumeRequest, opts ...grpc.CallOption) (*SavedResume, error)
+	PurgeResume(ctx context.Context, in *PurgeResumeRequest, opts ...grpc.CallOption) (*PurgeResumeResponse, error)
+	GetCurrentUser(ctx context.Context, in *GetCurrentUserRequest, opts ...grpc.CallOption) (*User, error)
+	ExportResume(ctx context.Context, in *ExportResumeRequest, opts ...grpc.CallOption) (*ExportResumeResponse, error)
+	ImportResume(ctx context.Context, in *ImportResumeRequest, opts ...grpc.CallOption) (*ResumeData, error)
+	RenderResume(ctx context.Context, in *RenderResumeRequest, opts ...grpc.CallOption) (*RenderResumeResponse, error)
+	ParseResumeFile(ctx context.Context, in *ParseResumeFileRequest, opts ...grpc.CallOption) (*ParseResumeFileResponse, error)
+	CreateApplication(ctx context.Context, in *CreateApplicationRequest, opts ...grpc.CallOption) (*Application, error)
+	GetApplication(ctx context.Context, in *GetApplicationRequest, opts ...grpc.CallOption) (*Application, error)
+	ListApplications(ctx context.Context, in *ListApplicationsRequest, opts ...grpc.CallOption) (*ListApplicationsResponse, error)
+	UpdateApplication(ctx context.Context, in *UpdateApplicationRequest, opts ...grpc.CallOption) (*Application, error)
+	DeleteApplication(ctx context.Context, in *DeleteApplicationRequest, opts ...grpc.CallOption) (*DeleteApplicationResponse, error)
+	SaveJobDescription(ctx context.Context, in *SaveJobDescriptionRequest, opts ...grpc.CallOption) (*JobDescription, error)
+	GetJobDescription(ctx context.Context, in *GetJobDescriptionRequest, opts ...grpc.CallOption) (*JobDescription, error)
+	ListJobDescriptions(ctx context.Context, in *ListJobDescriptionsRequest, opts ...grpc.CallOption) (*ListJobDescriptionsResponse, error)
+	SaveAnalysis(ctx context.Context, in *SaveAnalysisRequest, opts ...grpc.CallOption) (*Analysis, error)
+	ListAnalyses(ctx context.Context, in *ListAnalysesRequest, opts ...grpc.CallOption) (*ListAnalysesResponse, error)
+	SaveCoverLetter(ctx context.Context, in *SaveCoverLetterRequest, opts ...grpc.CallOption) (*CoverLetter, error)
+	UpdateCoverLetter(ctx context.Context, in *UpdateCoverLetterRequest, opts ...grpc.CallOption) (*CoverLetter, error)
+	ListCoverLetters(ctx context.Context, in *ListCoverLettersRequest, opts ...grpc.CallOption) (*ListCoverLettersResponse, error)
+	DeleteCoverLetter(ctx context.Context, in *DeleteCoverLetterRequest, opts ...grpc.CallOption) (*DeleteCoverLetterResponse, error)
+	SaveQuestionSet(ctx context.Context, in *SaveQuestionSetRequest, opts ...grpc.CallOption) (*QuestionSet, error)
+	ListQuestionSets(ctx context.Context, in *ListQuestionSetsRequest, opts ...grpc.CallOption) (*ListQuestionSetsResponse, error)
+	UpdatePracticeQuestion(ctx context.Context, in *UpdatePracticeQuestionRequest, opts ...grpc.CallOption) (*PracticeQuestion, error)
+	DeleteQuestionSet(ctx context.Context, in *DeleteQuestionSetRequest, opts ...grpc.CallOption) (*DeleteQuestionSetResponse, error)
 }
 
 type resumePersistenceServiceClient struct {
@@ -229,6 +331,26 @@ func (c *resumePersistenceServiceClient) SaveResume(ctx context.Context, in *Sav
 	return out, nil
 }
 
+func (c *resumePersistenceServiceClient) GetResume(ctx context.Context, in *GetResumeRequest, opts ...grpc.CallOption) (*SavedResume, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SavedResume)
+	err := c.cc.Invoke(ctx, ResumePersistenceService_GetResume_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *resumePersistenceServiceClient) UpdateResume(ctx context.Context, in *UpdateResumeRequest, opts ...grpc.CallOption) (*SavedResume, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SavedResume)
+	err := c.cc.Invoke(ctx, ResumePersistenceService_UpdateResume_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *resumePersistenceServiceClient) ListResumes(ctx context.Context, in *ListResumesRequest, opts ...grpc.CallOption) (*ListResumesResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ListResumesResponse)
@@ -249,126 +371,1258 @@ func (c *resumePersistenceServiceClient) DeleteResume(ctx context.Context, in *D
 	return out, nil
 }
 
-// ResumePersistenceServiceServer is the server API for ResumePersistenceService service.
-// All implementations must embed UnimplementedResumePersistenceServiceServer
-// for forward compatibility.
-type ResumePersistenceServiceServer interface {
-	SaveResume(context.Context, *SaveResumeRequest) (*SavedResume, error)
-	ListResumes(context.Context, *ListResumesRequest) (*ListResumesResponse, error)
-	DeleteResume(context.Context, *DeleteResumeRequest) (*DeleteResumeResponse, error)
-	mustEmbedUnimplementedResumePersistenceServiceServer()
+func (c *resumePersistenceServiceClient) ListResumeRevisions(ctx context.Context, in *ListResumeRevisionsRequest, opts ...grpc.CallOption) (*ListResumeRevisionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListResumeRevisionsResponse)
+	err := c.cc.Invoke(ctx, ResumePersistenceService_ListResumeRevisions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-// UnimplementedResumePersistenceServiceServer must be embedded to have
-// forward compatible implementations.
-//
-// NOTE: this should be embedded by value instead of pointer to avoid a nil
-// pointer dereference when methods are called.
-type UnimplementedResumePersistenceServiceServer struct{}
+func (c *resumePersistenceServiceClient) GetResumeRevision(ctx context.Context, in *GetResumeRevisionRequest, opts ...grpc.CallOption) (*ResumeRevision, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ResumeRevision)
+	err := c.cc.Invoke(ctx, ResumePersistenceService_GetResumeRevision_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
 
-func (UnimplementedResumePersistenceServiceServer) SaveResume(context.Context, *SaveResumeRequest) (*SavedResume, error) {
-	return nil, status.Error(codes.Unimplemented, "method SaveResume not implemented")
+func (c *resumePersistenceServiceClient) RestoreResumeRevision(ctx context.Context, in *RestoreResumeRevisionRequest, opts ...grpc.CallOption) (*SavedResume, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SavedResume)
+	err := c.cc.Invoke(ctx, ResumePersistenceService_RestoreResumeRevision_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedResumePersistenceServiceServer) ListResumes(context.Context, *ListResumesRequest) (*ListResumesResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method ListResumes not implemented")
+
+func (c *resumePersistenceServiceClient) DiffResumes(ctx context.Context, in *DiffResumesRequest, opts ...grpc.CallOption) (*ResumeDiff, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ResumeDiff)
+	err := c.cc.Invoke(ctx, ResumePersistenceService_DiffResumes_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedResumePersistenceServiceServer) DeleteResume(context.Context, *DeleteResumeRequest) (*DeleteResumeResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method DeleteResume not implemented")
+
+func (c *resumePersistenceServiceClient) ListDeletedResumes(ctx context.Context, in *ListDeletedResumesRequest, opts ...grpc.CallOption) (*ListResumesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListResumesResponse)
+	err := c.cc.Invoke(ctx, ResumePersistenceService_ListDeletedResumes_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedResumePersistenceServiceServer) mustEmbedUnimplementedResumePersistenceServiceServer() {
+
+func (c *resumePersistenceServiceClient) RestoreResume(ctx context.Context, in *RestoreResumeRequest, opts ...grpc.CallOption) (*SavedResume, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SavedResume)
+	err := c.cc.Invoke(ctx, ResumePersistenceService_RestoreResume_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedResumePersistenceServiceServer) testEmbeddedByValue() {}
 
-// UnsafeResumePersistenceServiceServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to ResumePersistenceServiceServer will
-// result in compilation errors.
-type UnsafeResumePersistenceServiceServer interface {
-	mustEmbedUnimplementedResumePersistenceServiceServer()
+func (c *resumePersistenceServiceClient) PurgeResume(ctx context.Context, in *PurgeResumeRequest, opts ...grpc.CallOption) (*PurgeResumeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PurgeResumeResponse)
+	err := c.cc.Invoke(ctx, ResumePersistenceService_PurgeResume_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func RegisterResumePersistenceServiceServer(s grpc.ServiceRegistrar, srv ResumePersistenceServiceServer) {
-	// If the following call panics, it indicates UnimplementedResumePersistenceServiceServer was
-	// embedded by pointer and is nil.  This will cause panics if an
-	// unimplemented method is ever invoked, so we test this at initialization
-	// time to prevent it from happening at runtime later due to I/O.
-	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
-		t.testEmbeddedByValue()
+func (c *resumePersistenceServiceClient) GetCurrentUser(ctx context.Context, in *GetCurrentUserRequest, opts ...grpc.CallOption) (*User, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(User)
+	err := c.cc.Invoke(ctx, ResumePersistenceService_GetCurrentUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
 	}
-	s.RegisterService(&ResumePersistenceService_ServiceDesc, srv)
+	return out, nil
 }
 
-func _ResumePersistenceService_SaveResume_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SaveResumeRequest)
-	if err := dec(in); err != nil {
+func (c *resumePersistenceServiceClient) ExportResume(ctx context.Context, in *ExportResumeRequest, opts ...grpc.CallOption) (*ExportResumeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExportResumeResponse)
+	err := c.cc.Invoke(ctx, ResumePersistenceService_ExportResume_FullMethodName, in, out, cOpts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(ResumePersistenceServiceServer).SaveResume(ctx, in)
+	return out, nil
+}
+
+func (c *resumePersistenceServiceClient) ImportResume(ctx context.Context, in *ImportResumeRequest, opts ...grpc.CallOption) (*ResumeData, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ResumeData)
+	err := c.cc.Invoke(ctx, ResumePersistenceService_ImportResume_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: ResumePersistenceService_SaveResume_FullMethodName,
+	return out, nil
+}
+
+func (c *resumePersistenceServiceClient) RenderResume(ctx context.Context, in *RenderResumeRequest, opts ...grpc.CallOption) (*RenderResumeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RenderResumeResponse)
+	err := c.cc.Invoke(ctx, ResumePersistenceService_RenderResume_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ResumePersistenceServiceServer).SaveResume(ctx, req.(*SaveResumeRequest))
+	return out, nil
+}
+
+func (c *resumePersistenceServiceClient) ParseResumeFile(ctx context.Context, in *ParseResumeFileRequest, opts ...grpc.CallOption) (*ParseResumeFileResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ParseResumeFileResponse)
+	err := c.cc.Invoke(ctx, ResumePersistenceService_ParseResumeFile_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
 	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _ResumePersistenceService_ListResumes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListResumesRequest)
-	if err := dec(in); err != nil {
+func (c *resumePersistenceServiceClient) CreateApplication(ctx context.Context, in *CreateApplicationRequest, opts ...grpc.CallOption) (*Application, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Application)
+	err := c.cc.Invoke(ctx, ResumePersistenceService_CreateApplication_FullMethodName, in, out, cOpts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(ResumePersistenceServiceServer).ListResumes(ctx, in)
+	return out, nil
+}
+
+func (c *resumePersistenceServiceClient) GetApplication(ctx context.Context, in *GetApplicationRequest, opts ...grpc.CallOption) (*Application, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Application)
+	err := c.cc.Invoke(ctx, ResumePersistenceService_GetApplication_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: ResumePersistenceService_ListResumes_FullMethodName,
+	return out, nil
+}
+
+func (c *resumePersistenceServiceClient) ListApplications(ctx context.Context, in *ListApplicationsRequest, opts ...grpc.CallOption) (*ListApplicationsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListApplicationsResponse)
+	err := c.cc.Invoke(ctx, ResumePersistenceService_ListApplications_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ResumePersistenceServiceServer).ListResumes(ctx, req.(*ListResumesRequest))
+	return out, nil
+}
+
+func (c *resumePersistenceServiceClient) UpdateApplication(ctx context.Context, in *UpdateApplicationRequest, opts ...grpc.CallOption) (*Application, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Application)
+	err := c.cc.Invoke(ctx, ResumePersistenceService_UpdateApplication_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
 	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _ResumePersistenceService_DeleteResume_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteResumeRequest)
-	if err := dec(in); err != nil {
+func (c *resumePersistenceServiceClient) DeleteApplication(ctx context.Context, in *DeleteApplicationRequest, opts ...grpc.CallOption) (*DeleteApplicationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteApplicationResponse)
+	err := c.cc.Invoke(ctx, ResumePersistenceService_DeleteApplication_FullMethodName, in, out, cOpts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(ResumePersistenceServiceServer).DeleteResume(ctx, in)
+	return out, nil
+}
+
+func (c *resumePersistenceServiceClient) SaveJobDescription(ctx context.Context, in *SaveJobDescriptionRequest, opts ...grpc.CallOption) (*JobDescription, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(JobDescription)
+	err := c.cc.Invoke(ctx, ResumePersistenceService_SaveJobDescription_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: ResumePersistenceService_DeleteResume_FullMethodName,
+	return out, nil
+}
+
+func (c *resumePersistenceServiceClient) GetJobDescription(ctx context.Context, in *GetJobDescriptionRequest, opts ...grpc.CallOption) (*JobDescription, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(JobDescription)
+	err := c.cc.Invoke(ctx, ResumePersistenceService_GetJobDescription_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ResumePersistenceServiceServer).DeleteResume(ctx, req.(*DeleteResumeRequest))
+	return out, nil
+}
+
+func (c *resumePersistenceServiceClient) ListJobDescriptions(ctx context.Context, in *ListJobDescriptionsRequest, opts ...grpc.CallOption) (*ListJobDescriptionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListJobDescriptionsResponse)
+	err := c.cc.Invoke(ctx, ResumePersistenceService_ListJobDescriptions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
 	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-// ResumePersistenceService_ServiceDesc is the grpc.ServiceDesc for ResumePersistenceService service.
-// It's only intended for direct use with grpc.RegisterService,
-// and not to be introspected or modified (even as a copy)
-var ResumePersistenceService_ServiceDesc = grpc.ServiceDesc{
-	ServiceName: "resume.ResumePersistenceService",
-	HandlerType: (*ResumePersistenceServiceServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "SaveResume",
-			Handler:    _ResumePersistenceService_SaveResume_Handler,
-		},
-		{
-			MethodName: "ListResumes",
-			Handler:    _ResumePersistenceService_ListResumes_Handler,
-		},
-		{
-			MethodName: "DeleteResume",
-			Handler:    _ResumePersistenceService_DeleteResume_Handler,
+func (c *resumePersistenceServiceClient) SaveAnalysis(ctx context.Context, in *SaveAnalysisRequest, opts ...grpc.CallOption) (*Analysis, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Analysis)
+	err := c.cc.Invoke(ctx, ResumePersistenceService_SaveAnalysis_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *resumePersistenceServiceClient) ListAnalyses(ctx context.Context, in *ListAnalysesRequest, opts ...grpc.CallOption) (*ListAnalysesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListAnalysesResponse)
+	err := c.cc.Invoke(ctx, ResumePersistenceService_ListAnalyses_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *resumePersistenceServiceClient) SaveCoverLetter(ctx context.Context, in *SaveCoverLetterRequest, opts ...grpc.CallOption) (*CoverLetter, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CoverLetter)
+	err := c.cc.Invoke(ctx, ResumePersistenceService_SaveCoverLetter_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *resumePersistenceServiceClient) UpdateCoverLetter(ctx context.Context, in *UpdateCoverLetterRequest, opts ...grpc.CallOption) (*CoverLetter, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CoverLetter)
+	err := c.cc.Invoke(ctx, ResumePersistenceService_UpdateCoverLetter_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *resumePersistenceServiceClient) ListCoverLetters(ctx context.Context, in *ListCoverLettersRequest, opts ...grpc.CallOption) (*ListCoverLettersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListCoverLettersResponse)
+	err := c.cc.Invoke(ctx, ResumePersistenceService_ListCoverLetters_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *resumePersistenceServiceClient) DeleteCoverLetter(ctx context.Context, in *DeleteCoverLetterRequest, opts ...grpc.CallOption) (*DeleteCoverLetterResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteCoverLetterResponse)
+	err := c.cc.Invoke(ctx, ResumePersistenceService_DeleteCoverLetter_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *resumePersistenceServiceClient) SaveQuestionSet(ctx context.Context, in *SaveQuestionSetRequest, opts ...grpc.CallOption) (*QuestionSet, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QuestionSet)
+	err := c.cc.Invoke(ctx, ResumePersistenceService_SaveQuestionSet_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *resumePersistenceServiceClient) ListQuestionSets(ctx context.Context, in *ListQuestionSetsRequest, opts ...grpc.CallOption) (*ListQuestionSetsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListQuestionSetsResponse)
+	err := c.cc.Invoke(ctx, ResumePersistenceService_ListQuestionSets_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *resumePersistenceServiceClient) UpdatePracticeQuestion(ctx context.Context, in *UpdatePracticeQuestionRequest, opts ...grpc.CallOption) (*PracticeQuestion, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PracticeQuestion)
+	err := c.cc.Invoke(ctx, ResumePersistenceService_UpdatePracticeQuestion_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *resumePersistenceServiceClient) DeleteQuestionSet(ctx context.Context, in *DeleteQuestionSetRequest, opts ...grpc.CallOption) (*DeleteQuestionSetResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteQuestionSetResponse)
+	err := c.cc.Invoke(ctx, ResumePersistenceService_DeleteQuestionSet_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ResumePersistenceServiceServer is the server API for ResumePersistenceService service.
+// All implementations must embed UnimplementedResumePersistenceServiceServer
+// for forward compatibility.
+type ResumePersistenceServiceServer interface {
+	SaveResume(context.Context, *SaveResumeRequest) (*SavedResume, error)
+	GetResume(context.Context, *GetResumeRequest) (*SavedResume, error)
+	UpdateResume(context.Context, *UpdateResumeRequest) (*SavedResume, error)
+	ListResumes(context.Context, *ListResumesRequest) (*ListResumesResponse, error)
+	DeleteResume(context.Context, *DeleteResumeRequest) (*DeleteResumeResponse, error)
+	ListResumeRevisions(context.Context, *ListResumeRevisionsRequest) (*ListResumeRevisionsResponse, error)
+	GetResumeRevision(context.Context, *GetResumeRevisionRequest) (*ResumeRevision, error)
+	RestoreResumeRevision(context.Context, *RestoreResumeRevisionRequest) (*SavedResume, error)
+	DiffResumes(context.Context, *DiffResumesRequest) (*ResumeDiff, error)
+	ListDeletedResumes(context.Context, *ListDeletedResumesRequest) (*ListResumesResponse, error)
+	RestoreResume(context.Context, *RestoreResumeRequest) (*SavedResume, error)
+	PurgeResume(context.Context, *PurgeResumeRequest) (*PurgeResumeResponse, error)
+	GetCurrentUser(context.Context, *GetCurrentUserRequest) (*User, error)
+	ExportResume(context.Context, *ExportResumeRequest) (*ExportResumeResponse, error)
+	ImportResume(context.Context, *ImportResumeRequest) (*ResumeData, error)
+	RenderResume(context.Context, *RenderResumeRequest) (*RenderResumeResponse, error)
+	ParseResumeFile(context.Context, *ParseResumeFileRequest) (*ParseResumeFileResponse, error)
+	CreateApplication(context.Context, *CreateApplicationRequest) (*Application, error)
+	GetApplication(context.Context, *GetApplicationRequest) (*Application, error)
+	ListApplications(context.Context, *ListApplicationsRequest) (*ListApplicationsResponse, error)
+	UpdateApplication(context.Context, *UpdateApplicationRequest) (*Application, error)
+	DeleteApplication(context.Context, *DeleteApplicationRequest) (*DeleteApplicationResponse, error)
+	SaveJobDescription(context.Context, *SaveJobDescriptionRequest) (*JobDescription, error)
+	GetJobDescription(context.Context, *GetJobDescriptionRequest) (*JobDescription, error)
+	ListJobDescriptions(context.Context, *ListJobDescriptionsRequest) (*ListJobDescriptionsResponse, error)
+	SaveAnalysis(context.Context, *SaveAnalysisRequest) (*Analysis, error)
+	ListAnalyses(context.Context, *ListAnalysesRequest) (*ListAnalysesResponse, error)
+	SaveCoverLetter(context.Context, *SaveCoverLetterRequest) (*CoverLetter, error)
+	UpdateCoverLetter(context.Context, *UpdateCoverLetterRequest) (*CoverLetter, error)
+	ListCoverLetters(context.Context, *ListCoverLettersRequest) (*ListCoverLettersResponse, error)
+	DeleteCoverLetter(context.Context, *DeleteCoverLetterRequest) (*DeleteCoverLetterResponse, error)
+	SaveQuestionSet(context.Context, *SaveQuestionSetRequest) (*QuestionSet, error)
+	ListQuestionSets(context.Context, *ListQuestionSetsRequest) (*ListQuestionSetsResponse, error)
+	UpdatePracticeQuestion(context.Context, *UpdatePracticeQuestionRequest) (*PracticeQuestion, error)
+	DeleteQuestionSet(context.Context, *DeleteQuestionSetRequest) (*DeleteQuestionSetResponse, error)
+	mustEmbedUnimplementedResumePersistenceServiceServer()
+}
+
+// UnimplementedResumePersistenceServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedResumePersistenceServiceServer struct{}
+
+func (UnimplementedResumePersistenceServiceServer) SaveResume(context.Context, *SaveResumeRequest) (*SavedResume, error) {
+	return nil, status.Error(codes.Unimplemented, "method SaveResume not implemented")
+}
+func (UnimplementedResumePersistenceServiceServer) GetResume(context.Context, *GetResumeRequest) (*SavedResume, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetResume not implemented")
+}
+func (UnimplementedResumePersistenceServiceServer) UpdateResume(context.Context, *UpdateResumeRequest) (*SavedResume, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateResume not implemented")
+}
+func (UnimplementedResumePersistenceServiceServer) ListResumes(context.Context, *ListResumesRequest) (*ListResumesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListResumes not implemented")
+}
+func (UnimplementedResumePersistenceServiceServer) DeleteResume(context.Context, *DeleteResumeRequest) (*DeleteResumeResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteResume not implemented")
+}
+func (UnimplementedResumePersistenceServiceServer) ListResumeRevisions(context.Context, *ListResumeRevisionsRequest) (*ListResumeRevisionsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListResumeRevisions not implemented")
+}
+func (UnimplementedResumePersistenceServiceServer) GetResumeRevision(context.Context, *GetResumeRevisionRequest) (*ResumeRevision, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetResumeRevision not implemented")
+}
+func (UnimplementedResumePersistenceServiceServer) RestoreResumeRevision(context.Context, *RestoreResumeRevisionRequest) (*SavedResume, error) {
+	return nil, status.Error(codes.Unimplemented, "method RestoreResumeRevision not implemented")
+}
+func (UnimplementedResumePersistenceServiceServer) DiffResumes(context.Context, *DiffResumesRequest) (*ResumeDiff, error) {
+	return nil, status.Error(codes.Unimplemented, "method DiffResumes not implemented")
+}
+func (UnimplementedResumePersistenceServiceServer) ListDeletedResumes(context.Context, *ListDeletedResumesRequest) (*ListResumesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListDeletedResumes not implemented")
+}
+func (UnimplementedResumePersistenceServiceServer) RestoreResume(context.Context, *RestoreResumeRequest) (*SavedResume, error) {
+	return nil, status.Error(codes.Unimplemented, "method RestoreResume not implemented")
+}
+func (UnimplementedResumePersistenceServiceServer) PurgeResume(context.Context, *PurgeResumeRequest) (*PurgeResumeResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method PurgeResume not implemented")
+}
+func (UnimplementedResumePersistenceServiceServer) GetCurrentUser(context.Context, *GetCurrentUserRequest) (*User, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetCurrentUser not implemented")
+}
+func (UnimplementedResumePersistenceServiceServer) ExportResume(context.Context, *ExportResumeRequest) (*ExportResumeResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ExportResume not implemented")
+}
+func (UnimplementedResumePersistenceServiceServer) ImportResume(context.Context, *ImportResumeRequest) (*ResumeData, error) {
+	return nil, status.Error(codes.Unimplemented, "method ImportResume not implemented")
+}
+func (UnimplementedResumePersistenceServiceServer) RenderResume(context.Context, *RenderResumeRequest) (*RenderResumeResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RenderResume not implemented")
+}
+func (UnimplementedResumePersistenceServiceServer) ParseResumeFile(context.Context, *ParseResumeFileRequest) (*ParseResumeFileResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ParseResumeFile not implemented")
+}
+func (UnimplementedResumePersistenceServiceServer) CreateApplication(context.Context, *CreateApplicationRequest) (*Application, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateApplication not implemented")
+}
+func (UnimplementedResumePersistenceServiceServer) GetApplication(context.Context, *GetApplicationRequest) (*Application, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetApplication not implemented")
+}
+func (UnimplementedResumePersistenceServiceServer) ListApplications(context.Context, *ListApplicationsRequest) (*ListApplicationsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListApplications not implemented")
+}
+func (UnimplementedResumePersistenceServiceServer) UpdateApplication(context.Context, *UpdateApplicationRequest) (*Application, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateApplication not implemented")
+}
+func (UnimplementedResumePersistenceServiceServer) DeleteApplication(context.Context, *DeleteApplicationRequest) (*DeleteApplicationResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteApplication not implemented")
+}
+func (UnimplementedResumePersistenceServiceServer) SaveJobDescription(context.Context, *SaveJobDescriptionRequest) (*JobDescription, error) {
+	return nil, status.Error(codes.Unimplemented, "method SaveJobDescription not implemented")
+}
+func (UnimplementedResumePersistenceServiceServer) GetJobDescription(context.Context, *GetJobDescriptionRequest) (*JobDescription, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetJobDescription not implemented")
+}
+func (UnimplementedResumePersistenceServiceServer) ListJobDescriptions(context.Context, *ListJobDescriptionsRequest) (*ListJobDescriptionsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListJobDescriptions not implemented")
+}
+func (UnimplementedResumePersistenceServiceServer) SaveAnalysis(context.Context, *SaveAnalysisRequest) (*Analysis, error) {
+	return nil, status.Error(codes.Unimplemented, "method SaveAnalysis not implemented")
+}
+func (UnimplementedResumePersistenceServiceServer) ListAnalyses(context.Context, *ListAnalysesRequest) (*ListAnalysesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListAnalyses not implemented")
+}
+func (UnimplementedResumePersistenceServiceServer) SaveCoverLetter(context.Context, *SaveCoverLetterRequest) (*CoverLetter, error) {
+	return nil, status.Error(codes.Unimplemented, "method SaveCoverLetter not implemented")
+}
+func (UnimplementedResumePersistenceServiceServer) UpdateCoverLetter(context.Context, *UpdateCoverLetterRequest) (*CoverLetter, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateCoverLetter not implemented")
+}
+func (UnimplementedResumePersistenceServiceServer) ListCoverLetters(context.Context, *ListCoverLettersRequest) (*ListCoverLettersResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListCoverLetters not implemented")
+}
+func (UnimplementedResumePersistenceServiceServer) DeleteCoverLetter(context.Context, *DeleteCoverLetterRequest) (*DeleteCoverLetterResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteCoverLetter not implemented")
+}
+func (UnimplementedResumePersistenceServiceServer) SaveQuestionSet(context.Context, *SaveQuestionSetRequest) (*QuestionSet, error) {
+	return nil, status.Error(codes.Unimplemented, "method SaveQuestionSet not implemented")
+}
+func (UnimplementedResumePersistenceServiceServer) ListQuestionSets(context.Context, *ListQuestionSetsRequest) (*ListQuestionSetsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListQuestionSets not implemented")
+}
+func (UnimplementedResumePersistenceServiceServer) UpdatePracticeQuestion(context.Context, *UpdatePracticeQuestionRequest) (*PracticeQuestion, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdatePracticeQuestion not implemented")
+}
+func (UnimplementedResumePersistenceServiceServer) DeleteQuestionSet(context.Context, *DeleteQuestionSetRequest) (*DeleteQuestionSetResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteQuestionSet not implemented")
+}
+func (UnimplementedResumePersistenceServiceServer) mustEmbedUnimplementedResumePersistenceServiceServer() {
+}
+func (UnimplementedResumePersistenceServiceServer) testEmbeddedByValue() {}
+
+// UnsafeResumePersistenceServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ResumePersistenceServiceServer will
+// result in compilation errors.
+type UnsafeResumePersistenceServiceServer interface {
+	mustEmbedUnimplementedResumePersistenceServiceServer()
+}
+
+func RegisterResumePersistenceServiceServer(s grpc.ServiceRegistrar, srv ResumePersistenceServiceServer) {
+	// If the following call panics, it indicates UnimplementedResumePersistenceServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&ResumePersistenceService_ServiceDesc, srv)
+}
+
+func _ResumePersistenceService_SaveResume_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SaveResumeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ResumePersistenceServiceServer).SaveResume(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ResumePersistenceService_SaveResume_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ResumePersistenceServiceServer).SaveResume(ctx, req.(*SaveResumeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ResumePersistenceService_GetResume_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetResumeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ResumePersistenceServiceServer).GetResume(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ResumePersistenceService_GetResume_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ResumePersistenceServiceServer).GetResume(ctx, req.(*GetResumeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ResumePersistenceService_UpdateResume_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateResumeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ResumePersistenceServiceServer).UpdateResume(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ResumePersistenceService_UpdateResume_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ResumePersistenceServiceServer).UpdateResume(ctx, req.(*UpdateResumeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ResumePersistenceService_ListResumes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListResumesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ResumePersistenceServiceServer).ListResumes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ResumePersistenceService_ListResumes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ResumePersistenceServiceServer).ListResumes(ctx, req.(*ListResumesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ResumePersistenceService_DeleteResume_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteResumeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ResumePersistenceServiceServer).DeleteResume(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ResumePersistenceService_DeleteResume_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ResumePersistenceServiceServer).DeleteResume(ctx, req.(*DeleteResumeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ResumePersistenceService_ListResumeRevisions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListResumeRevisionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ResumePersistenceServiceServer).ListResumeRevisions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ResumePersistenceService_ListResumeRevisions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ResumePersistenceServiceServer).ListResumeRevisions(ctx, req.(*ListResumeRevisionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ResumePersistenceService_GetResumeRevision_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetResumeRevisionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ResumePersistenceServiceServer).GetResumeRevision(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ResumePersistenceService_GetResumeRevision_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ResumePersistenceServiceServer).GetResumeRevision(ctx, req.(*GetResumeRevisionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ResumePersistenceService_RestoreResumeRevision_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreResumeRevisionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ResumePersistenceServiceServer).RestoreResumeRevision(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ResumePersistenceService_RestoreResumeRevision_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ResumePersistenceServiceServer).RestoreResumeRevision(ctx, req.(*RestoreResumeRevisionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ResumePersistenceService_DiffResumes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DiffResumesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ResumePersistenceServiceServer).DiffResumes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ResumePersistenceService_DiffResumes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ResumePersistenceServiceServer).DiffResumes(ctx, req.(*DiffResumesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ResumePersistenceService_ListDeletedResumes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDeletedResumesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ResumePersistenceServiceServer).ListDeletedResumes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ResumePersistenceService_ListDeletedResumes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ResumePersistenceServiceServer).ListDeletedResumes(ctx, req.(*ListDeletedResumesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ResumePersistenceService_RestoreResume_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreResumeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ResumePersistenceServiceServer).RestoreResume(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ResumePersistenceService_RestoreResume_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ResumePersistenceServiceServer).RestoreResume(ctx, req.(*RestoreResumeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ResumePersistenceService_PurgeResume_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PurgeResumeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ResumePersistenceServiceServer).PurgeResume(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ResumePersistenceService_PurgeResume_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ResumePersistenceServiceServer).PurgeResume(ctx, req.(*PurgeResumeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ResumePersistenceService_GetCurrentUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCurrentUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ResumePersistenceServiceServer).GetCurrentUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ResumePersistenceService_GetCurrentUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ResumePersistenceServiceServer).GetCurrentUser(ctx, req.(*GetCurrentUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ResumePersistenceService_ExportResume_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportResumeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ResumePersistenceServiceServer).ExportResume(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ResumePersistenceService_ExportResume_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ResumePersistenceServiceServer).ExportResume(ctx, req.(*ExportResumeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ResumePersistenceService_ImportResume_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportResumeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ResumePersistenceServiceServer).ImportResume(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ResumePersistenceService_ImportResume_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ResumePersistenceServiceServer).ImportResume(ctx, req.(*ImportResumeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ResumePersistenceService_RenderResume_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RenderResumeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ResumePersistenceServiceServer).RenderResume(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ResumePersistenceService_RenderResume_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ResumePersistenceServiceServer).RenderResume(ctx, req.(*RenderResumeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ResumePersistenceService_ParseResumeFile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ParseResumeFileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ResumePersistenceServiceServer).ParseResumeFile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ResumePersistenceService_ParseResumeFile_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ResumePersistenceServiceServer).ParseResumeFile(ctx, req.(*ParseResumeFileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ResumePersistenceService_CreateApplication_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateApplicationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ResumePersistenceServiceServer).CreateApplication(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ResumePersistenceService_CreateApplication_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ResumePersistenceServiceServer).CreateApplication(ctx, req.(*CreateApplicationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ResumePersistenceService_GetApplication_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetApplicationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ResumePersistenceServiceServer).GetApplication(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ResumePersistenceService_GetApplication_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ResumePersistenceServiceServer).GetApplication(ctx, req.(*GetApplicationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ResumePersistenceService_ListApplications_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListApplicationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ResumePersistenceServiceServer).ListApplications(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ResumePersistenceService_ListApplications_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ResumePersistenceServiceServer).ListApplications(ctx, req.(*ListApplicationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ResumePersistenceService_UpdateApplication_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateApplicationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ResumePersistenceServiceServer).UpdateApplication(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ResumePersistenceService_UpdateApplication_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ResumePersistenceServiceServer).UpdateApplication(ctx, req.(*UpdateApplicationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ResumePersistenceService_DeleteApplication_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteApplicationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ResumePersistenceServiceServer).DeleteApplication(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ResumePersistenceService_DeleteApplication_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ResumePersistenceServiceServer).DeleteApplication(ctx, req.(*DeleteApplicationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ResumePersistenceService_SaveJobDescription_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SaveJobDescriptionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ResumePersistenceServiceServer).SaveJobDescription(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ResumePersistenceService_SaveJobDescription_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ResumePersistenceServiceServer).SaveJobDescription(ctx, req.(*SaveJobDescriptionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ResumePersistenceService_GetJobDescription_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetJobDescriptionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ResumePersistenceServiceServer).GetJobDescription(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ResumePersistenceService_GetJobDescription_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ResumePersistenceServiceServer).GetJobDescription(ctx, req.(*GetJobDescriptionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ResumePersistenceService_ListJobDescriptions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListJobDescriptionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ResumePersistenceServiceServer).ListJobDescriptions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ResumePersistenceService_ListJobDescriptions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ResumePersistenceServiceServer).ListJobDescriptions(ctx, req.(*ListJobDescriptionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ResumePersistenceService_SaveAnalysis_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SaveAnalysisRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ResumePersistenceServiceServer).SaveAnalysis(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ResumePersistenceService_SaveAnalysis_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ResumePersistenceServiceServer).SaveAnalysis(ctx, req.(*SaveAnalysisRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ResumePersistenceService_ListAnalyses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAnalysesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ResumePersistenceServiceServer).ListAnalyses(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ResumePersistenceService_ListAnalyses_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ResumePersistenceServiceServer).ListAnalyses(ctx, req.(*ListAnalysesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ResumePersistenceService_SaveCoverLetter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SaveCoverLetterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ResumePersistenceServiceServer).SaveCoverLetter(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ResumePersistenceService_SaveCoverLetter_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ResumePersistenceServiceServer).SaveCoverLetter(ctx, req.(*SaveCoverLetterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ResumePersistenceService_UpdateCoverLetter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateCoverLetterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ResumePersistenceServiceServer).UpdateCoverLetter(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ResumePersistenceService_UpdateCoverLetter_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ResumePersistenceServiceServer).UpdateCoverLetter(ctx, req.(*UpdateCoverLetterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ResumePersistenceService_ListCoverLetters_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCoverLettersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ResumePersistenceServiceServer).ListCoverLetters(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ResumePersistenceService_ListCoverLetters_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ResumePersistenceServiceServer).ListCoverLetters(ctx, req.(*ListCoverLettersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ResumePersistenceService_DeleteCoverLetter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteCoverLetterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ResumePersistenceServiceServer).DeleteCoverLetter(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ResumePersistenceService_DeleteCoverLetter_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ResumePersistenceServiceServer).DeleteCoverLetter(ctx, req.(*DeleteCoverLetterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ResumePersistenceService_SaveQuestionSet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SaveQuestionSetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ResumePersistenceServiceServer).SaveQuestionSet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ResumePersistenceService_SaveQuestionSet_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ResumePersistenceServiceServer).SaveQuestionSet(ctx, req.(*SaveQuestionSetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ResumePersistenceService_ListQuestionSets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListQuestionSetsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ResumePersistenceServiceServer).ListQuestionSets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ResumePersistenceService_ListQuestionSets_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ResumePersistenceServiceServer).ListQuestionSets(ctx, req.(*ListQuestionSetsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ResumePersistenceService_UpdatePracticeQuestion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdatePracticeQuestionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ResumePersistenceServiceServer).UpdatePracticeQuestion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ResumePersistenceService_UpdatePracticeQuestion_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ResumePersistenceServiceServer).UpdatePracticeQuestion(ctx, req.(*UpdatePracticeQuestionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ResumePersistenceService_DeleteQuestionSet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteQuestionSetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ResumePersistenceServiceServer).DeleteQuestionSet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ResumePersistenceService_DeleteQuestionSet_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ResumePersistenceServiceServer).DeleteQuestionSet(ctx, req.(*DeleteQuestionSetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ResumePersistenceService_ServiceDesc is the grpc.ServiceDesc for ResumePersistenceService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ResumePersistenceService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "resume.ResumePersistenceService",
+	HandlerType: (*ResumePersistenceServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "SaveResume",
+			Handler:    _ResumePersistenceService_SaveResume_Handler,
+		},
+		{
+			MethodName: "GetResume",
+			Handler:    _ResumePersistenceService_GetResume_Handler,
+		},
+		{
+			MethodName: "UpdateResume",
+			Handler:    _ResumePersistenceService_UpdateResume_Handler,
+		},
+		{
+			MethodName: "ListResumes",
+			Handler:    _ResumePersistenceService_ListResumes_Handler,
+		},
+		{
+			MethodName: "DeleteResume",
+			Handler:    _ResumePersistenceService_DeleteResume_Handler,
+		},
+		{
+			MethodName: "ListResumeRevisions",
+			Handler:    _ResumePersistenceService_ListResumeRevisions_Handler,
+		},
+		{
+			MethodName: "GetResumeRevision",
+			Handler:    _ResumePersistenceService_GetResumeRevision_Handler,
+		},
+		{
+			MethodName: "RestoreResumeRevision",
+			Handler:    _ResumePersistenceService_RestoreResumeRevision_Handler,
+		},
+		{
+			MethodName: "DiffResumes",
+			Handler:    _ResumePersistenceService_DiffResumes_Handler,
+		},
+		{
+			MethodName: "ListDeletedResumes",
+			Handler:    _ResumePersistenceService_ListDeletedResumes_Handler,
+		},
+		{
+			MethodName: "RestoreResume",
+			Handler:    _ResumePersistenceService_RestoreResume_Handler,
+		},
+		{
+			MethodName: "PurgeResume",
+			Handler:    _ResumePersistenceService_PurgeResume_Handler,
+		},
+		{
+			MethodName: "GetCurrentUser",
+			Handler:    _ResumePersistenceService_GetCurrentUser_Handler,
+		},
+		{
+			MethodName: "ExportResume",
+			Handler:    _ResumePersistenceService_ExportResume_Handler,
+		},
+		{
+			MethodName: "ImportResume",
+			Handler:    _ResumePersistenceService_ImportResume_Handler,
+		},
+		{
+			MethodName: "RenderResume",
+			Handler:    _ResumePersistenceService_RenderResume_Handler,
+		},
+		{
+			MethodName: "ParseResumeFile",
+			Handler:    _ResumePersistenceService_ParseResumeFile_Handler,
+		},
+		{
+			MethodName: "CreateApplication",
+			Handler:    _ResumePersistenceService_CreateApplication_Handler,
+		},
+		{
+			MethodName: "GetApplication",
+			Handler:    _ResumePersistenceService_GetApplication_Handler,
+		},
+		{
+			MethodName: "ListApplications",
+			Handler:    _ResumePersistenceService_ListApplications_Handler,
+		},
+		{
+			MethodName: "UpdateApplication",
+			Handler:    _ResumePersistenceService_UpdateApplication_Handler,
+		},
+		{
+			MethodName: "DeleteApplication",
+			Handler:    _ResumePersistenceService_DeleteApplication_Handler,
+		},
+		{
+			MethodName: "SaveJobDescription",
+			Handler:    _ResumePersistenceService_SaveJobDescription_Handler,
+		},
+		{
+			MethodName: "GetJobDescription",
+			Handler:    _ResumePersistenceService_GetJobDescription_Handler,
+		},
+		{
+			MethodName: "ListJobDescriptions",
+			Handler:    _ResumePersistenceService_ListJobDescriptions_Handler,
+		},
+		{
+			MethodName: "SaveAnalysis",
+			Handler:    _ResumePersistenceService_SaveAnalysis_Handler,
+		},
+		{
+			MethodName: "ListAnalyses",
+			Handler:    _ResumePersistenceService_ListAnalyses_Handler,
+		},
+		{
+			MethodName: "SaveCoverLetter",
+			Handler:    _ResumePersistenceService_SaveCoverLetter_Handler,
+		},
+		{
+			MethodName: "UpdateCoverLetter",
+			Handler:    _ResumePersistenceService_UpdateCoverLetter_Handler,
+		},
+		{
+			MethodName: "ListCoverLetters",
+			Handler:    _ResumePersistenceService_ListCoverLetters_Handler,
+		},
+		{
+			MethodName: "DeleteCoverLetter",
+			Handler:    _ResumePersistenceService_DeleteCoverLetter_Handler,
+		},
+		{
+			MethodName: "SaveQuestionSet",
+			Handler:    _ResumePersistenceService_SaveQuestionSet_Handler,
+		},
+		{
+			MethodName: "ListQuestionSets",
+			Handler:    _ResumePersistenceService_ListQuestionSets_Handler,
+		},
+		{
+			MethodName: "UpdatePracticeQuestion",
+			Handler:    _ResumePersistenceService_UpdatePracticeQuestion_Handler,
+		},
+		{
+			MethodName: "DeleteQuestionSet",
+			Handler:    _ResumePersistenceService_DeleteQuestionSet_Handler,
 		},
 	},
 	Streams:  []grpc.StreamDesc{},
@@ -24,7 +24,7 @@ _sym_db = _symbol_database.Default()
 
 
 
-DESCRIPTOR = _descriptor_pool.Default().AddSerializedFile(b'\n\x19shared/proto/resume.proto\x12\x06resume\"\xe3\x03\n\nResumeData\x12\x11\n\tfull_name\x18\x01 \x01(\t\x12\r\n\x05\x65mail\x18\x02 \x01(\t\x12\r\n\x05phone\x18\x03 \x01(\t\x12\x0f\n\x07summary\x18\x04 \x01(\t\x12\x0e\n\x06skills\x18\x05 \x03(\t\x12&\n\nexperience\x18\x06 \x03(\x0b\x32\x12.resume.Experience\x12$\n\teducation\x18\x07 \x03(\x0b\x32\x11.resume.Education\x12!\n\x08projects\x18\x08 \x03(\x0b\x32\x0f.resume.Project\x12)\n\x0c\x63\x65rtificates\x18\t \x03(\x0b\x32\x13.resume.Certificate\x12\x11\n\tjob_title\x18\n \x01(\t\x12\x10\n\x08location\x18\x0b \x01(\t\x12\x10\n\x08linkedin\x18\x0c \x01(\t\x12\x0e\n\x06github\x18\r \x01(\t\x12\x0f\n\x07website\x18\x0e \x01(\t\x12\x15\n\rprofile_image\x18\x12 \x01(\t\x12(\n\x0cskill_groups\x18\x0f \x03(\x0b\x32\x12.resume.SkillGroup\x12#\n\tlanguages\x18\x10 \x03(\x0b\x32\x10.resume.Language\x12)\n\x0c\x61\x63hievements\x18\x11 \x03(\x0b\x32\x13.resume.Achievement\"g\n\nExperience\x12\r\n\x05title\x18\x01 \x01(\t\x12\x0f\n\x07\x63ompany\x18\x02 \x01(\t\x12\x12\n\nstart_date\x18\x03 \x01(\t\x12\x10\n\x08\x65nd_date\x18\x04 \x01(\t\x12\x13\n\x0b\x64\x65scription\x18\x05 \x01(\t\"I\n\tEducation\x12\x0e\n\x06\x64\x65gree\x18\x01 \x01(\t\x12\x13\n\x0binstitution\x18\x02 \x01(\t\x12\x17\n\x0fgraduation_date\x18\x03 \x01(\t\"a\n\x07Project\x12\r\n\x05title\x18\x01 \x01(\t\x12\x13\n\x0b\x64\x65scription\x18\x02 \x01(\t\x12\x12\n\ntech_stack\x18\x03 \x03(\t\x12\x0c\n\x04\x64\x61te\x18\x04 \x01(\t\x12\x10\n\x08location\x18\x05 \x01(\t\"G\n\x0b\x43\x65rtificate\x12\x0c\n\x04name\x18\x01 \x01(\t\x12\x0e\n\x06issuer\x18\x02 \x01(\t\x12\x0c\n\x04\x64\x61te\x18\x03 \x01(\t\x12\x0c\n\x04link\x18\x04 \x01(\t\"-\n\nSkillGroup\x12\x10\n\x08\x63\x61tegory\x18\x01 \x01(\t\x12\r\n\x05items\x18\x02 \x03(\t\"1\n\x08Language\x12\x10\n\x08language\x18\x01 \x01(\t\x12\x13\n\x0bproficiency\x18\x02 \x01(\t\"1\n\x0b\x41\x63hievement\x12\r\n\x05title\x18\x01 \x01(\t\x12\x13\n\x0b\x64\x65scription\x18\x02 \x01(\t\"U\n\rTailorRequest\x12+\n\x0foriginal_resume\x18\x01 \x01(\x0b\x32\x12.resume.ResumeData\x12\x17\n\x0fjob_description\x18\x02 \x01(\t\"S\n\x0eTailorResponse\x12+\n\x0ftailored_resume\x18\x01 \x01(\x0b\x32\x12.resume.ResumeData\x12\x14\n\x0c\x63over_letter\x18\x02 \x01(\t\"S\n\x14InterviewPrepRequest\x12\"\n\x06resume\x18\x01 \x01(\x0b\x32\x12.resume.ResumeData\x12\x17\n\x0fjob_description\x18\x02 \x01(\t\"I\n\x11InterviewQuestion\x12\x10\n\x08question\x18\x01 \x01(\t\x12\x0c\n\x04type\x18\x02 \x01(\t\x12\x14\n\x0c\x61nswer_guide\x18\x03 \x01(\t\"E\n\x15InterviewPrepResponse\x12,\n\tquestions\x18\x01 \x03(\x0b\x32\x19.resume.InterviewQuestion\"S\n\x14\x41nalyzeResumeRequest\x12\"\n\x06resume\x18\x01 \x01(\x0b\x32\x12.resume.ResumeData\x12\x17\n\x0fjob_description\x18\x02 \x01(\t\"e\n\x15\x41nalyzeResumeResponse\x12\r\n\x05score\x18\x01 \x01(\x05\x12\x10\n\x08\x66\x65\x65\x64\x62\x61\x63k\x18\x02 \x03(\t\x12\x18\n\x10missing_keywords\x18\x03 \x03(\t\x12\x11\n\treasoning\x18\x04 \x01(\t\"u\n\x0bSavedResume\x12\n\n\x02id\x18\x01 \x01(\t\x12\'\n\x0bresume_data\x18\x02 \x01(\x0b\x32\x12.resume.ResumeData\x12\x0c\n\x04tags\x18\x03 \x03(\t\x12\x0f\n\x07version\x18\x04 \x01(\t\x12\x12\n\ncreated_at\x18\x05 \x01(\t\"V\n\x11SaveResumeRequest\x12\"\n\x06resume\x18\x01 \x01(\x0b\x32\x12.resume.ResumeData\x12\x0c\n\x04tags\x18\x02 \x03(\t\x12\x0f\n\x07version\x18\x03 \x01(\t\"\"\n\x12ListResumesRequest\x12\x0c\n\x04tags\x18\x01 \x03(\t\";\n\x13ListResumesResponse\x12$\n\x07resumes\x18\x01 \x03(\x0b\x32\x13.resume.SavedResume\"!\n\x13\x44\x65leteResumeRequest\x12\n\n\x02id\x18\x01 \x01(\t\"\'\n\x14\x44\x65leteResumeResponse\x12\x0f\n\x07success\x18\x01 \x01(\x08\x32\xf3\x01\n\tAIService\x12=\n\x0cTailorResume\x12\x15.resume.TailorRequest\x1a\x16.resume.TailorResponse\x12L\n\rAnalyzeResume\x12\x1c.resume.AnalyzeResumeRequest\x1a\x1d.resume.AnalyzeResumeResponse\x12Y\n\x1aGenerateInterviewQuestions\x12\x1c.resume.InterviewPrepRequest\x1a\x1d.resume.InterviewPrepResponse2\xeb\x01\n\x18ResumePersistenceService\x12<\n\nSaveResume\x12\x19.resume.SaveResumeRequest\x1a\x13.resume.SavedResume\x12\x46\n\x0bListResumes\x12\x1a.resume.ListResumesRequest\x1a\x1b.resume.ListResumesResponse\x12I\n\x0c\x44\x65leteResume\x12\x1b.resume.DeleteResumeRequest\x1a\x1c.resume.DeleteResumeResponseB&Z$github.com/iprotoresume/shared/protob\x06proto3')
+DESCRIPTOR = _descriptor_pool.Default().AddSerializedFile(b'\n\x19shared/proto/resume.proto\x12\x06resume\"\xe3\x03\n\nResumeData\x12\x11\n\tfull_name\x18\x01 \x01(\t\x12\r\n\x05\x65mail\x18\x02 \x01(\t\x12\r\n\x05phone\x18\x03 \x01(\t\x12\x0f\n\x07summary\x18\x04 \x01(\t\x12\x0e\n\x06skills\x18\x05 \x03(\t\x12&\n\nexperience\x18\x06 \x03(\x0b\x32\x12.resume.Experience\x12$\n\teducation\x18\x07 \x03(\x0b\x32\x11.resume.Education\x12!\n\x08projects\x18\x08 \x03(\x0b\x32\x0f.resume.Project\x12)\n\x0c\x63\x65rtificates\x18\t \x03(\x0b\x32\x13.resume.Certificate\x12\x11\n\tjob_title\x18\n \x01(\t\x12\x10\n\x08location\x18\x0b \x01(\t\x12\x10\n\x08linkedin\x18\x0c \x01(\t\x12\x0e\n\x06github\x18\r \x01(\t\x12\x0f\n\x07website\x18\x0e \x01(\t\x12\x15\n\rprofile_image\x18\x12 \x01(\t\x12(\n\x0cskill_groups\x18\x0f \x03(\x0b\x32\x12.resume.SkillGroup\x12#\n\tlanguages\x18\x10 \x03(\x0b\x32\x10.resume.Language\x12)\n\x0c\x61\x63hievements\x18\x11 \x03(\x0b\x32\x13.resume.Achievement\"g\n\nExperience\x12\r\n\x05title\x18\x01 \x01(\t\x12\x0f\n\x07\x63ompany\x18\x02 \x01(\t\x12\x12\n\nstart_date\x18\x03 \x01(\t\x12\x10\n\x08\x65nd_date\x18\x04 \x01(\t\x12\x13\n\x0b\x64\x65scription\x18\x05 \x01(\t\"I\n\tEducation\x12\x0e\n\x06\x64\x65gree\x18\x01 \x01(\t\x12\x13\n\x0binstitution\x18\x02 \x01(\t\x12\x17\n\x0fgraduation_date\x18\x03 \x01(\t\"a\n\x07Project\x12\r\n\x05title\x18\x01 \x01(\t\x12\x13\n\x0b\x64\x65scription\x18\x02 \x01(\t\x12\x12\n\ntech_stack\x18\x03 \x03(\t\x12\x0c\n\x04\x64\x61te\x18\x04 \x01(\t\x12\x10\n\x08location\x18\x05 \x01(\t\"G\n\x0b\x43\x65rtificate\x12\x0c\n\x04name\x18\x01 \x01(\t\x12\x0e\n\x06issuer\x18\x02 \x01(\t\x12\x0c\n\x04\x64\x61te\x18\x03 \x01(\t\x12\x0c\n\x04link\x18\x04 \x01(\t\"-\n\nSkillGroup\x12\x10\n\x08\x63\x61tegory\x18\x01 \x01(\t\x12\r\n\x05items\x18\x02 \x03(\t\"1\n\x08Language\x12\x10\n\x08language\x18\x01 \x01(\t\x12\x13\n\x0bproficiency\x18\x02 \x01(\t\"1\n\x0b\x41\x63hievement\x12\r\n\x05title\x18\x01 \x01(\t\x12\x13\n\x0b\x64\x65scription\x18\x02 \x01(\t\"U\n\rTailorRequest\x12+\n\x0foriginal_resume\x18\x01 \x01(\x0b\x32\x12.resume.ResumeData\x12\x17\n\x0fjob_description\x18\x02 \x01(\t\"S\n\x0eTailorResponse\x12+\n\x0ftailored_resume\x18\x01 \x01(\x0b\x32\x12.resume.ResumeData\x12\x14\n\x0c\x63over_letter\x18\x02 \x01(\t\"S\n\x14InterviewPrepRequest\x12\"\n\x06resume\x18\x01 \x01(\x0b\x32\x12.resume.ResumeData\x12\x17\n\x0fjob_description\x18\x02 \x01(\t\"I\n\x11InterviewQuestion\x12\x10\n\x08question\x18\x01 \x01(\t\x12\x0c\n\x04type\x18\x02 \x01(\t\x12\x14\n\x0c\x61nswer_guide\x18\x03 \x01(\t\"E\n\x15InterviewPrepResponse\x12,\n\tquestions\x18\x01 \x03(\x0b\x32\x19.resume.InterviewQuestion\"E\n\x12ParseResumeRequest\x12\x0c\n\x04text\x18\x01 \x01(\t\x12!\n\x05\x64raft\x18\x02 \x01(\x0b\x32\x12.resume.ResumeData\"S\n\x14\x41nalyzeResumeRequest\x12\"\n\x06resume\x18\x01 \x01(\x0b\x32\x12.resume.ResumeData\x12\x17\n\x0fjob_description\x18\x02 \x01(\t\"e\n\x15\x41nalyzeResumeResponse\x12\r\n\x05score\x18\x01 \x01(\x05\x12\x10\n\x08\x66\x65\x65\x64\x62\x61\x63k\x18\x02 \x03(\t\x12\x18\n\x10missing_keywords\x18\x03 \x03(\t\x12\x11\n\treasoning\x18\x04 \x01(\t\"T\n\x04User\x12\n\n\x02id\x18\x01 \x01(\t\x12\x0f\n\x07subject\x18\x02 \x01(\t\x12\r\n\x05\x65mail\x18\x03 \x01(\t\x12\x0c\n\x04name\x18\x04 \x01(\t\x12\x12\n\ncreated_at\x18\x05 \x01(\t\"\x17\n\x15GetCurrentUserRequest\"\xc1\x01\n\x0bSavedResume\x12\n\n\x02id\x18\x01 \x01(\t\x12\'\n\x0bresume_data\x18\x02 \x01(\x0b\x32\x12.resume.ResumeData\x12\x0c\n\x04tags\x18\x03 \x03(\t\x12\x0f\n\x07version\x18\x04 \x01(\t\x12\x12\n\ncreated_at\x18\x05 \x01(\t\x12\x10\n\x08revision\x18\x06 \x01(\x05\x12\x12\n\nupdated_at\x18\x07 \x01(\t\x12\x12\n\ndeleted_at\x18\x08 \x01(\t\x12\x10\n\x08owner_id\x18\t \x01(\t\"b\n\x11SaveResumeRequest\x12\"\n\x06resume\x18\x01 \x01(\x0b\x32\x12.resume.ResumeData\x12\x0c\n\x04tags\x18\x02 \x03(\t\x12\x0f\n\x07version\x18\x03 \x01(\t\x12\n\n\x02id\x18\x04 \x01(\t\"\x1e\n\x10GetResumeRequest\x12\n\n\x02id\x18\x01 \x01(\t\"`\n\x13UpdateResumeRequest\x12\n\n\x02id\x18\x01 \x01(\t\x12\x19\n\x11\x65xpected_revision\x18\x02 \x01(\x05\x12\"\n\x06resume\x18\x03 \x01(\x0b\x32\x12.resume.ResumeData\"\x95\x01\n\x12ListResumesRequest\x12\x0c\n\x04tags\x18\x01 \x03(\t\x12\x11\n\tpage_size\x18\x02 \x01(\x05\x12\x12\n\npage_token\x18\x03 \x01(\t\x12(\n\x07sort_by\x18\x04 \x01(\x0e\x32\x17.resume.ResumeSortField\x12\x11\n\tascending\x18\x05 \x01(\x08\x12\r\n\x05query\x18\x06 \x01(\t\"z\n\x13ListResumesResponse\x12$\n\x07resumes\x18\x01 \x03(\x0b\x32\x13.resume.SavedResume\x12\x17\n\x0fnext_page_token\x18\x02 \x01(\t\x12\x13\n\x0btotal_count\x18\x03 \x01(\x05\x12\x0f\n\x07\x63ursors\x18\x04 \x03(\t\"!\n\x13\x44\x65leteResumeRequest\x12\n\n\x02id\x18\x01 \x01(\t\"\'\n\x14\x44\x65leteResumeResponse\x12\x0f\n\x07success\x18\x01 \x01(\x08\"\x1b\n\x19ListDeletedResumesRequest\"\"\n\x14RestoreResumeRequest\x12\n\n\x02id\x18\x01 \x01(\t\" \n\x12PurgeResumeRequest\x12\n\n\x02id\x18\x01 \x01(\t\"&\n\x13PurgeResumeResponse\x12\x0f\n\x07success\x18\x01 \x01(\x08\"~\n\x0eResumeRevision\x12\n\n\x02id\x18\x01 \x01(\t\x12\x11\n\tresume_id\x18\x02 \x01(\t\x12\x10\n\x08revision\x18\x03 \x01(\x05\x12\'\n\x0bresume_data\x18\x04 \x01(\x0b\x32\x12.resume.ResumeData\x12\x12\n\ncreated_at\x18\x05 \x01(\t\"/\n\x1aListResumeRevisionsRequest\x12\x11\n\tresume_id\x18\x01 \x01(\t\"H\n\x1bListResumeRevisionsResponse\x12)\n\trevisions\x18\x01 \x03(\x0b\x32\x16.resume.ResumeRevision\"?\n\x18GetResumeRevisionRequest\x12\x11\n\tresume_id\x18\x01 \x01(\t\x12\x10\n\x08revision\x18\x02 \x01(\x05\"C\n\x1cRestoreResumeRevisionRequest\x12\x11\n\tresume_id\x18\x01 \x01(\t\x12\x10\n\x08revision\x18\x02 \x01(\x05\"T\n\tResumeRef\x12\x11\n\tresume_id\x18\x01 \x01(\t\x12\x10\n\x08revision\x18\x02 \x01(\x05\x12\"\n\x06resume\x18\x03 \x01(\x0b\x32\x12.resume.ResumeData\"X\n\x12\x44iffResumesRequest\x12\x1f\n\x04\x62\x61se\x18\x01 \x01(\x0b\x32\x11.resume.ResumeRef\x12!\n\x06target\x18\x02 \x01(\x0b\x32\x11.resume.ResumeRef\"8\n\x08TextEdit\x12\x1e\n\x02op\x18\x01 \x01(\x0e\x32\x12.resume.TextEditOp\x12\x0c\n\x04text\x18\x02 \x01(\t\"\x91\x01\n\x0b\x46ieldChange\x12\r\n\x05\x66ield\x18\x01 \x01(\t\x12\x11\n\told_value\x18\x02 \x01(\t\x12\x11\n\tnew_value\x18\x03 \x01(\t\x12\x1f\n\x05\x65\x64its\x18\x04 \x03(\x0b\x32\x10.resume.TextEdit\x12\x14\n\x0c\x61\x64\x64\x65\x64_values\x18\x05 \x03(\t\x12\x16\n\x0eremoved_values\x18\x06 \x03(\t\"g\n\nItemChange\x12\'\n\x0b\x63hange_type\x18\x01 \x01(\x0e\x32\x12.resume.ChangeType\x12\x0b\n\x03key\x18\x02 \x01(\t\x12#\n\x06\x66ields\x18\x03 \x03(\x0b\x32\x13.resume.FieldChange\"\xcd\x01\n\nResumeDiff\x12#\n\x06\x66ields\x18\x01 \x03(\x0b\x32\x13.resume.FieldChange\x12&\n\nexperience\x18\x02 \x03(\x0b\x32\x12.resume.ItemChange\x12\"\n\x06skills\x18\x03 \x03(\x0b\x32\x12.resume.ItemChange\x12(\n\x0cskill_groups\x18\x04 \x03(\x0b\x32\x12.resume.ItemChange\x12$\n\x08projects\x18\x05 \x03(\x0b\x32\x12.resume.ItemChange\"G\n\x13\x45xportResumeRequest\x12\n\n\x02id\x18\x01 \x01(\t\x12$\n\x06\x66ormat\x18\x02 \x01(\x0e\x32\x14.resume.ResumeFormat\"O\n\x14\x45xportResumeResponse\x12\x0f\n\x07\x63ontent\x18\x01 \x01(\t\x12\x14\n\x0c\x63ontent_type\x18\x02 \x01(\t\x12\x10\n\x08\x66ilename\x18\x03 \x01(\t\"Z\n\x13ImportResumeRequest\x12$\n\x06\x66ormat\x18\x01 \x01(\x0e\x32\x14.resume.ResumeFormat\x12\x0f\n\x07\x63ontent\x18\x02 \x01(\t\x12\x0c\n\x04\x66ile\x18\x03 \x01(\x0c\"J\n\x13RenderResumeRequest\x12!\n\x06resume\x18\x01 \x01(\x0b\x32\x11.resume.ResumeRef\x12\x10\n\x08template\x18\x02 \x01(\t\"O\n\x14RenderResumeResponse\x12\x0f\n\x07\x63ontent\x18\x01 \x01(\x0c\x12\x14\n\x0c\x63ontent_type\x18\x02 \x01(\t\x12\x10\n\x08\x66ilename\x18\x03 \x01(\t\";\n\x16ParseResumeFileRequest\x12\x10\n\x08\x66ilename\x18\x01 \x01(\t\x12\x0f\n\x07\x63ontent\x18\x02 \x01(\x0c\"J\n\x17ParseResumeFileResponse\x12!\n\x05\x64raft\x18\x01 \x01(\x0b\x32\x12.resume.ResumeData\x12\x0c\n\x04text\x18\x02 \x01(\t\"f\n\x17\x41pplicationStatusChange\x12)\n\x06status\x18\x01 \x01(\x0e\x32\x19.resume.ApplicationStatus\x12\x0c\n\x04note\x18\x02 \x01(\t\x12\x12\n\nchanged_at\x18\x03 \x01(\t\"\xb2\x02\n\x0b\x41pplication\x12\n\n\x02id\x18\x01 \x01(\t\x12\x11\n\tresume_id\x18\x02 \x01(\t\x12\x17\n\x0fresume_revision\x18\x03 \x01(\x05\x12\x0f\n\x07\x63ompany\x18\x04 \x01(\t\x12\x0c\n\x04role\x18\x05 \x01(\t\x12\x17\n\x0fjob_description\x18\x06 \x01(\t\x12\x0b\n\x03url\x18\x07 \x01(\t\x12)\n\x06status\x18\x08 \x01(\x0e\x32\x19.resume.ApplicationStatus\x12\x12\n\napplied_at\x18\t \x01(\t\x12\r\n\x05notes\x18\n \x01(\t\x12\x30\n\x07history\x18\x0b \x03(\x0b\x32\x1f.resume.ApplicationStatusChange\x12\x12\n\ncreated_at\x18\x0c \x01(\t\x12\x12\n\nupdated_at\x18\r \x01(\t\"D\n\x18\x43reateApplicationRequest\x12(\n\x0b\x61pplication\x18\x01 \x01(\x0b\x32\x13.resume.Application\"#\n\x15GetApplicationRequest\x12\n\n\x02id\x18\x01 \x01(\t\"Y\n\x17ListApplicationsRequest\x12+\n\x08statuses\x18\x01 \x03(\x0e\x32\x19.resume.ApplicationStatus\x12\x11\n\tresume_id\x18\x02 \x01(\t\"E\n\x18ListApplicationsResponse\x12)\n\x0c\x61pplications\x18\x01 \x03(\x0b\x32\x13.resume.Application\"Y\n\x18UpdateApplicationRequest\x12(\n\x0b\x61pplication\x18\x01 \x01(\x0b\x32\x13.resume.Application\x12\x13\n\x0bstatus_note\x18\x02 \x01(\t\"&\n\x18\x44\x65leteApplicationRequest\x12\n\n\x02id\x18\x01 \x01(\t\",\n\x19\x44\x65leteApplicationResponse\x12\x0f\n\x07success\x18\x01 \x01(\x08\"\x84\x01\n\x0eJobDescription\x12\n\n\x02id\x18\x01 \x01(\t\x12\r\n\x05title\x18\x02 \x01(\t\x12\x0f\n\x07\x63ompany\x18\x03 \x01(\t\x12\x12\n\nsource_url\x18\x04 \x01(\t\x12\x0c\n\x04text\x18\x05 \x01(\t\x12\x10\n\x08keywords\x18\x06 \x03(\t\x12\x12\n\ncreated_at\x18\x07 \x01(\t\"L\n\x19SaveJobDescriptionRequest\x12/\n\x0fjob_description\x18\x01 \x01(\x0b\x32\x16.resume.JobDescription\"&\n\x18GetJobDescriptionRequest\x12\n\n\x02id\x18\x01 \x01(\t\"-\n\x1aListJobDescriptionsRequest\x12\x0f\n\x07\x63ompany\x18\x01 \x01(\t\"O\n\x1bListJobDescriptionsResponse\x12\x30\n\x10job_descriptions\x18\x01 \x03(\x0b\x32\x16.resume.JobDescription\"\xe8\x01\n\x08\x41nalysis\x12\n\n\x02id\x18\x01 \x01(\t\x12\x11\n\tresume_id\x18\x02 \x01(\t\x12\x17\n\x0fresume_revision\x18\x03 \x01(\x05\x12\x1a\n\x12job_description_id\x18\x04 \x01(\t\x12\r\n\x05score\x18\x05 \x01(\x05\x12\x10\n\x08\x66\x65\x65\x64\x62\x61\x63k\x18\x06 \x03(\t\x12\x18\n\x10missing_keywords\x18\x07 \x03(\t\x12\x11\n\treasoning\x18\x08 \x01(\t\x12&\n\x06source\x18\t \x01(\x0e\x32\x16.resume.AnalysisSource\x12\x12\n\ncreated_at\x18\n \x01(\t\"9\n\x13SaveAnalysisRequest\x12\"\n\x08\x61nalysis\x18\x01 \x01(\x0b\x32\x10.resume.Analysis\"D\n\x13ListAnalysesRequest\x12\x11\n\tresume_id\x18\x01 \x01(\t\x12\x1a\n\x12job_description_id\x18\x02 \x01(\t\":\n\x14ListAnalysesResponse\x12\"\n\x08\x61nalyses\x18\x01 \x03(\x0b\x32\x10.resume.Analysis\"V\n\rDocumentLinks\x12\x16\n\x0e\x61pplication_id\x18\x01 \x01(\t\x12\x11\n\tresume_id\x18\x02 \x01(\t\x12\x1a\n\x12job_description_id\x18\x03 \x01(\t\"x\n\x0b\x43overLetter\x12\n\n\x02id\x18\x01 \x01(\t\x12$\n\x05links\x18\x02 \x01(\x0b\x32\x15.resume.DocumentLinks\x12\x0f\n\x07\x63ontent\x18\x03 \x01(\t\x12\x12\n\ncreated_at\x18\x04 \x01(\t\x12\x12\n\nupdated_at\x18\x05 \x01(\t\"C\n\x16SaveCoverLetterRequest\x12)\n\x0c\x63over_letter\x18\x01 \x01(\x0b\x32\x13.resume.CoverLetter\"7\n\x18UpdateCoverLetterRequest\x12\n\n\x02id\x18\x01 \x01(\t\x12\x0f\n\x07\x63ontent\x18\x02 \x01(\t\"?\n\x17ListCoverLettersRequest\x12$\n\x05links\x18\x01 \x01(\x0b\x32\x15.resume.DocumentLinks\"F\n\x18ListCoverLettersResponse\x12*\n\rcover_letters\x18\x01 \x03(\x0b\x32\x13.resume.CoverLetter\"&\n\x18\x44\x65leteCoverLetterRequest\x12\n\n\x02id\x18\x01 \x01(\t\",\n\x19\x44\x65leteCoverLetterResponse\x12\x0f\n\x07success\x18\x01 \x01(\x08\"|\n\x10PracticeQuestion\x12\n\n\x02id\x18\x01 \x01(\t\x12\x10\n\x08question\x18\x02 \x01(\t\x12\x0c\n\x04type\x18\x03 \x01(\t\x12\x14\n\x0c\x61nswer_guide\x18\x04 \x01(\t\x12\x11\n\tpracticed\x18\x05 \x01(\x08\x12\x13\n\x0buser_answer\x18\x06 \x01(\t\"\x94\x01\n\x0bQuestionSet\x12\n\n\x02id\x18\x01 \x01(\t\x12$\n\x05links\x18\x02 \x01(\x0b\x32\x15.resume.DocumentLinks\x12+\n\tquestions\x18\x03 \x03(\x0b\x32\x18.resume.PracticeQuestion\x12\x12\n\ncreated_at\x18\x04 \x01(\t\x12\x12\n\nupdated_at\x18\x05 \x01(\t\"C\n\x16SaveQuestionSetRequest\x12)\n\x0cquestion_set\x18\x01 \x01(\x0b\x32\x13.resume.QuestionSet\"?\n\x17ListQuestionSetsRequest\x12$\n\x05links\x18\x01 \x01(\x0b\x32\x15.resume.DocumentLinks\"F\n\x18ListQuestionSetsResponse\x12*\n\rquestion_sets\x18\x01 \x03(\x0b\x32\x13.resume.QuestionSet\"{\n\x1dUpdatePracticeQuestionRequest\x12\n\n\x02id\x18\x01 \x01(\t\x12\x10\n\x08question\x18\x02 \x01(\t\x12\x14\n\x0c\x61nswer_guide\x18\x03 \x01(\t\x12\x11\n\tpracticed\x18\x04 \x01(\x08\x12\x13\n\x0buser_answer\x18\x05 \x01(\t\"&\n\x18\x44\x65leteQuestionSetRequest\x12\n\n\x02id\x18\x01 \x01(\t\",\n\x19\x44\x65leteQuestionSetResponse\x12\x0f\n\x07success\x18\x01 \x01(\x08*\x97\x01\n\x0fResumeSortField\x12!\n\x1dRESUME_SORT_FIELD_UNSPECIFIED\x10\x00\x12 \n\x1cRESUME_SORT_FIELD_CREATED_AT\x10\x01\x12 \n\x1cRESUME_SORT_FIELD_UPDATED_AT\x10\x02\x12\x1d\n\x19RESUME_SORT_FIELD_VERSION\x10\x03*s\n\nChangeType\x12\x1b\n\x17\x43HANGE_TYPE_UNSPECIFIED\x10\x00\x12\x15\n\x11\x43HANGE_TYPE_ADDED\x10\x01\x12\x17\n\x13\x43HANGE_TYPE_REMOVED\x10\x02\x12\x18\n\x14\x43HANGE_TYPE_MODIFIED\x10\x03*t\n\nTextEditOp\x12\x1c\n\x18TEXT_EDIT_OP_UNSPECIFIED\x10\x00\x12\x16\n\x12TEXT_EDIT_OP_EQUAL\x10\x01\x12\x17\n\x13TEXT_EDIT_OP_INSERT\x10\x02\x12\x17\n\x13TEXT_EDIT_OP_DELETE\x10\x03*\xbc\x01\n\x0cResumeFormat\x12\x1d\n\x19RESUME_FORMAT_UNSPECIFIED\x10\x00\x12\x1d\n\x19RESUME_FORMAT_JSON_RESUME\x10\x01\x12\x1a\n\x16RESUME_FORMAT_MARKDOWN\x10\x02\x12\x16\n\x12RESUME_FORMAT_HTML\x10\x03\x12\x17\n\x13RESUME_FORMAT_LATEX\x10\x04\x12!\n\x1dRESUME_FORMAT_LINKEDIN_EXPORT\x10\x05*\x9d\x02\n\x11\x41pplicationStatus\x12\"\n\x1e\x41PPLICATION_STATUS_UNSPECIFIED\x10\x00\x12\x1e\n\x1a\x41PPLICATION_STATUS_APPLIED\x10\x01\x12 \n\x1c\x41PPLICATION_STATUS_SCREENING\x10\x02\x12 \n\x1c\x41PPLICATION_STATUS_INTERVIEW\x10\x03\x12\x1c\n\x18\x41PPLICATION_STATUS_OFFER\x10\x04\x12\x1f\n\x1b\x41PPLICATION_STATUS_ACCEPTED\x10\x05\x12\x1f\n\x1b\x41PPLICATION_STATUS_REJECTED\x10\x06\x12 \n\x1c\x41PPLICATION_STATUS_WITHDRAWN\x10\x07*i\n\x0e\x41nalysisSource\x12\x1f\n\x1b\x41NALYSIS_SOURCE_UNSPECIFIED\x10\x00\x12\x17\n\x13\x41NALYSIS_SOURCE_LLM\x10\x01\x12\x1d\n\x19\x41NALYSIS_SOURCE_HEURISTIC\x10\x02\x32\xb2\x02\n\tAIService\x12=\n\x0cTailorResume\x12\x15.resume.TailorRequest\x1a\x16.resume.TailorResponse\x12L\n\rAnalyzeResume\x12\x1c.resume.AnalyzeResumeRequest\x1a\x1d.resume.AnalyzeResumeResponse\x12Y\n\x1aGenerateInterviewQuestions\x12\x1c.resume.InterviewPrepRequest\x1a\x1d.resume.InterviewPrepResponse\x12=\n\x0bParseResume\x12\x1a.resume.ParseResumeRequest\x1a\x12.resume.ResumeData2\xa9\x15\n\x18ResumePersistenceService\x12<\n\nSaveResume\x12\x19.resume.SaveResumeRequest\x1a\x13.resume.SavedResume\x12:\n\tGetResume\x12\x18.resume.GetResumeRequest\x1a\x13.resume.SavedResume\x12@\n\x0cUpdateResume\x12\x1b.resume.UpdateResumeRequest\x1a\x13.resume.SavedResume\x12\x46\n\x0bListResumes\x12\x1a.resume.ListResumesRequest\x1a\x1b.resume.ListResumesResponse\x12I\n\x0c\x44\x65leteResume\x12\x1b.resume.DeleteResumeRequest\x1a\x1c.resume.DeleteResumeResponse\x12^\n\x13ListResumeRevisions\x12\".resume.ListResumeRevisionsRequest\x1a#.resume.ListResumeRevisionsResponse\x12M\n\x11GetResumeRevision\x12 .resume.GetResumeRevisionRequest\x1a\x16.resume.ResumeRevision\x12R\n\x15RestoreResumeRevision\x12$.resume.RestoreResumeRevisionRequest\x1a\x13.resume.SavedResume\x12=\n\x0b\x44iffResumes\x12\x1a.resume.DiffResumesRequest\x1a\x12.resume.ResumeDiff\x12T\n\x12ListDeletedResumes\x12!.resume.ListDeletedResumesRequest\x1a\x1b.resume.ListResumesResponse\x12\x42\n\rRestoreResume\x12\x1c.resume.RestoreResumeRequest\x1a\x13.resume.SavedResume\x12\x46\n\x0bPurgeResume\x12\x1a.resume.PurgeResumeRequest\x1a\x1b.resume.PurgeResumeResponse\x12=\n\x0eGetCurrentUser\x12\x1d.resume.GetCurrentUserRequest\x1a\x0c.resume.User\x12I\n\x0c\x45xportResume\x12\x1b.resume.ExportResumeRequest\x1a\x1c.resume.ExportResumeResponse\x12?\n\x0cImportResume\x12\x1b.resume.ImportResumeRequest\x1a\x12.resume.ResumeData\x12I\n\x0cRenderResume\x12\x1b.resume.RenderResumeRequest\x1a\x1c.resume.RenderResumeResponse\x12R\n\x0fParseResumeFile\x12\x1e.resume.ParseResumeFileRequest\x1a\x1f.resume.ParseResumeFileResponse\x12J\n\x11\x43reateApplication\x12 .resume.CreateApplicationRequest\x1a\x13.resume.Application\x12\x44\n\x0eGetApplication\x12\x1d.resume.GetApplicationRequest\x1a\x13.resume.Application\x12U\n\x10ListApplications\x12\x1f.resume.ListApplicationsRequest\x1a .resume.ListApplicationsResponse\x12J\n\x11UpdateApplication\x12 .resume.UpdateApplicationRequest\x1a\x13.resume.Application\x12X\n\x11\x44\x65leteApplication\x12 .resume.DeleteApplicationRequest\x1a!.resume.DeleteApplicationResponse\x12O\n\x12SaveJobDescription\x12!.resume.SaveJobDescriptionRequest\x1a\x16.resume.JobDescription\x12M\n\x11GetJobDescription\x12 .resume.GetJobDescriptionRequest\x1a\x16.resume.JobDescription\x12^\n\x13ListJobDescriptions\x12\".resume.ListJobDescriptionsRequest\x1a#.resume.ListJobDescriptionsResponse\x12=\n\x0cSaveAnalysis\x12\x1b.resume.SaveAnalysisRequest\x1a\x10.resume.Analysis\x12I\n\x0cListAnalyses\x12\x1b.resume.ListAnalysesRequest\x1a\x1c.resume.ListAnalysesResponse\x12\x46\n\x0fSaveCoverLetter\x12\x1e.resume.SaveCoverLetterRequest\x1a\x13.resume.CoverLetter\x12J\n\x11UpdateCoverLetter\x12 .resume.UpdateCoverLetterRequest\x1a\x13.resume.CoverLetter\x12U\n\x10ListCoverLetters\x12\x1f.resume.ListCoverLettersRequest\x1a .resume.ListCoverLettersResponse\x12X\n\x11\x44\x65leteCoverLetter\x12 .resume.DeleteCoverLetterRequest\x1a!.resume.DeleteCoverLetterResponse\x12\x46\n\x0fSaveQuestionSet\x12\x1e.resume.SaveQuestionSetRequest\x1a\x13.resume.QuestionSet\x12U\n\x10ListQuestionSets\x12\x1f.resume.ListQuestionSetsRequest\x1a .resume.ListQuestionSetsResponse\x12Y\n\x16UpdatePracticeQuestion\x12%.resume.UpdatePracticeQuestionRequest\x1a\x18.resume.PracticeQuestion\x12X\n\x11\x44\x65leteQuestionSet\x12 .resume.DeleteQuestionSetRequest\x1a!.resume.DeleteQuestionSetResponseB&Z$github.com/iprotoresume/shared/protob\x06proto3')
 
 _globals = globals()
 _builder.BuildMessageAndEnumDescriptors(DESCRIPTOR, _globals)
@@ -32,6 +32,18 @@ _builder.BuildTopDescriptorsAndMessages(DESCRIPTOR, 'shared.proto.resume_pb2', _
 if not _descriptor._USE_C_DESCRIPTORS:
   _globals['DESCRIPTOR']._loaded_options = None
   _globals['DESCRIPTOR']._serialized_options = b'Z$github.com/iprotoresume/shared/proto'
+  _globals['_RESUMESORTFIELD']._serialized_start=7252
+  _globals['_RESUMESORTFIELD']._serialized_end=7403
+  _globals['_CHANGETYPE']._serialized_start=7405
+  _globals['_CHANGETYPE']._serialized_end=7520
+  _globals['_TEXTEDITOP']._serialized_start=7522
+  _globals['_TEXTEDITOP']._serialized_end=7638
+  _globals['_RESUMEFORMAT']._serialized_start=7641
+  _globals['_RESUMEFORMAT']._serialized_end=7829
+  _globals['_APPLICATIONSTATUS']._serialized_start=7832
+  _globals['_APPLICATIONSTATUS']._serialized_end=8117
+  _globals['_ANALYSISSOURCE']._serialized_start=8119
+  _globals['_ANALYSISSOURCE']._serialized_end=8224
   _globals['_RESUMEDATA']._serialized_start=38
   _globals['_RESUMEDATA']._serialized_end=521
   _globals['_EXPERIENCE']._serialized_start=523
@@ -58,24 +70,146 @@ if not _descriptor._USE_C_DESCRIPTORS:
   _globals['_INTERVIEWQUESTION']._serialized_end=1354
   _globals['_INTERVIEWPREPRESPONSE']._serialized_start=1356
   _globals['_INTERVIEWPREPRESPONSE']._serialized_end=1425
-  _globals['_ANALYZERESUMEREQUEST']._serialized_start=1427
-  _globals['_ANALYZERESUMEREQUEST']._serialized_end=1510
-  _globals['_ANALYZERESUMERESPONSE']._serialized_start=1512
-  _globals['_ANALYZERESUMERESPONSE']._serialized_end=1613
-  _globals['_SAVEDRESUME']._serialized_start=1615
-  _globals['_SAVEDRESUME']._serialized_end=1732
-  _globals['_SAVERESUMEREQUEST']._serialized_start=1734
-  _globals['_SAVERESUMEREQUEST']._serialized_end=1820
-  _globals['_LISTRESUMESREQUEST']._serialized_start=1822
-  _globals['_LISTRESUMESREQUEST']._serialized_end=1856
-  _globals['_LISTRESUMESRESPONSE']._serialized_start=1858
-  _globals['_LISTRESUMESRESPONSE']._serialized_end=1917
-  _globals['_DELETERESUMEREQUEST']._serialized_start=1919
-  _globals['_DELETERESUMEREQUEST']._serialized_end=1952
-  _globals['_DELETERESUMERESPONSE']._serialized_start=1954
-  _globals['_DELETERESUMERESPONSE']._serialized_end=1993
-  _globals['_AISERVICE']._serialized_start=1996
-  _globals['_AISERVICE']._serialized_end=2239
-  _globals['_RESUMEPERSISTENCESERVICE']._serialized_start=2242
-  _globals['_RESUMEPERSISTENCESERVICE']._serialized_end=2477
+  _globals['_PARSERESUMEREQUEST']._serialized_start=1427
+  _globals['_PARSERESUMEREQUEST']._serialized_end=1496
+  _globals['_ANALYZERESUMEREQUEST']._serialized_start=1498
+  _globals['_ANALYZERESUMEREQUEST']._serialized_end=1581
+  _globals['_ANALYZERESUMERESPONSE']._serialized_start=1583
+  _globals['_ANALYZERESUMERESPONSE']._serialized_end=1684
+  _globals['_USER']._serialized_start=1686
+  _globals['_USER']._serialized_end=1770
+  _globals['_GETCURRENTUSERREQUEST']._serialized_start=1772
+  _globals['_GETCURRENTUSERREQUEST']._serialized_end=1795
+  _globals['_SAVEDRESUME']._serialized_start=1798
+  _globals['_SAVEDRESUME']._serialized_end=1991
+  _globals['_SAVERESUMEREQUEST']._serialized_start=1993
+  _globals['_SAVERESUMEREQUEST']._serialized_end=2091
+  _globals['_GETRESUMEREQUEST']._serialized_start=2093
+  _globals['_GETRESUMEREQUEST']._serialized_end=2123
+  _globals['_UPDATERESUMEREQUEST']._serialized_start=2125
+  _globals['_UPDATERESUMEREQUEST']._serialized_end=2221
+  _globals['_LISTRESUMESREQUEST']._serialized_start=2224
+  _globals['_LISTRESUMESREQUEST']._serialized_end=2373
+  _globals['_LISTRESUMESRESPONSE']._serialized_start=2375
+  _globals['_LISTRESUMESRESPONSE']._serialized_end=2497
+  _globals['_DELETERESUMEREQUEST']._serialized_start=2499
+  _globals['_DELETERESUMEREQUEST']._serialized_end=2532
+  _globals['_DELETERESUMERESPONSE']._serialized_start=2534
+  _globals['_DELETERESUMERESPONSE']._serialized_end=2573
+  _globals['_LISTDELETEDRESUMESREQUEST']._serialized_start=2575
+  _globals['_LISTDELETEDRESUMESREQUEST']._serialized_end=2602
+  _globals['_RESTORERESUMEREQUEST']._serialized_start=2604
+  _globals['_RESTORERESUMEREQUEST']._serialized_end=2638
+  _globals['_PURGERESUMEREQUEST']._serialized_start=2640
+  _globals['_PURGERESUMEREQUEST']._serialized_end=2672
+  _globals['_PURGERESUMERESPONSE']._serialized_start=2674
+  _globals['_PURGERESUMERESPONSE']._serialized_end=2712
+  _globals['_RESUMEREVISION']._serialized_start=2714
+  _globals['_RESUMEREVISION']._serialized_end=2840
+  _globals['_LISTRESUMEREVISIONSREQUEST']._serialized_start=2842
+  _globals['_LISTRESUMEREVISIONSREQUEST']._serialized_end=2889
+  _globals['_LISTRESUMEREVISIONSRESPONSE']._serialized_start=2891
+  _globals['_LISTRESUMEREVISIONSRESPONSE']._serialized_end=2963
+  _globals['_GETRESUMEREVISIONREQUEST']._serialized_start=2965
+  _globals['_GETRESUMEREVISIONREQUEST']._serialized_end=3028
+  _globals['_RESTORERESUMEREVISIONREQUEST']._serialized_start=3030
+  _globals['_RESTORERESUMEREVISIONREQUEST']._serialized_end=3097
+  _globals['_RESUMEREF']._serialized_start=3099
+  _globals['_RESUMEREF']._serialized_end=3183
+  _globals['_DIFFRESUMESREQUEST']._serialized_start=3185
+  _globals['_DIFFRESUMESREQUEST']._serialized_end=3273
+  _globals['_TEXTEDIT']._serialized_start=3275
+  _globals['_TEXTEDIT']._serialized_end=3331
+  _globals['_FIELDCHANGE']._serialized_start=3334
+  _globals['_FIELDCHANGE']._serialized_end=3479
+  _globals['_ITEMCHANGE']._serialized_start=3481
+  _globals['_ITEMCHANGE']._serialized_end=3584
+  _globals['_RESUMEDIFF']._serialized_start=3587
+  _globals['_RESUMEDIFF']._serialized_end=3792
+  _globals['_EXPORTRESUMEREQUEST']._serialized_start=3794
+  _globals['_EXPORTRESUMEREQUEST']._serialized_end=3865
+  _globals['_EXPORTRESUMERESPONSE']._serialized_start=3867
+  _globals['_EXPORTRESUMERESPONSE']._serialized_end=3946
+  _globals['_IMPORTRESUMEREQUEST']._serialized_start=3948
+  _globals['_IMPORTRESUMEREQUEST']._serialized_end=4038
+  _globals['_RENDERRESUMEREQUEST']._serialized_start=4040
+  _globals['_RENDERRESUMEREQUEST']._serialized_end=4114
+  _globals['_RENDERRESUMERESPONSE']._serialized_start=4116
+  _globals['_RENDERRESUMERESPONSE']._serialized_end=4195
+  _globals['_PARSERESUMEFILEREQUEST']._serialized_start=4197
+  _globals['_PARSERESUMEFILEREQUEST']._serialized_end=4256
+  _globals['_PARSERESUMEFILERESPONSE']._serialized_start=4258
+  _globals['_PARSERESUMEFILERESPONSE']._serialized_end=4332
+  _globals['_APPLICATIONSTATUSCHANGE']._serialized_start=4334
+  _globals['_APPLICATIONSTATUSCHANGE']._serialized_end=4436
+  _globals['_APPLICATION']._serialized_start=4439
+  _globals['_APPLICATION']._serialized_end=4745
+  _globals['_CREATEAPPLICATIONREQUEST']._serialized_start=4747
+  _globals['_CREATEAPPLICATIONREQUEST']._serialized_end=4815
+  _globals['_GETAPPLICATIONREQUEST']._serialized_start=4817
+  _globals['_GETAPPLICATIONREQUEST']._serialized_end=4852
+  _globals['_LISTAPPLICATIONSREQUEST']._serialized_start=4854
+  _globals['_LISTAPPLICATIONSREQUEST']._serialized_end=4943
+  _globals['_LISTAPPLICATIONSRESPONSE']._serialized_start=4945
+  _globals['_LISTAPPLICATIONSRESPONSE']._serialized_end=5014
+  _globals['_UPDATEAPPLICATIONREQUEST']._serialized_start=5016
+  _globals['_UPDATEAPPLICATIONREQUEST']._serialized_end=5105
+  _globals['_DELETEAPPLICATIONREQUEST']._serialized_start=5107
+  _globals['_DELETEAPPLICATIONREQUEST']._serialized_end=5145
+  _globals['_DELETEAPPLICATIONRESPONSE']._serialized_start=5147
+  _globals['_DELETEAPPLICATIONRESPONSE']._serialized_end=5191
+  _globals['_JOBDESCRIPTION']._serialized_start=5194
+  _globals['_JOBDESCRIPTION']._serialized_end=5326
+  _globals['_SAVEJOBDESCRIPTIONREQUEST']._serialized_start=5328
+  _globals['_SAVEJOBDESCRIPTIONREQUEST']._serialized_end=5404
+  _globals['_GETJOBDESCRIPTIONREQUEST']._serialized_start=5406
+  _globals['_GETJOBDESCRIPTIONREQUEST']._serialized_end=5444
+  _globals['_LISTJOBDESCRIPTIONSREQUEST']._serialized_start=5446
+  _globals['_LISTJOBDESCRIPTIONSREQUEST']._serialized_end=5491
+  _globals['_LISTJOBDESCRIPTIONSRESPONSE']._serialized_start=5493
+  _globals['_LISTJOBDESCRIPTIONSRESPONSE']._serialized_end=5572
+  _globals['_ANALYSIS']._serialized_start=5575
+  _globals['_ANALYSIS']._serialized_end=5807
+  _globals['_SAVEANALYSISREQUEST']._serialized_start=5809
+  _globals['_SAVEANALYSISREQUEST']._serialized_end=5866
+  _globals['_LISTANALYSESREQUEST']._serialized_start=5868
+  _globals['_LISTANALYSESREQUEST']._serialized_end=5936
+  _globals['_LISTANALYSESRESPONSE']._serialized_start=5938
+  _globals['_LISTANALYSESRESPONSE']._serialized_end=5996
+  _globals['_DOCUMENTLINKS']._serialized_start=5998
+  _globals['_DOCUMENTLINKS']._serialized_end=6084
+  _globals['_COVERLETTER']._serialized_start=6086
+  _globals['_COVERLETTER']._serialized_end=6206
+  _globals['_SAVECOVERLETTERREQUEST']._serialized_start=6208
+  _globals['_SAVECOVERLETTERREQUEST']._serialized_end=6275
+  _globals['_UPDATECOVERLETTERREQUEST']._serialized_start=6277
+  _globals['_UPDATECOVERLETTERREQUEST']._serialized_end=6332
+  _globals['_LISTCOVERLETTERSREQUEST']._serialized_start=6334
+  _globals['_LISTCOVERLETTERSREQUEST']._serialized_end=6397
+  _globals['_LISTCOVERLETTERSRESPONSE']._serialized_start=6399
+  _globals['_LISTCOVERLETTERSRESPONSE']._serialized_end=6469
+  _globals['_DELETECOVERLETTERREQUEST']._serialized_start=6471
+  _globals['_DELETECOVERLETTERREQUEST']._serialized_end=6509
+  _globals['_DELETECOVERLETTERRESPONSE']._serialized_start=6511
+  _globals['_DELETECOVERLETTERRESPONSE']._serialized_end=6555
+  _globals['_PRACTICEQUESTION']._serialized_start=6557
+  _globals['_PRACTICEQUESTION']._serialized_end=6681
+  _globals['_QUESTIONSET']._serialized_start=6684
+  _globals['_QUESTIONSET']._serialized_end=6832
+  _globals['_SAVEQUESTIONSETREQUEST']._serialized_start=6834
+  _globals['_SAVEQUESTIONSETREQUEST']._serialized_end=6901
+  _globals['_LISTQUESTIONSETSREQUEST']._serialized_start=6903
+  _globals['_LISTQUESTIONSETSREQUEST']._serialized_end=6966
+  _globals['_LISTQUESTIONSETSRESPONSE']._serialized_start=6968
+  _globals['_LISTQUESTIONSETSRESPONSE']._serialized_end=7038
+  _globals['_UPDATEPRACTICEQUESTIONREQUEST']._serialized_start=7040
+  _globals['_UPDATEPRACTICEQUESTIONREQUEST']._serialized_end=7163
+  _globals['_DELETEQUESTIONSETREQUEST']._serialized_start=7165
+  _globals['_DELETEQUESTIONSETREQUEST']._serialized_end=7203
+  _globals['_DELETEQUESTIONSETRESPONSE']._serialized_start=7205
+  _globals['_DELETEQUESTIONSETRESPONSE']._serialized_end=7249
+  _globals['_AISERVICE']._serialized_start=8227
+  _globals['_AISERVICE']._serialized_end=8533
+  _globals['_RESUMEPERSISTENCESERVICE']._serialized_start=8536
+  _globals['_RESUMEPERSISTENCESERVICE']._serialized_end=11265
 # @@protoc_insertion_point(module_scope)
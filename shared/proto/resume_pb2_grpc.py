@@ -49,6 +49,11 @@ class AIServiceStub(object):
                 request_serializer=shared_dot_proto_dot_resume__pb2.InterviewPrepRequest.SerializeToString,
                 response_deserializer=shared_dot_proto_dot_resume__pb2.InterviewPrepResponse.FromString,
                 _registered_method=True)
+        self.ParseResume = channel.unary_unary(
+                '/resume.AIService/ParseResume',
+                request_serializer=shared_dot_proto_dot_resume__pb2.ParseResumeRequest.SerializeToString,
+                response_deserializer=shared_dot_proto_dot_resume__pb2.ResumeData.FromString,
+                _registered_method=True)
 
 
 class AIServiceServicer(object):
@@ -72,6 +77,12 @@ class AIServiceServicer(object):
         context.set_details('Method not implemented!')
         raise NotImplementedError('Method not implemented!')
 
+    def ParseResume(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
 
 def add_AIServiceServicer_to_server(servicer, server):
     rpc_method_handlers = {
@@ -90,6 +101,11 @@ def add_AIServiceServicer_to_server(servicer, server):
                     request_deserializer=shared_dot_proto_dot_resume__pb2.InterviewPrepRequest.FromString,
                     response_serializer=shared_dot_proto_dot_resume__pb2.InterviewPrepResponse.SerializeToString,
             ),
+            'ParseResume': grpc.unary_unary_rpc_method_handler(
+                    servicer.ParseResume,
+                    request_deserializer=shared_dot_proto_dot_resume__pb2.ParseResumeRequest.FromString,
+                    response_serializer=shared_dot_proto_dot_resume__pb2.ResumeData.SerializeToString,
+            ),
     }
     generic_handler = grpc.method_handlers_generic_handler(
             'resume.AIService', rpc_method_handlers)
@@ -182,6 +198,33 @@ class AIService(object):
             metadata,
             _registered_method=True)
 
+    @staticmethod
+    def ParseResume(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(
+            request,
+            target,
+            '/resume.AIService/ParseResume',
+            shared_dot_proto_dot_resume__pb2.ParseResumeRequest.SerializeToString,
+            shared_dot_proto_dot_resume__pb2.ResumeData.FromString,
+            options,
+            channel_credentials,
+            insecure,
+            call_credentials,
+            compression,
+            wait_for_ready,
+            timeout,
+            metadata,
+            _registered_method=True)
+
 
 class ResumePersistenceServiceStub(object):
     """Missing associated documentation comment in .proto file."""
@@ -197,6 +240,16 @@ class ResumePersistenceServiceStub(object):
                 request_serializer=shared_dot_proto_dot_resume__pb2.SaveResumeRequest.SerializeToString,
                 response_deserializer=shared_dot_proto_dot_resume__pb2.SavedResume.FromString,
                 _registered_method=True)
+        self.GetResume = channel.unary_unary(
+                '/resume.ResumePersistenceService/GetResume',
+                request_serializer=shared_dot_proto_dot_resume__pb2.GetResumeRequest.SerializeToString,
+                response_deserializer=shared_dot_proto_dot_resume__pb2.SavedResume.FromString,
+                _registered_method=True)
+        self.UpdateResume = channel.unary_unary(
+                '/resume.ResumePersistenceService/UpdateResume',
+                request_serializer=shared_dot_proto_dot_resume__pb2.UpdateResumeRequest.SerializeToString,
+                response_deserializer=shared_dot_proto_dot_resume__pb2.SavedResume.FromString,
+                _registered_method=True)
         self.ListResumes = channel.unary_unary(
                 '/resume.ResumePersistenceService/ListResumes',
                 request_serializer=shared_dot_proto_dot_resume__pb2.ListResumesRequest.SerializeToString,
@@ -207,6 +260,156 @@ class ResumePersistenceServiceStub(object):
                 request_serializer=shared_dot_proto_dot_resume__pb2.DeleteResumeRequest.SerializeToString,
                 response_deserializer=shared_dot_proto_dot_resume__pb2.DeleteResumeResponse.FromString,
                 _registered_method=True)
+        self.ListResumeRevisions = channel.unary_unary(
+                '/resume.ResumePersistenceService/ListResumeRevisions',
+                request_serializer=shared_dot_proto_dot_resume__pb2.ListResumeRevisionsRequest.SerializeToString,
+                response_deserializer=shared_dot_proto_dot_resume__pb2.ListResumeRevisionsResponse.FromString,
+                _registered_method=True)
+        self.GetResumeRevision = channel.unary_unary(
+                '/resume.ResumePersistenceService/GetResumeRevision',
+                request_serializer=shared_dot_proto_dot_resume__pb2.GetResumeRevisionRequest.SerializeToString,
+                response_deserializer=shared_dot_proto_dot_resume__pb2.ResumeRevision.FromString,
+                _registered_method=True)
+        self.RestoreResumeRevision = channel.unary_unary(
+                '/resume.ResumePersistenceService/RestoreResumeRevision',
+                request_serializer=shared_dot_proto_dot_resume__pb2.RestoreResumeRevisionRequest.SerializeToString,
+                response_deserializer=shared_dot_proto_dot_resume__pb2.SavedResume.FromString,
+                _registered_method=True)
+        self.DiffResumes = channel.unary_unary(
+                '/resume.ResumePersistenceService/DiffResumes',
+                request_serializer=shared_dot_proto_dot_resume__pb2.DiffResumesRequest.SerializeToString,
+                response_deserializer=shared_dot_proto_dot_resume__pb2.ResumeDiff.FromString,
+                _registered_method=True)
+        self.ListDeletedResumes = channel.unary_unary(
+                '/resume.ResumePersistenceService/ListDeletedResumes',
+                request_serializer=shared_dot_proto_dot_resume__pb2.ListDeletedResumesRequest.SerializeToString,
+                response_deserializer=shared_dot_proto_dot_resume__pb2.ListResumesResponse.FromString,
+                _registered_method=True)
+        self.RestoreResume = channel.unary_unary(
+                '/resume.ResumePersistenceService/RestoreResume',
+                request_serializer=shared_dot_proto_dot_resume__pb2.RestoreResumeRequest.SerializeToString,
+                response_deserializer=shared_dot_proto_dot_resume__pb2.SavedResume.FromString,
+                _registered_method=True)
+        self.PurgeResume = channel.unary_unary(
+                '/resume.ResumePersistenceService/PurgeResume',
+                request_serializer=shared_dot_proto_dot_resume__pb2.PurgeResumeRequest.SerializeToString,
+                response_deserializer=shared_dot_proto_dot_resume__pb2.PurgeResumeResponse.FromString,
+                _registered_method=True)
+        self.GetCurrentUser = channel.unary_unary(
+                '/resume.ResumePersistenceService/GetCurrentUser',
+                request_serializer=shared_dot_proto_dot_resume__pb2.GetCurrentUserRequest.SerializeToString,
+                response_deserializer=shared_dot_proto_dot_resume__pb2.User.FromString,
+                _registered_method=True)
+        self.ExportResume = channel.unary_unary(
+                '/resume.ResumePersistenceService/ExportResume',
+                request_serializer=shared_dot_proto_dot_resume__pb2.ExportResumeRequest.SerializeToString,
+                response_deserializer=shared_dot_proto_dot_resume__pb2.ExportResumeResponse.FromString,
+                _registered_method=True)
+        self.ImportResume = channel.unary_unary(
+                '/resume.ResumePersistenceService/ImportResume',
+                request_serializer=shared_dot_proto_dot_resume__pb2.ImportResumeRequest.SerializeToString,
+                response_deserializer=shared_dot_proto_dot_resume__pb2.ResumeData.FromString,
+                _registered_method=True)
+        self.RenderResume = channel.unary_unary(
+                '/resume.ResumePersistenceService/RenderResume',
+                request_serializer=shared_dot_proto_dot_resume__pb2.RenderResumeRequest.SerializeToString,
+                response_deserializer=shared_dot_proto_dot_resume__pb2.RenderResumeResponse.FromString,
+                _registered_method=True)
+        self.ParseResumeFile = channel.unary_unary(
+                '/resume.ResumePersistenceService/ParseResumeFile',
+                request_serializer=shared_dot_proto_dot_resume__pb2.ParseResumeFileRequest.SerializeToString,
+                response_deserializer=shared_dot_proto_dot_resume__pb2.ParseResumeFileResponse.FromString,
+                _registered_method=True)
+        self.CreateApplication = channel.unary_unary(
+                '/resume.ResumePersistenceService/CreateApplication',
+                request_serializer=shared_dot_proto_dot_resume__pb2.CreateApplicationRequest.SerializeToString,
+                response_deserializer=shared_dot_proto_dot_resume__pb2.Application.FromString,
+                _registered_method=True)
+        self.GetApplication = channel.unary_unary(
+                '/resume.ResumePersistenceService/GetApplication',
+                request_serializer=shared_dot_proto_dot_resume__pb2.GetApplicationRequest.SerializeToString,
+                response_deserializer=shared_dot_proto_dot_resume__pb2.Application.FromString,
+                _registered_method=True)
+        self.ListApplications = channel.unary_unary(
+                '/resume.ResumePersistenceService/ListApplications',
+                request_serializer=shared_dot_proto_dot_resume__pb2.ListApplicationsRequest.SerializeToString,
+                response_deserializer=shared_dot_proto_dot_resume__pb2.ListApplicationsResponse.FromString,
+                _registered_method=True)
+        self.UpdateApplication = channel.unary_unary(
+                '/resume.ResumePersistenceService/UpdateApplication',
+                request_serializer=shared_dot_proto_dot_resume__pb2.UpdateApplicationRequest.SerializeToString,
+                response_deserializer=shared_dot_proto_dot_resume__pb2.Application.FromString,
+                _registered_method=True)
+        self.DeleteApplication = channel.unary_unary(
+                '/resume.ResumePersistenceService/DeleteApplication',
+                request_serializer=shared_dot_proto_dot_resume__pb2.DeleteApplicationRequest.SerializeToString,
+                response_deserializer=shared_dot_proto_dot_resume__pb2.DeleteApplicationResponse.FromString,
+                _registered_method=True)
+        self.SaveJobDescription = channel.unary_unary(
+                '/resume.ResumePersistenceService/SaveJobDescription',
+                request_serializer=shared_dot_proto_dot_resume__pb2.SaveJobDescriptionRequest.SerializeToString,
+                response_deserializer=shared_dot_proto_dot_resume__pb2.JobDescription.FromString,
+                _registered_method=True)
+        self.GetJobDescription = channel.unary_unary(
+                '/resume.ResumePersistenceService/GetJobDescription',
+                request_serializer=shared_dot_proto_dot_resume__pb2.GetJobDescriptionRequest.SerializeToString,
+                response_deserializer=shared_dot_proto_dot_resume__pb2.JobDescription.FromString,
+                _registered_method=True)
+        self.ListJobDescriptions = channel.unary_unary(
+                '/resume.ResumePersistenceService/ListJobDescriptions',
+                request_serializer=shared_dot_proto_dot_resume__pb2.ListJobDescriptionsRequest.SerializeToString,
+                response_deserializer=shared_dot_proto_dot_resume__pb2.ListJobDescriptionsResponse.FromString,
+                _registered_method=True)
+        self.SaveAnalysis = channel.unary_unary(
+                '/resume.ResumePersistenceService/SaveAnalysis',
+                request_serializer=shared_dot_proto_dot_resume__pb2.SaveAnalysisRequest.SerializeToString,
+                response_deserializer=shared_dot_proto_dot_resume__pb2.Analysis.FromString,
+                _registered_method=True)
+        self.ListAnalyses = channel.unary_unary(
+                '/resume.ResumePersistenceService/ListAnalyses',
+                request_serializer=shared_dot_proto_dot_resume__pb2.ListAnalysesRequest.SerializeToString,
+                response_deserializer=shared_dot_proto_dot_resume__pb2.ListAnalysesResponse.FromString,
+                _registered_method=True)
+        self.SaveCoverLetter = channel.unary_unary(
+                '/resume.ResumePersistenceService/SaveCoverLetter',
+                request_serializer=shared_dot_proto_dot_resume__pb2.SaveCoverLetterRequest.SerializeToString,
+                response_deserializer=shared_dot_proto_dot_resume__pb2.CoverLetter.FromString,
+                _registered_method=True)
+        self.UpdateCoverLetter = channel.unary_unary(
+                '/resume.ResumePersistenceService/UpdateCoverLetter',
+                request_serializer=shared_dot_proto_dot_resume__pb2.UpdateCoverLetterRequest.SerializeToString,
+                response_deserializer=shared_dot_proto_dot_resume__pb2.CoverLetter.FromString,
+                _registered_method=True)
+        self.ListCoverLetters = channel.unary_unary(
+                '/resume.ResumePersistenceService/ListCoverLetters',
+                request_serializer=shared_dot_proto_dot_resume__pb2.ListCoverLettersRequest.SerializeToString,
+                response_deserializer=shared_dot_proto_dot_resume__pb2.ListCoverLettersResponse.FromString,
+                _registered_method=True)
+        self.DeleteCoverLetter = channel.unary_unary(
+                '/resume.ResumePersistenceService/DeleteCoverLetter',
+                request_serializer=shared_dot_proto_dot_resume__pb2.DeleteCoverLetterRequest.SerializeToString,
+                response_deserializer=shared_dot_proto_dot_resume__pb2.DeleteCoverLetterResponse.FromString,
+                _registered_method=True)
+        self.SaveQuestionSet = channel.unary_unary(
+                '/resume.ResumePersistenceService/SaveQuestionSet',
+                request_serializer=shared_dot_proto_dot_resume__pb2.SaveQuestionSetRequest.SerializeToString,
+                response_deserializer=shared_dot_proto_dot_resume__pb2.QuestionSet.FromString,
+                _registered_method=True)
+        self.ListQuestionSets = channel.unary_unary(
+                '/resume.ResumePersistenceService/ListQuestionSets',
+                request_serializer=shared_dot_proto_dot_resume__pb2.ListQuestionSetsRequest.SerializeToString,
+                response_deserializer=shared_dot_proto_dot_resume__pb2.ListQuestionSetsResponse.FromString,
+                _registered_method=True)
+        self.UpdatePracticeQuestion = channel.unary_unary(
+                '/resume.ResumePersistenceService/UpdatePracticeQuestion',
+                request_serializer=shared_dot_proto_dot_resume__pb2.UpdatePracticeQuestionRequest.SerializeToString,
+                response_deserializer=shared_dot_proto_dot_resume__pb2.PracticeQuestion.FromString,
+                _registered_method=True)
+        self.DeleteQuestionSet = channel.unary_unary(
+                '/resume.ResumePersistenceService/DeleteQuestionSet',
+                request_serializer=shared_dot_proto_dot_resume__pb2.DeleteQuestionSetRequest.SerializeToString,
+                response_deserializer=shared_dot_proto_dot_resume__pb2.DeleteQuestionSetResponse.FromString,
+                _registered_method=True)
 
 
 class ResumePersistenceServiceServicer(object):
@@ -218,6 +421,18 @@ class ResumePersistenceServiceServicer(object):
         context.set_details('Method not implemented!')
         raise NotImplementedError('Method not implemented!')
 
+    def GetResume(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def UpdateResume(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
     def ListResumes(self, request, context):
         """Missing associated documentation comment in .proto file."""
         context.set_code(grpc.StatusCode.UNIMPLEMENTED)
@@ -230,6 +445,186 @@ class ResumePersistenceServiceServicer(object):
         context.set_details('Method not implemented!')
         raise NotImplementedError('Method not implemented!')
 
+    def ListResumeRevisions(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def GetResumeRevision(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def RestoreResumeRevision(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def DiffResumes(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def ListDeletedResumes(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def RestoreResume(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def PurgeResume(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def GetCurrentUser(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def ExportResume(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def ImportResume(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def RenderResume(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def ParseResumeFile(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def CreateApplication(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def GetApplication(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def ListApplications(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def UpdateApplication(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def DeleteApplication(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def SaveJobDescription(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def GetJobDescription(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def ListJobDescriptions(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def SaveAnalysis(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def ListAnalyses(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def SaveCoverLetter(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def UpdateCoverLetter(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def ListCoverLetters(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def DeleteCoverLetter(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def SaveQuestionSet(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def ListQuestionSets(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def UpdatePracticeQuestion(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def DeleteQuestionSet(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
 
 def add_ResumePersistenceServiceServicer_to_server(servicer, server):
     rpc_method_handlers = {
@@ -238,6 +633,16 @@ def add_ResumePersistenceServiceServicer_to_server(servicer, server):
                     request_deserializer=shared_dot_proto_dot_resume__pb2.SaveResumeRequest.FromString,
                     response_serializer=shared_dot_proto_dot_resume__pb2.SavedResume.SerializeToString,
             ),
+            'GetResume': grpc.unary_unary_rpc_method_handler(
+                    servicer.GetResume,
+                    request_deserializer=shared_dot_proto_dot_resume__pb2.GetResumeRequest.FromString,
+                    response_serializer=shared_dot_proto_dot_resume__pb2.SavedResume.SerializeToString,
+            ),
+            'UpdateResume': grpc.unary_unary_rpc_method_handler(
+                    servicer.UpdateResume,
+                    request_deserializer=shared_dot_proto_dot_resume__pb2.UpdateResumeRequest.FromString,
+                    response_serializer=shared_dot_proto_dot_resume__pb2.SavedResume.SerializeToString,
+            ),
             'ListResumes': grpc.unary_unary_rpc_method_handler(
                     servicer.ListResumes,
                     request_deserializer=shared_dot_proto_dot_resume__pb2.ListResumesRequest.FromString,
@@ -248,31 +653,181 @@ def add_ResumePersistenceServiceServicer_to_server(servicer, server):
                     request_deserializer=shared_dot_proto_dot_resume__pb2.DeleteResumeRequest.FromString,
                     response_serializer=shared_dot_proto_dot_resume__pb2.DeleteResumeResponse.SerializeToString,
             ),
-    }
-    generic_handler = grpc.method_handlers_generic_handler(
-            'resume.ResumePersistenceService', rpc_method_handlers)
-    server.add_generic_rpc_handlers((generic_handler,))
-    server.add_registered_method_handlers('resume.ResumePersistenceService', rpc_method_handlers)
-
-
- # This class is part of an EXPERIMENTAL API.
-class ResumePersistenceService(object):
-    """Missing associated documentation comment in .proto file."""
-
-    @staticmethod
-    def SaveResume(request,
-            target,
-            options=(),
-            channel_credentials=None,
-            call_credentials=None,
-            insecure=False,
-            compression=None,
-            wait_for_ready=None,
-            timeout=None,
-            metadata=None):
-        return grpc.experimental.unary_unary(
-            request,
-            target,
+            'ListResumeRevisions': grpc.unary_unary_rpc_method_handler(
+                    servicer.ListResumeRevisions,
+                    request_deserializer=shared_dot_proto_dot_resume__pb2.ListResumeRevisionsRequest.FromString,
+                    response_serializer=shared_dot_proto_dot_resume__pb2.ListResumeRevisionsResponse.SerializeToString,
+            ),
+            'GetResumeRevision': grpc.unary_unary_rpc_method_handler(
+                    servicer.GetResumeRevision,
+                    request_deserializer=shared_dot_proto_dot_resume__pb2.GetResumeRevisionRequest.FromString,
+                    response_serializer=shared_dot_proto_dot_resume__pb2.ResumeRevision.SerializeToString,
+            ),
+            'RestoreResumeRevision': grpc.unary_unary_rpc_method_handler(
+                    servicer.RestoreResumeRevision,
+                    request_deserializer=shared_dot_proto_dot_resume__pb2.RestoreResumeRevisionRequest.FromString,
+                    response_serializer=shared_dot_proto_dot_resume__pb2.SavedResume.SerializeToString,
+            ),
+            'DiffResumes': grpc.unary_unary_rpc_method_handler(
+                    servicer.DiffResumes,
+                    request_deserializer=shared_dot_proto_dot_resume__pb2.DiffResumesRequest.FromString,
+                    response_serializer=shared_dot_proto_dot_resume__pb2.ResumeDiff.SerializeToString,
+            ),
+            'ListDeletedResumes': grpc.unary_unary_rpc_method_handler(
+                    servicer.ListDeletedResumes,
+                    request_deserializer=shared_dot_proto_dot_resume__pb2.ListDeletedResumesRequest.FromString,
+                    response_serializer=shared_dot_proto_dot_resume__pb2.ListResumesResponse.SerializeToString,
+            ),
+            'RestoreResume': grpc.unary_unary_rpc_method_handler(
+                    servicer.RestoreResume,
+                    request_deserializer=shared_dot_proto_dot_resume__pb2.RestoreResumeRequest.FromString,
+                    response_serializer=shared_dot_proto_dot_resume__pb2.SavedResume.SerializeToString,
+            ),
+            'PurgeResume': grpc.unary_unary_rpc_method_handler(
+                    servicer.PurgeResume,
+                    request_deserializer=shared_dot_proto_dot_resume__pb2.PurgeResumeRequest.FromString,
+                    response_serializer=shared_dot_proto_dot_resume__pb2.PurgeResumeResponse.SerializeToString,
+            ),
+            'GetCurrentUser': grpc.unary_unary_rpc_method_handler(
+                    servicer.GetCurrentUser,
+                    request_deserializer=shared_dot_proto_dot_resume__pb2.GetCurrentUserRequest.FromString,
+                    response_serializer=shared_dot_proto_dot_resume__pb2.User.SerializeToString,
+            ),
+            'ExportResume': grpc.unary_unary_rpc_method_handler(
+                    servicer.ExportResume,
+                    request_deserializer=shared_dot_proto_dot_resume__pb2.ExportResumeRequest.FromString,
+                    response_serializer=shared_dot_proto_dot_resume__pb2.ExportResumeResponse.SerializeToString,
+            ),
+            'ImportResume': grpc.unary_unary_rpc_method_handler(
+                    servicer.ImportResume,
+                    request_deserializer=shared_dot_proto_dot_resume__pb2.ImportResumeRequest.FromString,
+                    response_serializer=shared_dot_proto_dot_resume__pb2.ResumeData.SerializeToString,
+            ),
+            'RenderResume': grpc.unary_unary_rpc_method_handler(
+                    servicer.RenderResume,
+                    request_deserializer=shared_dot_proto_dot_resume__pb2.RenderResumeRequest.FromString,
+                    response_serializer=shared_dot_proto_dot_resume__pb2.RenderResumeResponse.SerializeToString,
+            ),
+            'ParseResumeFile': grpc.unary_unary_rpc_method_handler(
+                    servicer.ParseResumeFile,
+                    request_deserializer=shared_dot_proto_dot_resume__pb2.ParseResumeFileRequest.FromString,
+                    response_serializer=shared_dot_proto_dot_resume__pb2.ParseResumeFileResponse.SerializeToString,
+            ),
+            'CreateApplication': grpc.unary_unary_rpc_method_handler(
+                    servicer.CreateApplication,
+                    request_deserializer=shared_dot_proto_dot_resume__pb2.CreateApplicationRequest.FromString,
+                    response_serializer=shared_dot_proto_dot_resume__pb2.Application.SerializeToString,
+            ),
+            'GetApplication': grpc.unary_unary_rpc_method_handler(
+                    servicer.GetApplication,
+                    request_deserializer=shared_dot_proto_dot_resume__pb2.GetApplicationRequest.FromString,
+                    response_serializer=shared_dot_proto_dot_resume__pb2.Application.SerializeToString,
+            ),
+            'ListApplications': grpc.unary_unary_rpc_method_handler(
+                    servicer.ListApplications,
+                    request_deserializer=shared_dot_proto_dot_resume__pb2.ListApplicationsRequest.FromString,
+                    response_serializer=shared_dot_proto_dot_resume__pb2.ListApplicationsResponse.SerializeToString,
+            ),
+            'UpdateApplication': grpc.unary_unary_rpc_method_handler(
+                    servicer.UpdateApplication,
+                    request_deserializer=shared_dot_proto_dot_resume__pb2.UpdateApplicationRequest.FromString,
+                    response_serializer=shared_dot_proto_dot_resume__pb2.Application.SerializeToString,
+            ),
+            'DeleteApplication': grpc.unary_unary_rpc_method_handler(
+                    servicer.DeleteApplication,
+                    request_deserializer=shared_dot_proto_dot_resume__pb2.DeleteApplicationRequest.FromString,
+                    response_serializer=shared_dot_proto_dot_resume__pb2.DeleteApplicationResponse.SerializeToString,
+            ),
+            'SaveJobDescription': grpc.unary_unary_rpc_method_handler(
+                    servicer.SaveJobDescription,
+                    request_deserializer=shared_dot_proto_dot_resume__pb2.SaveJobDescriptionRequest.FromString,
+                    response_serializer=shared_dot_proto_dot_resume__pb2.JobDescription.SerializeToString,
+            ),
+            'GetJobDescription': grpc.unary_unary_rpc_method_handler(
+                    servicer.GetJobDescription,
+                    request_deserializer=shared_dot_proto_dot_resume__pb2.GetJobDescriptionRequest.FromString,
+                    response_serializer=shared_dot_proto_dot_resume__pb2.JobDescription.SerializeToString,
+            ),
+            'ListJobDescriptions': grpc.unary_unary_rpc_method_handler(
+                    servicer.ListJobDescriptions,
+                    request_deserializer=shared_dot_proto_dot_resume__pb2.ListJobDescriptionsRequest.FromString,
+                    response_serializer=shared_dot_proto_dot_resume__pb2.ListJobDescriptionsResponse.SerializeToString,
+            ),
+            'SaveAnalysis': grpc.unary_unary_rpc_method_handler(
+                    servicer.SaveAnalysis,
+                    request_deserializer=shared_dot_proto_dot_resume__pb2.SaveAnalysisRequest.FromString,
+                    response_serializer=shared_dot_proto_dot_resume__pb2.Analysis.SerializeToString,
+            ),
+            'ListAnalyses': grpc.unary_unary_rpc_method_handler(
+                    servicer.ListAnalyses,
+                    request_deserializer=shared_dot_proto_dot_resume__pb2.ListAnalysesRequest.FromString,
+                    response_serializer=shared_dot_proto_dot_resume__pb2.ListAnalysesResponse.SerializeToString,
+            ),
+            'SaveCoverLetter': grpc.unary_unary_rpc_method_handler(
+                    servicer.SaveCoverLetter,
+                    request_deserializer=shared_dot_proto_dot_resume__pb2.SaveCoverLetterRequest.FromString,
+                    response_serializer=shared_dot_proto_dot_resume__pb2.CoverLetter.SerializeToString,
+            ),
+            'UpdateCoverLetter': grpc.unary_unary_rpc_method_handler(
+                    servicer.UpdateCoverLetter,
+                    request_deserializer=shared_dot_proto_dot_resume__pb2.UpdateCoverLetterRequest.FromString,
+                    response_serializer=shared_dot_proto_dot_resume__pb2.CoverLetter.SerializeToString,
+            ),
+            'ListCoverLetters': grpc.unary_unary_rpc_method_handler(
+                    servicer.ListCoverLetters,
+                    request_deserializer=shared_dot_proto_dot_resume__pb2.ListCoverLettersRequest.FromString,
+                    response_serializer=shared_dot_proto_dot_resume__pb2.ListCoverLettersResponse.SerializeToString,
+            ),
+            'DeleteCoverLetter': grpc.unary_unary_rpc_method_handler(
+                    servicer.DeleteCoverLetter,
+                    request_deserializer=shared_dot_proto_dot_resume__pb2.DeleteCoverLetterRequest.FromString,
+                    response_serializer=shared_dot_proto_dot_resume__pb2.DeleteCoverLetterResponse.SerializeToString,
+            ),
+            'SaveQuestionSet': grpc.unary_unary_rpc_method_handler(
+                    servicer.SaveQuestionSet,
+                    request_deserializer=shared_dot_proto_dot_resume__pb2.SaveQuestionSetRequest.FromString,
+                    response_serializer=shared_dot_proto_dot_resume__pb2.QuestionSet.SerializeToString,
+            ),
+            'ListQuestionSets': grpc.unary_unary_rpc_method_handler(
+                    servicer.ListQuestionSets,
+                    request_deserializer=shared_dot_proto_dot_resume__pb2.ListQuestionSetsRequest.FromString,
+                    response_serializer=shared_dot_proto_dot_resume__pb2.ListQuestionSetsResponse.SerializeToString,
+            ),
+            'UpdatePracticeQuestion': grpc.unary_unary_rpc_method_handler(
+                    servicer.UpdatePracticeQuestion,
+                    request_deserializer=shared_dot_proto_dot_resume__pb2.UpdatePracticeQuestionRequest.FromString,
+                    response_serializer=shared_dot_proto_dot_resume__pb2.PracticeQuestion.SerializeToString,
+            ),
+            'DeleteQuestionSet': grpc.unary_unary_rpc_method_handler(
+                    servicer.DeleteQuestionSet,
+                    request_deserializer=shared_dot_proto_dot_resume__pb2.DeleteQuestionSetRequest.FromString,
+                    response_serializer=shared_dot_proto_dot_resume__pb2.DeleteQuestionSetResponse.SerializeToString,
+            ),
+    }
+    generic_handler = grpc.method_handlers_generic_handler(
+            'resume.ResumePersistenceService', rpc_method_handlers)
+    server.add_generic_rpc_handlers((generic_handler,))
+    server.add_registered_method_handlers('resume.ResumePersistenceService', rpc_method_handlers)
+
+
+ # This class is part of an EXPERIMENTAL API.
+class ResumePersistenceService(object):
+    """Missing associated documentation comment in .proto file."""
+
+    @staticmethod
+    def SaveResume(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(
+            request,
+            target,
             '/resume.ResumePersistenceService/SaveResume',
             shared_dot_proto_dot_resume__pb2.SaveResumeRequest.SerializeToString,
             shared_dot_proto_dot_resume__pb2.SavedResume.FromString,
@@ -286,6 +841,60 @@ class ResumePersistenceService(object):
             metadata,
             _registered_method=True)
 
+    @staticmethod
+    def GetResume(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(
+            request,
+            target,
+            '/resume.ResumePersistenceService/GetResume',
+            shared_dot_proto_dot_resume__pb2.GetResumeRequest.SerializeToString,
+            shared_dot_proto_dot_resume__pb2.SavedResume.FromString,
+            options,
+            channel_credentials,
+            insecure,
+            call_credentials,
+            compression,
+            wait_for_ready,
+            timeout,
+            metadata,
+            _registered_method=True)
+
+    @staticmethod
+    def UpdateResume(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(
+            request,
+            target,
+            '/resume.ResumePersistenceService/UpdateResume',
+            shared_dot_proto_dot_resume__pb2.UpdateResumeRequest.SerializeToString,
+            shared_dot_proto_dot_resume__pb2.SavedResume.FromString,
+            options,
+            channel_credentials,
+            insecure,
+            call_credentials,
+            compression,
+            wait_for_ready,
+            timeout,
+            metadata,
+            _registered_method=True)
+
     @staticmethod
     def ListResumes(request,
             target,
@@ -339,3 +948,813 @@ class ResumePersistenceService(object):
             timeout,
             metadata,
             _registered_method=True)
+
+    @staticmethod
+    def ListResumeRevisions(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(
+            request,
+            target,
+            '/resume.ResumePersistenceService/ListResumeRevisions',
+            shared_dot_proto_dot_resume__pb2.ListResumeRevisionsRequest.SerializeToString,
+            shared_dot_proto_dot_resume__pb2.ListResumeRevisionsResponse.FromString,
+            options,
+            channel_credentials,
+            insecure,
+            call_credentials,
+            compression,
+            wait_for_ready,
+            timeout,
+            metadata,
+            _registered_method=True)
+
+    @staticmethod
+    def GetResumeRevision(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(
+            request,
+            target,
+            '/resume.ResumePersistenceService/GetResumeRevision',
+            shared_dot_proto_dot_resume__pb2.GetResumeRevisionRequest.SerializeToString,
+            shared_dot_proto_dot_resume__pb2.ResumeRevision.FromString,
+            options,
+            channel_credentials,
+            insecure,
+            call_credentials,
+            compression,
+            wait_for_ready,
+            timeout,
+            metadata,
+            _registered_method=True)
+
+    @staticmethod
+    def RestoreResumeRevision(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(
+            request,
+            target,
+            '/resume.ResumePersistenceService/RestoreResumeRevision',
+            shared_dot_proto_dot_resume__pb2.RestoreResumeRevisionRequest.SerializeToString,
+            shared_dot_proto_dot_resume__pb2.SavedResume.FromString,
+            options,
+            channel_credentials,
+            insecure,
+            call_credentials,
+            compression,
+            wait_for_ready,
+            timeout,
+            metadata,
+            _registered_method=True)
+
+    @staticmethod
+    def DiffResumes(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(
+            request,
+            target,
+            '/resume.ResumePersistenceService/DiffResumes',
+            shared_dot_proto_dot_resume__pb2.DiffResumesRequest.SerializeToString,
+            shared_dot_proto_dot_resume__pb2.ResumeDiff.FromString,
+            options,
+            channel_credentials,
+            insecure,
+            call_credentials,
+            compression,
+            wait_for_ready,
+            timeout,
+            metadata,
+            _registered_method=True)
+
+    @staticmethod
+    def ListDeletedResumes(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(
+            request,
+            target,
+            '/resume.ResumePersistenceService/ListDeletedResumes',
+            shared_dot_proto_dot_resume__pb2.ListDeletedResumesRequest.SerializeToString,
+            shared_dot_proto_dot_resume__pb2.ListResumesResponse.FromString,
+            options,
+            channel_credentials,
+            insecure,
+            call_credentials,
+            compression,
+            wait_for_ready,
+            timeout,
+            metadata,
+            _registered_method=True)
+
+    @staticmethod
+    def RestoreResume(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(
+            request,
+            target,
+            '/resume.ResumePersistenceService/RestoreResume',
+            shared_dot_proto_dot_resume__pb2.RestoreResumeRequest.SerializeToString,
+            shared_dot_proto_dot_resume__pb2.SavedResume.FromString,
+            options,
+            channel_credentials,
+            insecure,
+            call_credentials,
+            compression,
+            wait_for_ready,
+            timeout,
+            metadata,
+            _registered_method=True)
+
+    @staticmethod
+    def PurgeResume(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(
+            request,
+            target,
+            '/resume.ResumePersistenceService/PurgeResume',
+            shared_dot_proto_dot_resume__pb2.PurgeResumeRequest.SerializeToString,
+            shared_dot_proto_dot_resume__pb2.PurgeResumeResponse.FromString,
+            options,
+            channel_credentials,
+            insecure,
+            call_credentials,
+            compression,
+            wait_for_ready,
+            timeout,
+            metadata,
+            _registered_method=True)
+
+    @staticmethod
+    def GetCurrentUser(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(
+            request,
+            target,
+            '/resume.ResumePersistenceService/GetCurrentUser',
+            shared_dot_proto_dot_resume__pb2.GetCurrentUserRequest.SerializeToString,
+            shared_dot_proto_dot_resume__pb2.User.FromString,
+            options,
+            channel_credentials,
+            insecure,
+            call_credentials,
+            compression,
+            wait_for_ready,
+            timeout,
+            metadata,
+            _registered_method=True)
+
+    @staticmethod
+    def ExportResume(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(
+            request,
+            target,
+            '/resume.ResumePersistenceService/ExportResume',
+            shared_dot_proto_dot_resume__pb2.ExportResumeRequest.SerializeToString,
+            shared_dot_proto_dot_resume__pb2.ExportResumeResponse.FromString,
+            options,
+            channel_credentials,
+            insecure,
+            call_credentials,
+            compression,
+            wait_for_ready,
+            timeout,
+            metadata,
+            _registered_method=True)
+
+    @staticmethod
+    def ImportResume(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(
+            request,
+            target,
+            '/resume.ResumePersistenceService/ImportResume',
+            shared_dot_proto_dot_resume__pb2.ImportResumeRequest.SerializeToString,
+            shared_dot_proto_dot_resume__pb2.ResumeData.FromString,
+            options,
+            channel_credentials,
+            insecure,
+            call_credentials,
+            compression,
+            wait_for_ready,
+            timeout,
+            metadata,
+            _registered_method=True)
+
+    @staticmethod
+    def RenderResume(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(
+            request,
+            target,
+            '/resume.ResumePersistenceService/RenderResume',
+            shared_dot_proto_dot_resume__pb2.RenderResumeRequest.SerializeToString,
+            shared_dot_proto_dot_resume__pb2.RenderResumeResponse.FromString,
+            options,
+            channel_credentials,
+            insecure,
+            call_credentials,
+            compression,
+            wait_for_ready,
+            timeout,
+            metadata,
+            _registered_method=True)
+
+    @staticmethod
+    def ParseResumeFile(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(
+            request,
+            target,
+            '/resume.ResumePersistenceService/ParseResumeFile',
+            shared_dot_proto_dot_resume__pb2.ParseResumeFileRequest.SerializeToString,
+            shared_dot_proto_dot_resume__pb2.ParseResumeFileResponse.FromString,
+            options,
+            channel_credentials,
+            insecure,
+            call_credentials,
+            compression,
+            wait_for_ready,
+            timeout,
+            metadata,
+            _registered_method=True)
+
+    @staticmethod
+    def CreateApplication(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(
+            request,
+            target,
+            '/resume.ResumePersistenceService/CreateApplication',
+            shared_dot_proto_dot_resume__pb2.CreateApplicationRequest.SerializeToString,
+            shared_dot_proto_dot_resume__pb2.Application.FromString,
+            options,
+            channel_credentials,
+            insecure,
+            call_credentials,
+            compression,
+            wait_for_ready,
+            timeout,
+            metadata,
+            _registered_method=True)
+
+    @staticmethod
+    def GetApplication(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(
+            request,
+            target,
+            '/resume.ResumePersistenceService/GetApplication',
+            shared_dot_proto_dot_resume__pb2.GetApplicationRequest.SerializeToString,
+            shared_dot_proto_dot_resume__pb2.Application.FromString,
+            options,
+            channel_credentials,
+            insecure,
+            call_credentials,
+            compression,
+            wait_for_ready,
+            timeout,
+            metadata,
+            _registered_method=True)
+
+    @staticmethod
+    def ListApplications(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(
+            request,
+            target,
+            '/resume.ResumePersistenceService/ListApplications',
+            shared_dot_proto_dot_resume__pb2.ListApplicationsRequest.SerializeToString,
+            shared_dot_proto_dot_resume__pb2.ListApplicationsResponse.FromString,
+            options,
+            channel_credentials,
+            insecure,
+            call_credentials,
+            compression,
+            wait_for_ready,
+            timeout,
+            metadata,
+            _registered_method=True)
+
+    @staticmethod
+    def UpdateApplication(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(
+            request,
+            target,
+            '/resume.ResumePersistenceService/UpdateApplication',
+            shared_dot_proto_dot_resume__pb2.UpdateApplicationRequest.SerializeToString,
+            shared_dot_proto_dot_resume__pb2.Application.FromString,
+            options,
+            channel_credentials,
+            insecure,
+            call_credentials,
+            compression,
+            wait_for_ready,
+            timeout,
+            metadata,
+            _registered_method=True)
+
+    @staticmethod
+    def DeleteApplication(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(
+            request,
+            target,
+            '/resume.ResumePersistenceService/DeleteApplication',
+            shared_dot_proto_dot_resume__pb2.DeleteApplicationRequest.SerializeToString,
+            shared_dot_proto_dot_resume__pb2.DeleteApplicationResponse.FromString,
+            options,
+            channel_credentials,
+            insecure,
+            call_credentials,
+            compression,
+            wait_for_ready,
+            timeout,
+            metadata,
+            _registered_method=True)
+
+    @staticmethod
+    def SaveJobDescription(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(
+            request,
+            target,
+            '/resume.ResumePersistenceService/SaveJobDescription',
+            shared_dot_proto_dot_resume__pb2.SaveJobDescriptionRequest.SerializeToString,
+            shared_dot_proto_dot_resume__pb2.JobDescription.FromString,
+            options,
+            channel_credentials,
+            insecure,
+            call_credentials,
+            compression,
+            wait_for_ready,
+            timeout,
+            metadata,
+            _registered_method=True)
+
+    @staticmethod
+    def GetJobDescription(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(
+            request,
+            target,
+            '/resume.ResumePersistenceService/GetJobDescription',
+            shared_dot_proto_dot_resume__pb2.GetJobDescriptionRequest.SerializeToString,
+            shared_dot_proto_dot_resume__pb2.JobDescription.FromString,
+            options,
+            channel_credentials,
+            insecure,
+            call_credentials,
+            compression,
+            wait_for_ready,
+            timeout,
+            metadata,
+            _registered_method=True)
+
+    @staticmethod
+    def ListJobDescriptions(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(
+            request,
+            target,
+            '/resume.ResumePersistenceService/ListJobDescriptions',
+            shared_dot_proto_dot_resume__pb2.ListJobDescriptionsRequest.SerializeToString,
+            shared_dot_proto_dot_resume__pb2.ListJobDescriptionsResponse.FromString,
+            options,
+            channel_credentials,
+            insecure,
+            call_credentials,
+            compression,
+            wait_for_ready,
+            timeout,
+            metadata,
+            _registered_method=True)
+
+    @staticmethod
+    def SaveAnalysis(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(
+            request,
+            target,
+            '/resume.ResumePersistenceService/SaveAnalysis',
+            shared_dot_proto_dot_resume__pb2.SaveAnalysisRequest.SerializeToString,
+            shared_dot_proto_dot_resume__pb2.Analysis.FromString,
+            options,
+            channel_credentials,
+            insecure,
+            call_credentials,
+            compression,
+            wait_for_ready,
+            timeout,
+            metadata,
+            _registered_method=True)
+
+    @staticmethod
+    def ListAnalyses(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(
+            request,
+            target,
+            '/resume.ResumePersistenceService/ListAnalyses',
+            shared_dot_proto_dot_resume__pb2.ListAnalysesRequest.SerializeToString,
+            shared_dot_proto_dot_resume__pb2.ListAnalysesResponse.FromString,
+            options,
+            channel_credentials,
+            insecure,
+            call_credentials,
+            compression,
+            wait_for_ready,
+            timeout,
+            metadata,
+            _registered_method=True)
+
+    @staticmethod
+    def SaveCoverLetter(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(
+            request,
+            target,
+            '/resume.ResumePersistenceService/SaveCoverLetter',
+            shared_dot_proto_dot_resume__pb2.SaveCoverLetterRequest.SerializeToString,
+            shared_dot_proto_dot_resume__pb2.CoverLetter.FromString,
+            options,
+            channel_credentials,
+            insecure,
+            call_credentials,
+            compression,
+            wait_for_ready,
+            timeout,
+            metadata,
+            _registered_method=True)
+
+    @staticmethod
+    def UpdateCoverLetter(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(
+            request,
+            target,
+            '/resume.ResumePersistenceService/UpdateCoverLetter',
+            shared_dot_proto_dot_resume__pb2.UpdateCoverLetterRequest.SerializeToString,
+            shared_dot_proto_dot_resume__pb2.CoverLetter.FromString,
+            options,
+            channel_credentials,
+            insecure,
+            call_credentials,
+            compression,
+            wait_for_ready,
+            timeout,
+            metadata,
+            _registered_method=True)
+
+    @staticmethod
+    def ListCoverLetters(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(
+            request,
+            target,
+            '/resume.ResumePersistenceService/ListCoverLetters',
+            shared_dot_proto_dot_resume__pb2.ListCoverLettersRequest.SerializeToString,
+            shared_dot_proto_dot_resume__pb2.ListCoverLettersResponse.FromString,
+            options,
+            channel_credentials,
+            insecure,
+            call_credentials,
+            compression,
+            wait_for_ready,
+            timeout,
+            metadata,
+            _registered_method=True)
+
+    @staticmethod
+    def DeleteCoverLetter(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(
+            request,
+            target,
+            '/resume.ResumePersistenceService/DeleteCoverLetter',
+            shared_dot_proto_dot_resume__pb2.DeleteCoverLetterRequest.SerializeToString,
+            shared_dot_proto_dot_resume__pb2.DeleteCoverLetterResponse.FromString,
+            options,
+            channel_credentials,
+            insecure,
+            call_credentials,
+            compression,
+            wait_for_ready,
+            timeout,
+            metadata,
+            _registered_method=True)
+
+    @staticmethod
+    def SaveQuestionSet(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(
+            request,
+            target,
+            '/resume.ResumePersistenceService/SaveQuestionSet',
+            shared_dot_proto_dot_resume__pb2.SaveQuestionSetRequest.SerializeToString,
+            shared_dot_proto_dot_resume__pb2.QuestionSet.FromString,
+            options,
+            channel_credentials,
+            insecure,
+            call_credentials,
+            compression,
+            wait_for_ready,
+            timeout,
+            metadata,
+            _registered_method=True)
+
+    @staticmethod
+    def ListQuestionSets(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(
+            request,
+            target,
+            '/resume.ResumePersistenceService/ListQuestionSets',
+            shared_dot_proto_dot_resume__pb2.ListQuestionSetsRequest.SerializeToString,
+            shared_dot_proto_dot_resume__pb2.ListQuestionSetsResponse.FromString,
+            options,
+            channel_credentials,
+            insecure,
+            call_credentials,
+            compression,
+            wait_for_ready,
+            timeout,
+            metadata,
+            _registered_method=True)
+
+    @staticmethod
+    def UpdatePracticeQuestion(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(
+            request,
+            target,
+            '/resume.ResumePersistenceService/UpdatePracticeQuestion',
+            shared_dot_proto_dot_resume__pb2.UpdatePracticeQuestionRequest.SerializeToString,
+            shared_dot_proto_dot_resume__pb2.PracticeQuestion.FromString,
+            options,
+            channel_credentials,
+            insecure,
+            call_credentials,
+            compression,
+            wait_for_ready,
+            timeout,
+            metadata,
+            _registered_method=True)
+
+    @staticmethod
+    def DeleteQuestionSet(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(
+            request,
+            target,
+            '/resume.ResumePersistenceService/DeleteQuestionSet',
+            shared_dot_proto_dot_resume__pb2.DeleteQuestionSetRequest.SerializeToString,
+            shared_dot_proto_dot_resume__pb2.DeleteQuestionSetResponse.FromString,
+            options,
+            channel_credentials,
+            insecure,
+            call_credentials,
+            compression,
+            wait_for_ready,
+            timeout,
+            metadata,
+            _registered_method=True)